@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+// Package assetstore holds the logic shared by the stores of assets uploaded
+// for a service, such as sourcemaps and ProGuard mappings: caching of fetched
+// assets, and fetching them from Elasticsearch.
+package assetstore
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	gocache "github.com/patrickmn/go-cache"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+const (
+	minCleanupIntervalSeconds float64 = 60
+)
+
+var (
+	// ErrInit is returned when creating a cache with a negative expiration.
+	ErrInit = errors.New("Cache cannot be initialized. Expiration and CleanupInterval need to be >= 0")
+
+	// keyEscaper escapes the separator in cache key components, so that
+	// keys of different components do not collide, and the components of
+	// a key may be matched by prefix.
+	keyEscaper   = strings.NewReplacer(`\`, `\\`, `_`, `\_`)
+	keyUnescaper = strings.NewReplacer(`\\`, `\`, `\_`, `_`)
+)
+
+// Cache caches assets by key. The absence of an asset is cached as well,
+// as are assets which could not be fetched, unless the failure is temporary.
+type Cache struct {
+	*gocache.Cache
+	logger *logp.Logger
+}
+
+// NewCache creates a new Cache, expiring assets after the given expiration.
+func NewCache(expiration time.Duration, logger *logp.Logger) (*Cache, error) {
+	if expiration < 0 {
+		return nil, ErrInit
+	}
+	return &Cache{
+		Cache:  gocache.New(expiration, cleanupInterval(expiration)),
+		logger: logger,
+	}, nil
+}
+
+// Fetch returns the asset cached for key. If no asset is cached, Fetch calls
+// fetch and caches its result. If fetch returns an error, a nil asset is cached
+// unless the error is temporary; see IsTemporary.
+func (c *Cache) Fetch(key string, fetch func() (interface{}, error)) (interface{}, error) {
+	if val, found := c.Get(key); found {
+		return val, nil
+	}
+	val, err := fetch()
+	if err != nil {
+		if !IsTemporary(err) {
+			c.Put(key, nil)
+		}
+		return nil, err
+	}
+	c.Put(key, val)
+	return val, nil
+}
+
+// Put caches asset for key, replacing any cached asset.
+func (c *Cache) Put(key string, asset interface{}) {
+	c.SetDefault(key, asset)
+	if !c.logger.IsDebug() {
+		return
+	}
+	c.logger.Debugf("Added id %v. Cache now has %v entries.", key, c.ItemCount())
+}
+
+// Remove removes the asset cached for key.
+func (c *Cache) Remove(key string) {
+	c.Delete(key)
+	if !c.logger.IsDebug() {
+		return
+	}
+	c.logger.Debugf("Removed id %v. Cache now has %v entries.", key, c.ItemCount())
+}
+
+// RemoveMatching removes the assets cached for keys made up of the given
+// prefix components and one more component for which match returns true.
+// RemoveMatching returns the number of removed assets.
+func (c *Cache) RemoveMatching(prefix []string, match func(last string) bool) int {
+	keyPrefix := Key(append(prefix, "")...)
+	var removed int
+	for k := range c.Items() {
+		if strings.HasPrefix(k, keyPrefix) && match(keyUnescaper.Replace(k[len(keyPrefix):])) {
+			c.Delete(k)
+			removed++
+		}
+	}
+	if removed > 0 && c.logger.IsDebug() {
+		c.logger.Debugf("Removed %d ids. Cache now has %v entries.", removed, c.ItemCount())
+	}
+	return removed
+}
+
+// Key returns the cache key for the given components.
+func Key(components ...string) string {
+	escaped := make([]string, len(components))
+	for i, component := range components {
+		escaped[i] = keyEscaper.Replace(component)
+	}
+	return strings.Join(escaped, "_")
+}
+
+func cleanupInterval(ttl time.Duration) time.Duration {
+	return time.Duration(math.Max(ttl.Seconds(), minCleanupIntervalSeconds)) * time.Second
+}
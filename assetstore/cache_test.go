@@ -0,0 +1,135 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package assetstore
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+func TestNewCache(t *testing.T) {
+	_, err := NewCache(-1, logp.NewLogger("test"))
+	assert.Equal(t, ErrInit, err)
+
+	cache, err := NewCache(time.Minute, logp.NewLogger("test"))
+	require.NoError(t, err)
+	assert.NotNil(t, cache)
+}
+
+func TestCacheFetch(t *testing.T) {
+	cache, err := NewCache(time.Minute, logp.NewLogger("test"))
+	require.NoError(t, err)
+
+	var fetched int
+	fetch := func(asset interface{}, err error) func() (interface{}, error) {
+		return func() (interface{}, error) {
+			fetched++
+			return asset, err
+		}
+	}
+
+	// assets and failures are cached
+	for key, test := range map[string]struct {
+		asset    interface{}
+		err      error
+		expected interface{}
+	}{
+		"asset":   {asset: "asset", expected: "asset"},
+		"missing": {},
+		"failure": {err: errors.New("invalid asset")},
+	} {
+		asset, err := cache.Fetch(key, fetch(test.asset, test.err))
+		assert.Equal(t, test.err, err)
+		assert.Equal(t, test.expected, asset)
+
+		asset, err = cache.Fetch(key, fetch("other", nil))
+		assert.NoError(t, err)
+		assert.Equal(t, test.expected, asset)
+	}
+	assert.Equal(t, 3, fetched)
+
+	// temporary failures are not cached
+	temporary := Temporary(errors.New("unavailable"))
+	_, err = cache.Fetch("temporary", fetch(nil, temporary))
+	assert.Equal(t, temporary, err)
+	asset, err := cache.Fetch("temporary", fetch("asset", nil))
+	assert.NoError(t, err)
+	assert.Equal(t, "asset", asset)
+	assert.Equal(t, 5, fetched)
+}
+
+func TestCacheRemoveMatching(t *testing.T) {
+	cache, err := NewCache(time.Minute, logp.NewLogger("test"))
+	require.NoError(t, err)
+
+	// Components containing the key separator must not collide.
+	otherKey := Key("a_b", "c", "d_e")
+	cache.Put(otherKey, "asset")
+	cache.Put(Key("a", "b_c", "d_e"), "asset")
+	cache.Put(Key("a", "b_c", `d\e`), "asset")
+	cache.Put(Key("a", "b_c", "f"), "asset")
+	assert.Equal(t, 4, cache.ItemCount())
+
+	var matched []string
+	removed := cache.RemoveMatching([]string{"a", "b_c"}, func(last string) bool {
+		matched = append(matched, last)
+		return last != "f"
+	})
+	assert.Equal(t, 2, removed)
+	assert.ElementsMatch(t, []string{"d_e", `d\e`, "f"}, matched)
+
+	var keys []string
+	for key := range cache.Items() {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{otherKey, Key("a", "b_c", "f")}, keys)
+}
+
+func TestIsTemporary(t *testing.T) {
+	err := errors.New("unavailable")
+	assert.False(t, IsTemporary(err))
+	assert.True(t, IsTemporary(Temporary(err)))
+	assert.True(t, IsTemporary(fmt.Errorf("wrapped: %w", Temporary(err))))
+	assert.Equal(t, err.Error(), Temporary(err).Error())
+}
+
+func TestCleanupInterval(t *testing.T) {
+	tests := []struct {
+		ttl      time.Duration
+		expected float64
+	}{
+		{expected: 1},
+		{ttl: 30 * time.Second, expected: 1},
+		{ttl: 30 * time.Second, expected: 1},
+		{ttl: 60 * time.Second, expected: 1},
+		{ttl: 61 * time.Second, expected: 61.0 / 60},
+		{ttl: 5 * time.Minute, expected: 5},
+	}
+	for idx, test := range tests {
+		out := cleanupInterval(test.ttl)
+		assert.Equal(t, test.expected, out.Minutes(),
+			fmt.Sprintf("(%v) expected %v minutes, received %v minutes", idx, test.expected, out.Minutes()))
+	}
+}
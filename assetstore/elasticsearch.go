@@ -0,0 +1,93 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package assetstore
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/apm-server/elasticsearch"
+)
+
+// ErrMsgESFailure is the message of errors caused by failures to query Elasticsearch.
+const ErrMsgESFailure = "failure querying ES"
+
+type esSearchResponse struct {
+	Hits struct {
+		Total struct {
+			Value int
+		}
+		Hits []struct {
+			Source json.RawMessage `json:"_source"`
+		}
+	} `json:"hits"`
+}
+
+// SearchFirst runs the search query against index, decoding the source of the
+// first hit into source. SearchFirst returns the total number of hits, which is
+// zero if index does not exist.
+//
+// Failures to query Elasticsearch are temporary, see IsTemporary. Other error
+// responses are reported prefixed with errMsg.
+func SearchFirst(
+	ctx context.Context,
+	client elasticsearch.Client,
+	index string,
+	query interface{},
+	source interface{},
+	errMsg string,
+) (int, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(query); err != nil {
+		return 0, err
+	}
+	statusCode, body, err := client.SearchQuery(ctx, index, &buf)
+	if err != nil {
+		return 0, Temporary(errors.Wrap(err, ErrMsgESFailure))
+	}
+	defer body.Close()
+	// handle error response
+	if statusCode >= http.StatusMultipleChoices {
+		if statusCode == http.StatusNotFound {
+			return 0, nil
+		}
+		b, err := ioutil.ReadAll(body)
+		if err != nil {
+			return 0, errors.Wrap(err, errMsg)
+		}
+		return 0, errors.New(fmt.Sprintf("%s %s", errMsg, b))
+	}
+
+	var resp esSearchResponse
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		return 0, err
+	}
+	hits := resp.Hits.Total.Value
+	if hits > 0 && len(resp.Hits.Hits) > 0 && len(resp.Hits.Hits[0].Source) > 0 {
+		if err := json.Unmarshal(resp.Hits.Hits[0].Source, source); err != nil {
+			return 0, err
+		}
+	}
+	return hits, nil
+}
@@ -0,0 +1,88 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package assetstore
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/elasticsearch/estest"
+)
+
+func TestSearchFirst(t *testing.T) {
+	type source struct {
+		Asset string
+	}
+	for name, tc := range map[string]struct {
+		statusCode int
+		esBody     map[string]interface{}
+		hits       int
+		source     source
+		errMsg     string
+		temporary  bool
+	}{
+		"found": {
+			statusCode: http.StatusOK,
+			esBody: map[string]interface{}{
+				"hits": map[string]interface{}{
+					"total": map[string]interface{}{"value": 2},
+					"hits":  []map[string]interface{}{{"_source": map[string]interface{}{"asset": "a"}}},
+				},
+			},
+			hits:   2,
+			source: source{Asset: "a"},
+		},
+		"no hits": {
+			statusCode: http.StatusOK,
+			esBody: map[string]interface{}{
+				"hits": map[string]interface{}{"total": map[string]interface{}{"value": 0}},
+			},
+		},
+		"missing index": {statusCode: http.StatusNotFound},
+		"bad request": {
+			statusCode: http.StatusBadRequest,
+			errMsg:     "Could not fetch asset.",
+		},
+		"unavailable": {
+			statusCode: -1,
+			errMsg:     ErrMsgESFailure,
+			temporary:  true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			client, err := estest.NewElasticsearchClient(estest.NewTransport(t, tc.statusCode, tc.esBody))
+			require.NoError(t, err)
+
+			var out source
+			hits, err := SearchFirst(context.Background(), client, "index", map[string]interface{}{}, &out, "Could not fetch asset.")
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMsg)
+				assert.Equal(t, tc.temporary, IsTemporary(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.hits, hits)
+			assert.Equal(t, tc.source, out)
+		})
+	}
+}
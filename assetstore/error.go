@@ -0,0 +1,42 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package assetstore
+
+import "errors"
+
+type temporaryError struct {
+	error
+}
+
+func (e temporaryError) Unwrap() error {
+	return e.error
+}
+
+// Temporary marks err as caused by a backend failure which may resolve itself,
+// such as a failure to reach Elasticsearch. Results of temporary failures are
+// not cached.
+func Temporary(err error) error {
+	return temporaryError{err}
+}
+
+// IsTemporary reports whether err, or any error it wraps, has been marked as
+// temporary with Temporary.
+func IsTemporary(err error) bool {
+	var temporary temporaryError
+	return errors.As(err, &temporary)
+}
@@ -0,0 +1,134 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package proguard
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/beater/beatertest"
+	"github.com/elastic/apm-server/beater/request"
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+)
+
+const testMapping = "com.example.Foo -> a:\n    void bar() -> a\n"
+
+func TestProguardMappingHandler(t *testing.T) {
+	for name, tc := range map[string]struct {
+		r        *http.Request
+		fields   map[string]string
+		mapping  string
+		reporter publish.Reporter
+		code     int
+		body     string
+	}{
+		"method": {
+			r:    httptest.NewRequest(http.MethodGet, "/", nil),
+			code: http.StatusMethodNotAllowed,
+			body: beatertest.ResultErrWrap(request.MapResultIDToStatus[request.IDResponseErrorsMethodNotAllowed].Keyword),
+		},
+		"decode": {
+			r:    httptest.NewRequest(http.MethodPost, "/", nil),
+			code: http.StatusBadRequest,
+			body: beatertest.ResultErrWrap(fmt.Sprintf("%s: invalid content type: ",
+				request.MapResultIDToStatus[request.IDResponseErrorsDecode].Keyword)),
+		},
+		"missing service version": {
+			fields:  map[string]string{"service_name": "app"},
+			mapping: testMapping,
+			code:    http.StatusBadRequest,
+			body: beatertest.ResultErrWrap(fmt.Sprintf("%s: error validating ProGuard mapping: service_name and service_version must be sent",
+				request.MapResultIDToStatus[request.IDResponseErrorsValidate].Keyword)),
+		},
+		"invalid mapping": {
+			fields:  map[string]string{"service_name": "app", "service_version": "1.0"},
+			mapping: "not a mapping",
+			code:    http.StatusBadRequest,
+			body: beatertest.ResultErrWrap(fmt.Sprintf("%s: error validating ProGuard mapping: no class mappings found",
+				request.MapResultIDToStatus[request.IDResponseErrorsValidate].Keyword)),
+		},
+		"queue": {
+			fields:  map[string]string{"service_name": "app", "service_version": "1.0"},
+			mapping: testMapping,
+			reporter: func(ctx context.Context, p publish.PendingReq) error {
+				return errors.New("500")
+			},
+			code: http.StatusServiceUnavailable,
+			body: beatertest.ResultErrWrap(fmt.Sprintf("%s: 500", request.MapResultIDToStatus[request.IDResponseErrorsFullQueue].Keyword)),
+		},
+		"valid": {
+			fields:  map[string]string{"service_name": "app", "service_version": "1.0"},
+			mapping: testMapping,
+			reporter: func(ctx context.Context, p publish.PendingReq) error {
+				require.Len(t, p.Transformables, 1)
+				assert.Equal(t, &model.ProguardMapping{
+					ServiceName:    "app",
+					ServiceVersion: "1.0",
+					Mapping:        testMapping,
+				}, p.Transformables[0])
+				return nil
+			},
+			code: http.StatusAccepted,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			r := tc.r
+			if r == nil {
+				r = multipartRequest(t, tc.fields, tc.mapping)
+			}
+			reporter := tc.reporter
+			if reporter == nil {
+				reporter = beatertest.NilReporter
+			}
+			w := httptest.NewRecorder()
+			c := request.NewContext()
+			c.Reset(w, r)
+			Handler(reporter)(c)
+
+			assert.Equal(t, tc.code, w.Code)
+			assert.Equal(t, tc.body, w.Body.String())
+		})
+	}
+}
+
+func multipartRequest(t *testing.T, fields map[string]string, mapping string) *http.Request {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	for k, v := range fields {
+		require.NoError(t, w.WriteField(k, v))
+	}
+	part, err := w.CreateFormFile("mapping", "mapping.txt")
+	require.NoError(t, err)
+	_, err = part.Write([]byte(mapping))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r := httptest.NewRequest(http.MethodPost, "/", &buf)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	return r
+}
@@ -0,0 +1,288 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sourcemap
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"strings"
+
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/utility"
+)
+
+const (
+	// maxArchiveEntrySize limits the decompressed size of a single archive entry.
+	maxArchiveEntrySize = 64 * 1024 * 1024
+
+	// maxArchiveSize limits the total decompressed size of an archive's entries.
+	maxArchiveSize = 256 * 1024 * 1024
+
+	// maxArchiveEntries limits the number of entries in an archive.
+	maxArchiveEntries = 10000
+
+	paramArchive              = "archive"
+	paramBundleFilepathPrefix = "bundle_filepath_prefix"
+	sourcemapFileExtension    = ".map"
+)
+
+var (
+	magicGzip = []byte{0x1f, 0x8b}
+	magicZip  = []byte("PK\x03\x04")
+
+	// errArchiveTooLarge is returned when an archive exceeds the limits
+	// on its number of entries or total decompressed size. The whole
+	// upload is aborted, rather than the offending entry rejected.
+	errArchiveTooLarge = errors.New("archive too large")
+)
+
+// archiveEntry holds the content of a regular file read from an archive.
+type archiveEntry struct {
+	name    string
+	content []byte
+	err     error
+}
+
+// rejectedEntry describes an archive entry which was not accepted.
+type rejectedEntry struct {
+	Path  string `json:"path"`
+	Error string `json:"error"`
+}
+
+// isArchiveUpload reports whether the request holds a sourcemap archive,
+// rather than a single sourcemap.
+func isArchiveUpload(req *http.Request) bool {
+	if !strings.Contains(req.Header.Get("Content-Type"), "multipart/form-data") {
+		return false
+	}
+	file, _, err := req.FormFile(paramArchive)
+	if err != nil {
+		return false
+	}
+	file.Close()
+	return true
+}
+
+// archiveSourcemap holds a sourcemap decoded from an archive,
+// and the path of the archive entry from which it was decoded.
+type archiveSourcemap struct {
+	entryName string
+	sourcemap model.Sourcemap
+}
+
+// decodeArchive decodes the tar.gz or zip archive in the request into one sourcemap per
+// entry with a .map extension. Each entry's bundle filepath is derived from the entry's
+// path relative to the bundle_filepath_prefix request field, excluding the .map extension.
+//
+// Entries which cannot be read, are not sourcemap files, or have paths that would escape
+// the bundle_filepath_prefix are returned as rejected. If the archive exceeds the limits
+// on its number of entries or total decompressed size, an error wrapping errArchiveTooLarge
+// is returned.
+func decodeArchive(req *http.Request) ([]archiveSourcemap, []rejectedEntry, error) {
+	file, header, err := req.FormFile(paramArchive)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer file.Close()
+
+	entries, err := readArchive(file, header)
+	if err != nil {
+		return nil, nil, err
+	}
+	prefix := strings.TrimSuffix(req.FormValue(paramBundleFilepathPrefix), "/")
+	serviceName := req.FormValue(paramServiceName)
+	serviceVersion := req.FormValue(paramServiceVersion)
+
+	var smaps []archiveSourcemap
+	var rejected []rejectedEntry
+	for _, entry := range entries {
+		if entry.err != nil {
+			rejected = append(rejected, rejectedEntry{Path: entry.name, Error: entry.err.Error()})
+			continue
+		}
+		if !strings.HasSuffix(entry.name, sourcemapFileExtension) {
+			rejected = append(rejected, rejectedEntry{
+				Path:  entry.name,
+				Error: fmt.Sprintf("expected file with %s extension", sourcemapFileExtension),
+			})
+			continue
+		}
+		if err := validateEntryPath(entry.name); err != nil {
+			rejected = append(rejected, rejectedEntry{Path: entry.name, Error: err.Error()})
+			continue
+		}
+		bundlePath := strings.TrimSuffix(entry.name, sourcemapFileExtension)
+		smaps = append(smaps, archiveSourcemap{
+			entryName: entry.name,
+			sourcemap: model.Sourcemap{
+				ServiceName:    serviceName,
+				ServiceVersion: serviceVersion,
+				BundleFilepath: utility.CleanUrlPath(prefix + "/" + bundlePath),
+				Sourcemap:      string(entry.content),
+			},
+		})
+	}
+	return smaps, rejected, nil
+}
+
+// validateEntryPath returns an error if the archive entry path is absolute or
+// contains ".." elements, as the derived bundle filepath could then escape the
+// bundle_filepath_prefix.
+func validateEntryPath(name string) error {
+	if strings.HasPrefix(name, "/") || strings.HasPrefix(name, "\\") {
+		return errors.New("expected relative path")
+	}
+	for _, elem := range strings.FieldsFunc(name, func(r rune) bool { return r == '/' || r == '\\' }) {
+		if elem == ".." {
+			return errors.New(`expected path without ".." elements`)
+		}
+	}
+	return nil
+}
+
+// readArchive reads all regular files from a tar.gz or zip archive, detecting the format from its content.
+func readArchive(file multipart.File, header *multipart.FileHeader) ([]archiveEntry, error) {
+	r := bufio.NewReader(file)
+	magic, err := r.Peek(len(magicZip))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	limits := archiveLimits{entries: maxArchiveEntries, size: maxArchiveSize}
+	switch {
+	case bytes.HasPrefix(magic, magicGzip):
+		return readTarGz(r, &limits)
+	case bytes.HasPrefix(magic, magicZip):
+		return readZip(file, header.Size, &limits)
+	}
+	return nil, fmt.Errorf("unsupported archive format for %s: expected tar.gz or zip", header.Filename)
+}
+
+func readTarGz(r io.Reader, limits *archiveLimits) ([]archiveEntry, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer gz.Close()
+
+	var entries []archiveEntry
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return entries, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if err := limits.addEntry(); err != nil {
+			return nil, err
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		content, err := limits.readEntry(tr)
+		if errors.Is(err, errArchiveTooLarge) {
+			return nil, err
+		}
+		entries = append(entries, archiveEntry{name: header.Name, content: content, err: err})
+	}
+}
+
+func readZip(r io.ReaderAt, size int64, limits *archiveLimits) ([]archiveEntry, error) {
+	zr, err := zip.NewReader(r, size)
+	if err != nil {
+		return nil, err
+	}
+	if len(zr.File) > limits.entries {
+		return nil, limits.tooManyEntries()
+	}
+	entries := make([]archiveEntry, 0, len(zr.File))
+	for _, f := range zr.File {
+		if err := limits.addEntry(); err != nil {
+			return nil, err
+		}
+		if !f.Mode().IsRegular() {
+			continue
+		}
+		entry := archiveEntry{name: f.Name}
+		var rc io.ReadCloser
+		if rc, entry.err = f.Open(); entry.err == nil {
+			entry.content, entry.err = limits.readEntry(rc)
+			rc.Close()
+			if errors.Is(entry.err, errArchiveTooLarge) {
+				return nil, entry.err
+			}
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+// archiveLimits holds the remaining budget of entries and
+// decompressed bytes for reading an archive.
+type archiveLimits struct {
+	entries int
+	size    int64
+}
+
+func (l *archiveLimits) addEntry() error {
+	if l.entries <= 0 {
+		return l.tooManyEntries()
+	}
+	l.entries--
+	return nil
+}
+
+func (l *archiveLimits) tooManyEntries() error {
+	return fmt.Errorf("%w: exceeds maximum of %d entries", errArchiveTooLarge, maxArchiveEntries)
+}
+
+// readEntry reads an archive entry's content, charging it against the
+// remaining size budget. An entry exceeding maxArchiveEntrySize results in
+// an error for that entry alone, whereas exceeding the remaining size budget
+// results in an error wrapping errArchiveTooLarge.
+func (l *archiveLimits) readEntry(r io.Reader) ([]byte, error) {
+	limit := int64(maxArchiveEntrySize)
+	if l.size < limit {
+		limit = l.size
+	}
+	content, err := ioutil.ReadAll(io.LimitReader(r, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > l.size {
+		return nil, fmt.Errorf(
+			"%w: exceeds maximum decompressed size of %d bytes",
+			errArchiveTooLarge, maxArchiveSize,
+		)
+	}
+	l.size -= int64(len(content))
+	if len(content) > maxArchiveEntrySize {
+		return nil, fmt.Errorf("entry exceeds maximum size of %d bytes", maxArchiveEntrySize)
+	}
+	return content, nil
+}
@@ -0,0 +1,228 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sourcemap
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/beater/beatertest"
+	"github.com/elastic/apm-server/beater/request"
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+)
+
+type testArchiveFile struct {
+	name, content string
+}
+
+var testArchiveFiles = []testArchiveFile{
+	{name: "js/app.js.map", content: `{"version":3}`},
+	{name: "./js/chunk.1.js.map", content: `{"version":3}`},
+	{name: "js/empty.js.map", content: ""},
+	{name: "js/app.js", content: "console.log()"},
+}
+
+func TestAssetHandlerArchive(t *testing.T) {
+	for name, archive := range map[string][]byte{
+		"tar.gz": tarGzArchive(t, testArchiveFiles),
+		"zip":    zipArchive(t, testArchiveFiles),
+	} {
+		t.Run(name, func(t *testing.T) {
+			var published []model.Sourcemap
+			reporter := func(ctx context.Context, p publish.PendingReq) error {
+				for _, tr := range p.Transformables {
+					published = append(published, *tr.(*model.Sourcemap))
+				}
+				return nil
+			}
+			w := sendArchive(t, reporter, archive, "http://localhost/static/")
+			assert.Equal(t, http.StatusAccepted, w.Code)
+			assert.JSONEq(t, `{
+				"accepted": 2,
+				"rejected": [
+					{"path": "js/app.js", "error": "expected file with .map extension"},
+					{"path": "js/empty.js.map", "error": "error validating sourcemap: expected sourcemap to be sent as string, but got null"}
+				]}`, w.Body.String())
+			assert.Equal(t, []model.Sourcemap{{
+				ServiceName:    "My service",
+				ServiceVersion: "0.1",
+				BundleFilepath: "http://localhost/static/js/app.js",
+				Sourcemap:      `{"version":3}`,
+			}, {
+				ServiceName:    "My service",
+				ServiceVersion: "0.1",
+				BundleFilepath: "http://localhost/static/js/chunk.1.js",
+				Sourcemap:      `{"version":3}`,
+			}}, published)
+		})
+	}
+}
+
+func TestAssetHandlerArchiveInvalid(t *testing.T) {
+	t.Run("format", func(t *testing.T) {
+		w := sendArchive(t, beatertest.NilReporter, []byte("not an archive"), "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, beatertest.ResultErrWrap(fmt.Sprintf(
+			"%s: unsupported archive format for sourcemaps: expected tar.gz or zip",
+			request.MapResultIDToStatus[request.IDResponseErrorsDecode].Keyword,
+		)), w.Body.String())
+	})
+
+	t.Run("noSourcemaps", func(t *testing.T) {
+		var published bool
+		reporter := func(ctx context.Context, p publish.PendingReq) error {
+			published = true
+			return nil
+		}
+		archive := zipArchive(t, []testArchiveFile{{name: "app.js", content: "console.log()"}})
+		w := sendArchive(t, reporter, archive, "")
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.JSONEq(t, `{"accepted": 0, "rejected": [{"path": "app.js", "error": "expected file with .map extension"}]}`, w.Body.String())
+		assert.False(t, published)
+	})
+
+	t.Run("pathTraversal", func(t *testing.T) {
+		var published []model.Sourcemap
+		reporter := func(ctx context.Context, p publish.PendingReq) error {
+			for _, tr := range p.Transformables {
+				published = append(published, *tr.(*model.Sourcemap))
+			}
+			return nil
+		}
+		archive := zipArchive(t, []testArchiveFile{
+			{name: "js/app.js.map", content: `{"version":3}`},
+			{name: "../../other/app.js.map", content: `{"version":3}`},
+			{name: "js/../../other/app.js.map", content: `{"version":3}`},
+			{name: "/other/app.js.map", content: `{"version":3}`},
+		})
+		w := sendArchive(t, reporter, archive, "http://localhost/static/")
+		assert.Equal(t, http.StatusAccepted, w.Code)
+		assert.JSONEq(t, `{
+			"accepted": 1,
+			"rejected": [
+				{"path": "../../other/app.js.map", "error": "expected path without \"..\" elements"},
+				{"path": "js/../../other/app.js.map", "error": "expected path without \"..\" elements"},
+				{"path": "/other/app.js.map", "error": "expected relative path"}
+			]}`, w.Body.String())
+		require.Len(t, published, 1)
+		assert.Equal(t, "http://localhost/static/js/app.js", published[0].BundleFilepath)
+	})
+
+	t.Run("tooManyEntries", func(t *testing.T) {
+		files := make([]testArchiveFile, maxArchiveEntries+1)
+		for i := range files {
+			files[i] = testArchiveFile{name: fmt.Sprintf("%d.js.map", i)}
+		}
+		w := sendArchive(t, beatertest.NilReporter, zipArchive(t, files), "")
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Contains(t, w.Body.String(), fmt.Sprintf("archive too large: exceeds maximum of %d entries", maxArchiveEntries))
+	})
+}
+
+func TestReadArchiveLimits(t *testing.T) {
+	files := []testArchiveFile{
+		{name: "a.js.map", content: "0123456789"},
+		{name: "b.js.map", content: "0123456789"},
+	}
+	for name, read := range map[string]func(limits *archiveLimits) ([]archiveEntry, error){
+		"tar.gz": func(limits *archiveLimits) ([]archiveEntry, error) {
+			return readTarGz(bytes.NewReader(tarGzArchive(t, files)), limits)
+		},
+		"zip": func(limits *archiveLimits) ([]archiveEntry, error) {
+			archive := zipArchive(t, files)
+			return readZip(bytes.NewReader(archive), int64(len(archive)), limits)
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			entries, err := read(&archiveLimits{entries: 10, size: 20})
+			require.NoError(t, err)
+			assert.Len(t, entries, 2)
+
+			// The total decompressed size exceeds the budget.
+			_, err = read(&archiveLimits{entries: 10, size: 19})
+			assert.True(t, errors.Is(err, errArchiveTooLarge))
+
+			// The number of entries exceeds the budget. The tar.gz
+			// archive has an additional directory entry.
+			_, err = read(&archiveLimits{entries: 1, size: 20})
+			assert.True(t, errors.Is(err, errArchiveTooLarge))
+		})
+	}
+}
+
+func sendArchive(t *testing.T, reporter publish.Reporter, archive []byte, prefix string) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	mw := multipart.NewWriter(&buf)
+	part, err := mw.CreateFormFile("archive", "sourcemaps")
+	require.NoError(t, err)
+	_, err = part.Write(archive)
+	require.NoError(t, err)
+	mw.WriteField("service_name", "My service")
+	mw.WriteField("service_version", "0.1")
+	mw.WriteField("bundle_filepath_prefix", prefix)
+	require.NoError(t, mw.Close())
+
+	r := httptest.NewRequest(http.MethodPost, "/", &buf)
+	r.Header.Set("Content-Type", mw.FormDataContentType())
+	w := httptest.NewRecorder()
+	c := request.NewContext()
+	c.Reset(w, r)
+	Handler(reporter, nil)(c)
+	return w
+}
+
+func tarGzArchive(t *testing.T, files []testArchiveFile) []byte {
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "js/", Typeflag: tar.TypeDir, Mode: 0755}))
+	for _, f := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content))}))
+		_, err := tw.Write([]byte(f.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func zipArchive(t *testing.T, files []testArchiveFile) []byte {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		require.NoError(t, err)
+		_, err = w.Write([]byte(f.content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, zw.Close())
+	return buf.Bytes()
+}
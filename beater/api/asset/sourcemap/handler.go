@@ -31,10 +31,19 @@ import (
 	"github.com/elastic/apm-server/beater/request"
 	"github.com/elastic/apm-server/model"
 	"github.com/elastic/apm-server/publish"
+	sourcemapstore "github.com/elastic/apm-server/sourcemap"
 	"github.com/elastic/apm-server/transform"
 	"github.com/elastic/apm-server/utility"
 )
 
+const (
+	msgStoreUnavailable  = "sourcemap store unavailable"
+	msgSourcemapNotFound = "sourcemap not found"
+	paramServiceName     = "service_name"
+	paramServiceVersion  = "service_version"
+	paramBundleFilepath  = "bundle_filepath"
+)
+
 var (
 	// MonitoringMap holds a mapping for request.IDs to monitoring counters
 	MonitoringMap = request.DefaultMonitoringMapForRegistry(registry)
@@ -44,51 +53,185 @@ var (
 	decodingError = monitoring.NewInt(registry, "decoding.errors")
 	validateCount = monitoring.NewInt(registry, "validation.count")
 	validateError = monitoring.NewInt(registry, "validation.errors")
+
+	errStoreUnavailable  = errors.New(msgStoreUnavailable)
+	errSourcemapNotFound = errors.New(msgSourcemapNotFound)
 )
 
 // Handler returns a request.Handler for managing asset requests.
-func Handler(report publish.Reporter) request.Handler {
+//
+// Sourcemaps are uploaded with POST requests, and published through report.
+// GET and DELETE requests list and remove sourcemaps using store, which may be
+// nil if sourcemaps cannot be read.
+func Handler(report publish.Reporter, store *sourcemapstore.Store) request.Handler {
 	return func(c *request.Context) {
-		if c.Request.Method != "POST" {
+		switch c.Request.Method {
+		case http.MethodPost:
+			upload(c, report)
+		case http.MethodGet:
+			list(c, store)
+		case http.MethodDelete:
+			remove(c, store)
+		default:
 			c.Result.SetDefault(request.IDResponseErrorsMethodNotAllowed)
 			c.Write()
-			return
 		}
-		var smap model.Sourcemap
-		decodingCount.Inc()
-		if err := decode(c.Request, &smap); err != nil {
-			decodingError.Inc()
-			if strings.Contains(err.Error(), request.MapResultIDToStatus[request.IDResponseErrorsRequestTooLarge].Keyword) {
-				c.Result.SetWithError(request.IDResponseErrorsRequestTooLarge, err)
-			} else {
-				c.Result.SetWithError(request.IDResponseErrorsDecode, err)
-			}
-			c.Write()
-			return
+	}
+}
+
+func upload(c *request.Context, report publish.Reporter) {
+	if isArchiveUpload(c.Request) {
+		uploadArchive(c, report)
+		return
+	}
+	var smap model.Sourcemap
+	decodingCount.Inc()
+	if err := decode(c.Request, &smap); err != nil {
+		decodingError.Inc()
+		if strings.Contains(err.Error(), request.MapResultIDToStatus[request.IDResponseErrorsRequestTooLarge].Keyword) {
+			c.Result.SetWithError(request.IDResponseErrorsRequestTooLarge, err)
+		} else {
+			c.Result.SetWithError(request.IDResponseErrorsDecode, err)
+		}
+		c.Write()
+		return
+	}
+	validateCount.Inc()
+	if err := validate(smap); err != nil {
+		validateError.Inc()
+		c.Result.SetWithError(request.IDResponseErrorsValidate, err)
+		c.Write()
+		return
+	}
+
+	if !send(c, report, []transform.Transformable{&smap}) {
+		return
+	}
+	c.Result.SetDefault(request.IDResponseValidAccepted)
+	c.Write()
+}
+
+// uploadArchive publishes all valid sourcemaps of an archive in a single request,
+// reporting the rejected archive entries in the response.
+func uploadArchive(c *request.Context, report publish.Reporter) {
+	decodingCount.Inc()
+	smaps, rejected, err := decodeArchive(c.Request)
+	if err != nil {
+		decodingError.Inc()
+		if errors.Is(err, errArchiveTooLarge) {
+			c.Result.SetWithError(request.IDResponseErrorsRequestTooLarge, err)
+		} else {
+			c.Result.SetWithError(request.IDResponseErrorsDecode, err)
 		}
+		c.Write()
+		return
+	}
+
+	transformables := make([]transform.Transformable, 0, len(smaps))
+	for i := range smaps {
+		smap := &smaps[i].sourcemap
 		validateCount.Inc()
-		if err := validate(smap); err != nil {
+		if err := validate(*smap); err != nil {
 			validateError.Inc()
-			c.Result.SetWithError(request.IDResponseErrorsValidate, err)
-			c.Write()
-			return
+			// Report the archive entry, so users can identify the failing file.
+			rejected = append(rejected, rejectedEntry{Path: smaps[i].entryName, Error: err.Error()})
+			continue
 		}
+		transformables = append(transformables, smap)
+	}
+	if rejected == nil {
+		rejected = []rejectedEntry{}
+	}
+	body := map[string]interface{}{"accepted": len(transformables), "rejected": rejected}
+	if len(transformables) == 0 {
+		status := request.MapResultIDToStatus[request.IDResponseErrorsValidate]
+		c.Result.Set(request.IDResponseErrorsValidate, status.Code, status.Keyword, body,
+			errors.New("error validating sourcemap archive: no valid sourcemaps found"))
+		c.Write()
+		return
+	}
+	if !send(c, report, transformables) {
+		return
+	}
+	c.Result.SetWithBody(request.IDResponseValidAccepted, body)
+	c.Write()
+}
 
-		req := publish.PendingReq{Transformables: []transform.Transformable{&smap}}
-		span, ctx := apm.StartSpan(c.Request.Context(), "Send", "Reporter")
-		defer span.End()
-		req.Trace = !span.Dropped()
-		if err := report(ctx, req); err != nil {
-			if err == publish.ErrChannelClosed {
-				c.Result.SetWithError(request.IDResponseErrorsShuttingDown, err)
-			} else {
-				c.Result.SetWithError(request.IDResponseErrorsFullQueue, err)
-			}
-			c.Write()
+// send reports the transformables, writing an error response and returning false if reporting fails.
+func send(c *request.Context, report publish.Reporter, transformables []transform.Transformable) bool {
+	req := publish.PendingReq{Transformables: transformables}
+	span, ctx := apm.StartSpan(c.Request.Context(), "Send", "Reporter")
+	defer span.End()
+	req.Trace = !span.Dropped()
+	if err := report(ctx, req); err != nil {
+		if err == publish.ErrChannelClosed {
+			c.Result.SetWithError(request.IDResponseErrorsShuttingDown, err)
+		} else {
+			c.Result.SetWithError(request.IDResponseErrorsFullQueue, err)
 		}
-		c.Result.SetDefault(request.IDResponseValidAccepted)
 		c.Write()
+		return false
+	}
+	return true
+}
+
+func list(c *request.Context, store *sourcemapstore.Store) {
+	if store == nil {
+		c.Result.SetWithError(request.IDResponseErrorsServiceUnavailable, errStoreUnavailable)
+		c.Write()
+		return
+	}
+	params := c.Request.URL.Query()
+	name, version := params.Get(paramServiceName), params.Get(paramServiceVersion)
+	if name == "" || version == "" {
+		c.Result.SetWithError(request.IDResponseErrorsInvalidQuery,
+			errors.New("service_name and service_version must be sent"))
+		c.Write()
+		return
+	}
+	sourcemaps, err := store.List(c.Request.Context(), name, version)
+	if err != nil {
+		c.Result.SetWithError(request.IDResponseErrorsServiceUnavailable, err)
+		c.Write()
+		return
+	}
+	if sourcemaps == nil {
+		sourcemaps = []sourcemapstore.Metadata{}
+	}
+	c.Result.SetWithBody(request.IDResponseValidOK, map[string]interface{}{"sourcemaps": sourcemaps})
+	c.Write()
+}
+
+func remove(c *request.Context, store *sourcemapstore.Store) {
+	if store == nil {
+		c.Result.SetWithError(request.IDResponseErrorsServiceUnavailable, errStoreUnavailable)
+		c.Write()
+		return
+	}
+	params := c.Request.URL.Query()
+	name, version := params.Get(paramServiceName), params.Get(paramServiceVersion)
+	bundleFilepath := params.Get(paramBundleFilepath)
+	if name == "" || version == "" || bundleFilepath == "" {
+		c.Result.SetWithError(request.IDResponseErrorsInvalidQuery,
+			errors.New("bundle_filepath, service_name and service_version must be sent"))
+		c.Write()
+		return
+	}
+	// Uploaded bundle filepaths are cleaned before being stored.
+	bundleFilepath = utility.CleanUrlPath(bundleFilepath)
+	deleted, err := store.Delete(c.Request.Context(), name, version, bundleFilepath)
+	if err != nil {
+		c.Result.SetWithError(request.IDResponseErrorsServiceUnavailable, err)
+		c.Write()
+		return
+	}
+	if deleted == 0 {
+		c.Result.SetWithError(request.IDResponseErrorsNotFound, errSourcemapNotFound)
+		c.Write()
+		return
 	}
+	c.Result.SetWithBody(request.IDResponseValidOK, map[string]interface{}{"deleted": deleted})
+	c.Write()
 }
 
 func decode(req *http.Request, smap *model.Sourcemap) error {
@@ -105,9 +248,9 @@ func decode(req *http.Request, smap *model.Sourcemap) error {
 		return err
 	}
 	smap.Sourcemap = string(bytes)
-	smap.BundleFilepath = utility.CleanUrlPath(req.FormValue("bundle_filepath"))
-	smap.ServiceName = req.FormValue("service_name")
-	smap.ServiceVersion = req.FormValue("service_version")
+	smap.BundleFilepath = utility.CleanUrlPath(req.FormValue(paramBundleFilepath))
+	smap.ServiceName = req.FormValue(paramServiceName)
+	smap.ServiceVersion = req.FormValue(paramServiceVersion)
 	return nil
 }
 
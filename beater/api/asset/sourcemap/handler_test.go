@@ -23,12 +23,15 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,6 +42,7 @@ import (
 	"github.com/elastic/apm-server/beater/beatertest"
 	"github.com/elastic/apm-server/beater/request"
 	"github.com/elastic/apm-server/publish"
+	sourcemapstore "github.com/elastic/apm-server/sourcemap"
 	"github.com/elastic/apm-server/tests/loader"
 	"github.com/elastic/apm-server/transform"
 )
@@ -46,7 +50,7 @@ import (
 func TestAssetHandler(t *testing.T) {
 	testcases := map[string]testcaseT{
 		"method": {
-			r:    httptest.NewRequest(http.MethodGet, "/", nil),
+			r:    httptest.NewRequest(http.MethodPut, "/", nil),
 			code: http.StatusMethodNotAllowed,
 			body: beatertest.ResultErrWrap(request.MapResultIDToStatus[request.IDResponseErrorsMethodNotAllowed].Keyword),
 		},
@@ -161,7 +165,95 @@ func (tc *testcaseT) setup() error {
 	}
 	c := request.NewContext()
 	c.Reset(tc.w, tc.r)
-	h := Handler(tc.reporter)
+	h := Handler(tc.reporter, nil)
 	h(c)
 	return nil
 }
+
+func TestAssetHandlerList(t *testing.T) {
+	store := testFileStore(t)
+	for name, tc := range map[string]struct {
+		store *sourcemapstore.Store
+		query string
+		code  int
+		body  string
+	}{
+		"noStore": {
+			query: "service_name=foo&service_version=1.0.1",
+			code:  http.StatusServiceUnavailable,
+			body:  beatertest.ResultErrWrap(fmt.Sprintf("%s: %s", request.MapResultIDToStatus[request.IDResponseErrorsServiceUnavailable].Keyword, msgStoreUnavailable)),
+		},
+		"missingVersion": {
+			store: store,
+			query: "service_name=foo",
+			code:  http.StatusBadRequest,
+			body:  beatertest.ResultErrWrap(fmt.Sprintf("%s: service_name and service_version must be sent", request.MapResultIDToStatus[request.IDResponseErrorsInvalidQuery].Keyword)),
+		},
+		"empty": {
+			store: store,
+			query: "service_name=foo&service_version=1.0.2",
+			code:  http.StatusOK,
+			body:  `{"sourcemaps":[]}` + "\n",
+		},
+		"valid": {
+			store: store,
+			query: "service_name=foo&service_version=1.0.1",
+			code:  http.StatusOK,
+			body:  `{"sourcemaps":[{"service_name":"foo","service_version":"1.0.1","bundle_filepath":"/js/bundle.js"}]}` + "\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c := request.NewContext()
+			c.Reset(w, httptest.NewRequest(http.MethodGet, "/?"+tc.query, nil))
+			Handler(beatertest.NilReporter, tc.store)(c)
+			assert.Equal(t, tc.code, w.Code)
+			assert.Equal(t, tc.body, w.Body.String())
+		})
+	}
+}
+
+func TestAssetHandlerDelete(t *testing.T) {
+	store := testFileStore(t)
+	ctx := context.Background()
+	mapper, err := store.Fetch(ctx, "foo", "1.0.1", "/js/bundle.js")
+	require.NoError(t, err)
+	require.NotNil(t, mapper)
+
+	del := func(query string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		c := request.NewContext()
+		c.Reset(w, httptest.NewRequest(http.MethodDelete, "/?"+query, nil))
+		Handler(beatertest.NilReporter, store)(c)
+		return w
+	}
+
+	w := del("service_name=foo&service_version=1.0.1")
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	w = del("service_name=foo&service_version=1.0.1&bundle_filepath=%2Fjs%2Fbundle.js")
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"deleted":1}`+"\n", w.Body.String())
+
+	// the sourcemap must no longer be served from the cache
+	mapper, err = store.Fetch(ctx, "foo", "1.0.1", "/js/bundle.js")
+	require.NoError(t, err)
+	assert.Nil(t, mapper)
+
+	w = del("service_name=foo&service_version=1.0.1&bundle_filepath=%2Fjs%2Fbundle.js")
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	assert.Equal(t, beatertest.ResultErrWrap(fmt.Sprintf("%s: %s", request.MapResultIDToStatus[request.IDResponseErrorsNotFound].Keyword, msgSourcemapNotFound)), w.Body.String())
+}
+
+// testFileStore returns a sourcemap store backed by a temporary directory,
+// holding a sourcemap for service foo, version 1.0.1 and file /js/bundle.js.
+func testFileStore(t *testing.T) *sourcemapstore.Store {
+	b, err := loader.LoadDataAsBytes("../testdata/sourcemap/bundle.js.map")
+	require.NoError(t, err)
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "foo", "1.0.1", "js"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "foo", "1.0.1", "js", "bundle.js.map"), b, 0644))
+	store, err := sourcemapstore.NewFileStore(dir, time.Minute)
+	require.NoError(t, err)
+	return store
+}
@@ -24,11 +24,13 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/logp"
 
+	"github.com/elastic/apm-server/beater/api/asset/proguard"
 	"github.com/elastic/apm-server/beater/api/asset/sourcemap"
 	"github.com/elastic/apm-server/beater/api/config/agent"
 	"github.com/elastic/apm-server/beater/api/intake"
 	"github.com/elastic/apm-server/beater/api/profile"
 	"github.com/elastic/apm-server/beater/api/root"
+	"github.com/elastic/apm-server/beater/api/tailsampling"
 	"github.com/elastic/apm-server/beater/authorization"
 	"github.com/elastic/apm-server/beater/config"
 	"github.com/elastic/apm-server/beater/middleware"
@@ -37,6 +39,7 @@ import (
 	logs "github.com/elastic/apm-server/log"
 	"github.com/elastic/apm-server/processor/stream"
 	"github.com/elastic/apm-server/publish"
+	sourcemapstore "github.com/elastic/apm-server/sourcemap"
 )
 
 const (
@@ -49,10 +52,14 @@ const (
 	AgentConfigPath = "/config/v1/agents"
 	// AssetSourcemapPath defines the path to upload sourcemaps
 	AssetSourcemapPath = "/assets/v1/sourcemaps"
+	// AssetProguardMappingPath defines the path to upload ProGuard/R8 mapping files
+	AssetProguardMappingPath = "/assets/v1/proguard_mappings"
 	// IntakePath defines the path to ingest monitored events
 	IntakePath = "/intake/v2/events"
 	// ProfilePath defines the path to ingest profiles
 	ProfilePath = "/intake/v2/profile"
+	// TailSamplingExplainPath defines the path to explain tail-sampling decisions
+	TailSamplingExplainPath = "/sampling/v1/explain"
 
 	// RUM routes
 
@@ -70,7 +77,16 @@ type route struct {
 }
 
 // NewMux registers apm handlers to paths building up the APM Server API.
-func NewMux(beaterConfig *config.Config, report publish.Reporter) (*http.ServeMux, error) {
+//
+// The sourcemap store is used for listing and deleting sourcemaps, and may be nil.
+// The trace explainer is used for explaining tail-sampling decisions, and is nil
+// if tail-based sampling is disabled.
+func NewMux(
+	beaterConfig *config.Config,
+	report publish.Reporter,
+	sourcemapStore *sourcemapstore.Store,
+	traceExplainer tailsampling.TraceExplainer,
+) (*http.ServeMux, error) {
 	pool := request.NewContextPool()
 	mux := http.NewServeMux()
 	logger := logp.NewLogger(logs.Handler)
@@ -82,7 +98,8 @@ func NewMux(beaterConfig *config.Config, report publish.Reporter) (*http.ServeMu
 
 	routeMap := []route{
 		{RootPath, rootHandler},
-		{AssetSourcemapPath, sourcemapHandler},
+		{AssetSourcemapPath, sourcemapHandler(sourcemapStore)},
+		{AssetProguardMappingPath, proguardMappingHandler},
 		{AgentConfigPath, backendAgentConfigHandler},
 		{AgentConfigRUMPath, rumAgentConfigHandler},
 		{IntakeRUMPath, rumIntakeHandler},
@@ -90,6 +107,7 @@ func NewMux(beaterConfig *config.Config, report publish.Reporter) (*http.ServeMu
 		{IntakePath, backendIntakeHandler},
 		// The profile endpoint is in Beta
 		{ProfilePath, profileHandler},
+		{TailSamplingExplainPath, tailSamplingExplainHandler(traceExplainer)},
 	}
 
 	for _, route := range routeMap {
@@ -131,10 +149,33 @@ func rumV3IntakeHandler(cfg *config.Config, _ *authorization.Builder, reporter p
 	return middleware.Wrap(h, rumMiddleware(cfg, nil, intake.MonitoringMap)...)
 }
 
-func sourcemapHandler(cfg *config.Config, builder *authorization.Builder, reporter publish.Reporter) (request.Handler, error) {
-	h := sourcemap.Handler(reporter)
+func sourcemapHandler(store *sourcemapstore.Store) func(*config.Config, *authorization.Builder, publish.Reporter) (request.Handler, error) {
+	return func(cfg *config.Config, builder *authorization.Builder, reporter publish.Reporter) (request.Handler, error) {
+		h := sourcemap.Handler(reporter, store)
+		authHandler := builder.ForPrivilege(authorization.PrivilegeSourcemapWrite.Action)
+		return middleware.Wrap(h, sourcemapMiddleware(cfg, authHandler)...)
+	}
+}
+
+func proguardMappingHandler(cfg *config.Config, builder *authorization.Builder, reporter publish.Reporter) (request.Handler, error) {
+	h := proguard.Handler(reporter)
 	authHandler := builder.ForPrivilege(authorization.PrivilegeSourcemapWrite.Action)
-	return middleware.Wrap(h, sourcemapMiddleware(cfg, authHandler)...)
+	msg := "ProGuard mapping upload endpoint is disabled. " +
+		"Configure the `apm-server.proguard` section in apm-server.yml to enable ProGuard mapping uploads. " +
+		"If you are not deobfuscating stack traces, you can safely ignore this error."
+	return middleware.Wrap(h, append(backendMiddleware(cfg, authHandler, proguard.MonitoringMap),
+		middleware.KillSwitchMiddleware(cfg.Proguard.Enabled, msg))...)
+}
+
+func tailSamplingExplainHandler(explainer tailsampling.TraceExplainer) func(*config.Config, *authorization.Builder, publish.Reporter) (request.Handler, error) {
+	return func(cfg *config.Config, builder *authorization.Builder, _ publish.Reporter) (request.Handler, error) {
+		h := tailsampling.Handler(explainer)
+		authHandler := builder.ForPrivilege(authorization.PrivilegeSamplingRead.Action)
+		msg := "Tail-based sampling is disabled. " +
+			"Configure the `apm-server.sampling.tail` section in apm-server.yml to enable it."
+		return middleware.Wrap(h, append(backendMiddleware(cfg, authHandler, tailsampling.MonitoringMap),
+			middleware.KillSwitchMiddleware(explainer != nil, msg))...)
+	}
 }
 
 func backendAgentConfigHandler(cfg *config.Config, builder *authorization.Builder, _ publish.Reporter) (request.Handler, error) {
@@ -0,0 +1,60 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/beater/config"
+	"github.com/elastic/apm-server/beater/headers"
+)
+
+func TestProguardMappingHandler_AuthorizationMiddleware(t *testing.T) {
+	cfg := cfgEnabledProguard()
+	cfg.SecretToken = "1234"
+
+	rec, err := requestToMuxerWithPattern(cfg, AssetProguardMappingPath)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+
+	h := map[string]string{headers.Authorization: "Bearer 1234"}
+	rec, err = requestToMuxerWithHeader(cfg, AssetProguardMappingPath, http.MethodPost, h)
+	require.NoError(t, err)
+	assert.NotEqual(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestProguardMappingHandler_KillSwitchMiddleware(t *testing.T) {
+	rec, err := requestToMuxerWithPattern(config.DefaultConfig(), AssetProguardMappingPath)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "ProGuard mapping upload endpoint is disabled")
+
+	rec, err = requestToMuxerWithPattern(cfgEnabledProguard(), AssetProguardMappingPath)
+	require.NoError(t, err)
+	assert.NotEqual(t, http.StatusForbidden, rec.Code)
+}
+
+func cfgEnabledProguard() *config.Config {
+	cfg := config.DefaultConfig()
+	cfg.Proguard.Enabled = true
+	return cfg
+}
@@ -82,7 +82,7 @@ func TestSourcemapHandler_KillSwitchMiddleware(t *testing.T) {
 }
 
 func TestSourcemapHandler_PanicMiddleware(t *testing.T) {
-	h := testHandler(t, sourcemapHandler)
+	h := testHandler(t, sourcemapHandler(nil))
 	rec := &beatertest.WriterPanicOnce{}
 	c := request.NewContext()
 	c.Reset(rec, httptest.NewRequest(http.MethodGet, "/", nil))
@@ -92,7 +92,7 @@ func TestSourcemapHandler_PanicMiddleware(t *testing.T) {
 }
 
 func TestSourcemapHandler_MonitoringMiddleware(t *testing.T) {
-	h := testHandler(t, sourcemapHandler)
+	h := testHandler(t, sourcemapHandler(nil))
 	c, _ := beatertest.ContextWithResponseRecorder(http.MethodPost, "/")
 
 	// send GET request resulting in 403 Forbidden error as RUM is disabled by default
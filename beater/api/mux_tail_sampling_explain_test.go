@@ -0,0 +1,103 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/beater/authorization"
+	"github.com/elastic/apm-server/beater/beatertest"
+	"github.com/elastic/apm-server/beater/config"
+	"github.com/elastic/apm-server/beater/headers"
+	"github.com/elastic/apm-server/elasticsearch"
+)
+
+type testTraceExplainer struct{}
+
+func (testTraceExplainer) ExplainTrace(traceID string) (interface{}, error) {
+	return map[string]string{"trace_id": traceID}, nil
+}
+
+func TestTailSamplingExplainHandler_AuthorizationMiddleware(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.SecretToken = "1234"
+	mux, err := NewMux(cfg, beatertest.NilReporter, nil, testTraceExplainer{})
+	require.NoError(t, err)
+
+	r := httptest.NewRequest(http.MethodGet, TailSamplingExplainPath+"?trace_id=abc", nil)
+	w := httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusUnauthorized, w.Code)
+
+	r.Header.Set(headers.Authorization, "Bearer 1234")
+	w = httptest.NewRecorder()
+	mux.ServeHTTP(w, r)
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, `{"trace_id":"abc"}`+"\n", w.Body.String())
+}
+
+func TestTailSamplingExplainHandler_APIKeyPrivilege(t *testing.T) {
+	// API keys are authorized by Elasticsearch: the "sampler" key has
+	// the sampling:read privilege, the "ingester" key has all others.
+	es := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		permissions := elasticsearch.Permissions{}
+		for _, privilege := range authorization.PrivilegesAll {
+			permissions[privilege.Action] = privilege != authorization.PrivilegeSamplingRead
+		}
+		if r.Header.Get(headers.Authorization) == "ApiKey sampler" {
+			permissions = elasticsearch.Permissions{authorization.PrivilegeSamplingRead.Action: true}
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(elasticsearch.HasPrivilegesResponse{
+			Application: map[elasticsearch.AppName]elasticsearch.PermissionsPerResource{
+				authorization.Application: {authorization.ResourceInternal: permissions},
+			},
+		})
+	}))
+	defer es.Close()
+
+	cfg := config.DefaultConfig()
+	cfg.APIKeyConfig.Enabled = true
+	cfg.APIKeyConfig.ESConfig.Hosts = elasticsearch.Hosts{es.URL}
+	mux, err := NewMux(cfg, beatertest.NilReporter, nil, testTraceExplainer{})
+	require.NoError(t, err)
+
+	for key, expected := range map[string]int{
+		"ingester": http.StatusUnauthorized,
+		"sampler":  http.StatusOK,
+	} {
+		r := httptest.NewRequest(http.MethodGet, TailSamplingExplainPath+"?trace_id=abc", nil)
+		r.Header.Set(headers.Authorization, "ApiKey "+key)
+		w := httptest.NewRecorder()
+		mux.ServeHTTP(w, r)
+		assert.Equal(t, expected, w.Code, key)
+	}
+}
+
+func TestTailSamplingExplainHandler_KillSwitchMiddleware(t *testing.T) {
+	rec, err := requestToMuxerWithHeader(config.DefaultConfig(), TailSamplingExplainPath+"?trace_id=abc", http.MethodGet, nil)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.Contains(t, rec.Body.String(), "Tail-based sampling is disabled")
+}
@@ -44,7 +44,7 @@ func requestToMuxerWithHeader(cfg *config.Config, pattern string, method string,
 }
 
 func requestToMuxer(cfg *config.Config, r *http.Request) (*httptest.ResponseRecorder, error) {
-	mux, err := NewMux(cfg, beatertest.NilReporter)
+	mux, err := NewMux(cfg, beatertest.NilReporter, nil, nil)
 	if err != nil {
 		return nil, err
 	}
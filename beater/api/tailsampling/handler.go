@@ -0,0 +1,70 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tailsampling
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+
+	"github.com/elastic/apm-server/beater/request"
+)
+
+const paramTraceID = "trace_id"
+
+var (
+	// MonitoringMap holds a mapping for request.IDs to monitoring counters
+	MonitoringMap = request.DefaultMonitoringMapForRegistry(registry)
+	registry      = monitoring.Default.NewRegistry("apm-server.tailsampling")
+
+	errTraceIDMissing = errors.New(paramTraceID + " must be sent")
+)
+
+// TraceExplainer explains the tail-sampling decisions made for traces.
+type TraceExplainer interface {
+	// ExplainTrace returns a JSON-encodable description of the
+	// tail-sampling state of the trace with the given trace ID.
+	ExplainTrace(traceID string) (interface{}, error)
+}
+
+// Handler returns a request.Handler for explaining the tail-sampling
+// decision for the trace identified by the trace_id query parameter.
+func Handler(explainer TraceExplainer) request.Handler {
+	return func(c *request.Context) {
+		if c.Request.Method != http.MethodGet {
+			c.Result.SetDefault(request.IDResponseErrorsMethodNotAllowed)
+			c.Write()
+			return
+		}
+		traceID := c.Request.URL.Query().Get(paramTraceID)
+		if traceID == "" {
+			c.Result.SetWithError(request.IDResponseErrorsInvalidQuery, errTraceIDMissing)
+			c.Write()
+			return
+		}
+		explanation, err := explainer.ExplainTrace(traceID)
+		if err != nil {
+			c.Result.SetWithError(request.IDResponseErrorsServiceUnavailable, err)
+			c.Write()
+			return
+		}
+		c.Result.SetWithBody(request.IDResponseValidOK, explanation)
+		c.Write()
+	}
+}
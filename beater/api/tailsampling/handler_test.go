@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package tailsampling
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/apm-server/beater/beatertest"
+	"github.com/elastic/apm-server/beater/request"
+)
+
+type explainerFunc func(traceID string) (interface{}, error)
+
+func (f explainerFunc) ExplainTrace(traceID string) (interface{}, error) {
+	return f(traceID)
+}
+
+func TestHandler(t *testing.T) {
+	explainer := explainerFunc(func(traceID string) (interface{}, error) {
+		if traceID == "failing" {
+			return nil, errors.New("storage closed")
+		}
+		return map[string]interface{}{"trace_id": traceID}, nil
+	})
+	for name, tc := range map[string]struct {
+		r    *http.Request
+		code int
+		body string
+	}{
+		"method": {
+			r:    httptest.NewRequest(http.MethodPost, "/?trace_id=abc", nil),
+			code: http.StatusMethodNotAllowed,
+			body: beatertest.ResultErrWrap(request.MapResultIDToStatus[request.IDResponseErrorsMethodNotAllowed].Keyword),
+		},
+		"missingTraceID": {
+			r:    httptest.NewRequest(http.MethodGet, "/", nil),
+			code: http.StatusBadRequest,
+			body: beatertest.ResultErrWrap(fmt.Sprintf("%s: trace_id must be sent", request.MapResultIDToStatus[request.IDResponseErrorsInvalidQuery].Keyword)),
+		},
+		"explainerError": {
+			r:    httptest.NewRequest(http.MethodGet, "/?trace_id=failing", nil),
+			code: http.StatusServiceUnavailable,
+			body: beatertest.ResultErrWrap(fmt.Sprintf("%s: storage closed", request.MapResultIDToStatus[request.IDResponseErrorsServiceUnavailable].Keyword)),
+		},
+		"valid": {
+			r:    httptest.NewRequest(http.MethodGet, "/?trace_id=abc", nil),
+			code: http.StatusOK,
+			body: `{"trace_id":"abc"}` + "\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			c := request.NewContext()
+			c.Reset(w, tc.r)
+			Handler(explainer)(c)
+			assert.Equal(t, tc.code, w.Code)
+			assert.Equal(t, tc.body, w.Body.String())
+		})
+	}
+}
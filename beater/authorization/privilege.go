@@ -29,7 +29,8 @@ var (
 	PrivilegeAgentConfigRead = es.NewPrivilege("agentConfig", "config_agent:read")
 	PrivilegeEventWrite      = es.NewPrivilege("event", "event:write")
 	PrivilegeSourcemapWrite  = es.NewPrivilege("sourcemap", "sourcemap:write")
-	PrivilegesAll            = []es.NamedPrivilege{PrivilegeAgentConfigRead, PrivilegeEventWrite, PrivilegeSourcemapWrite}
+	PrivilegeSamplingRead    = es.NewPrivilege("sampling", "sampling:read")
+	PrivilegesAll            = []es.NamedPrivilege{PrivilegeAgentConfigRead, PrivilegeEventWrite, PrivilegeSourcemapWrite, PrivilegeSamplingRead}
 	// ActionAny can't be used for querying, use ActionsAll instead
 	ActionAny  = es.PrivilegeAction("*")
 	ActionsAll = func() []es.PrivilegeAction {
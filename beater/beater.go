@@ -41,6 +41,7 @@ import (
 	"github.com/elastic/apm-server/elasticsearch"
 	"github.com/elastic/apm-server/ingest/pipeline"
 	logs "github.com/elastic/apm-server/log"
+	"github.com/elastic/apm-server/proguard"
 	"github.com/elastic/apm-server/publish"
 	"github.com/elastic/apm-server/sampling"
 	"github.com/elastic/apm-server/sourcemap"
@@ -172,7 +173,11 @@ func (bt *beater) Run(b *beat.Beat) error {
 		runServer = bt.wrapRunServer(runServer)
 	}
 
-	publisher, err := newPublisher(b, bt.config, bt.namespace, tracer)
+	transformConfig, err := newTransformConfig(b.Info, bt.config)
+	if err != nil {
+		return err
+	}
+	publisher, err := newPublisher(b, bt.config, bt.namespace, tracer, transformConfig)
 	if err != nil {
 		return err
 	}
@@ -222,11 +227,12 @@ func (bt *beater) Run(b *beat.Beat) error {
 	bt.mutex.Unlock()
 
 	return runServer(ctx, ServerParams{
-		Info:     b.Info,
-		Config:   bt.config,
-		Logger:   bt.logger,
-		Tracer:   tracer,
-		Reporter: reporter,
+		Info:           b.Info,
+		Config:         bt.config,
+		Logger:         bt.logger,
+		Tracer:         tracer,
+		Reporter:       reporter,
+		SourcemapStore: transformConfig.RUM.SourcemapStore,
 	})
 }
 
@@ -395,11 +401,7 @@ func runServerWithTracerServer(runServer RunServerFunc, tracerServer *tracerServ
 	}
 }
 
-func newPublisher(b *beat.Beat, cfg *config.Config, namespace string, tracer *apm.Tracer) (*publish.Publisher, error) {
-	transformConfig, err := newTransformConfig(b.Info, cfg)
-	if err != nil {
-		return nil, err
-	}
+func newPublisher(b *beat.Beat, cfg *config.Config, namespace string, tracer *apm.Tracer, transformConfig *transform.Config) (*publish.Publisher, error) {
 	publisherConfig := &publish.PublisherConfig{
 		Info:            b.Info,
 		Pipeline:        cfg.Pipeline,
@@ -418,7 +420,8 @@ func newTransformConfig(beatInfo beat.Info, cfg *config.Config) (*transform.Conf
 		},
 	}
 
-	if cfg.RumConfig.IsEnabled() && cfg.RumConfig.SourceMapping.IsEnabled() && cfg.RumConfig.SourceMapping.ESConfig != nil {
+	if cfg.RumConfig.IsEnabled() && cfg.RumConfig.SourceMapping.IsEnabled() &&
+		(cfg.RumConfig.SourceMapping.Directory != "" || cfg.RumConfig.SourceMapping.ESConfig != nil) {
 		store, err := newSourcemapStore(beatInfo, cfg.RumConfig.SourceMapping)
 		if err != nil {
 			return nil, err
@@ -426,10 +429,30 @@ func newTransformConfig(beatInfo beat.Info, cfg *config.Config) (*transform.Conf
 		transformConfig.RUM.SourcemapStore = store
 	}
 
+	if cfg.Proguard.Enabled && cfg.Proguard.ESConfig != nil {
+		store, err := newProguardMappingStore(beatInfo, cfg.Proguard)
+		if err != nil {
+			return nil, err
+		}
+		transformConfig.ProguardMappingStore = store
+	}
+
 	return transformConfig, nil
 }
 
+func newProguardMappingStore(beatInfo beat.Info, cfg *config.ProguardConfig) (*proguard.Store, error) {
+	esClient, err := elasticsearch.NewClient(cfg.ESConfig)
+	if err != nil {
+		return nil, err
+	}
+	index := strings.ReplaceAll(cfg.IndexPattern, "%{[observer.version]}", beatInfo.Version)
+	return proguard.NewStore(esClient, index, cfg.Cache.Expiration)
+}
+
 func newSourcemapStore(beatInfo beat.Info, cfg *config.SourceMapping) (*sourcemap.Store, error) {
+	if cfg.Directory != "" {
+		return sourcemap.NewFileStore(cfg.Directory, cfg.Cache.Expiration)
+	}
 	esClient, err := elasticsearch.NewClient(cfg.ESConfig)
 	if err != nil {
 		return nil, err
@@ -167,6 +167,50 @@ func TestTransformConfigIndex(t *testing.T) {
 	t.Run("with-observer-version", func(t *testing.T) { test(t, "blah-%{[observer.version]}-blah", "blah-1.2.3-blah") })
 }
 
+func TestTransformConfigProguard(t *testing.T) {
+	var requestPaths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPaths = append(requestPaths, r.URL.Path)
+	}))
+	defer srv.Close()
+
+	cfg := config.DefaultConfig()
+	transformConfig, err := newTransformConfig(beat.Info{Version: "1.2.3"}, cfg)
+	require.NoError(t, err)
+	assert.Nil(t, transformConfig.ProguardMappingStore)
+
+	cfg.Proguard.Enabled = true
+	cfg.Proguard.ESConfig.Hosts = []string{srv.URL}
+	cfg.Proguard.IndexPattern = "apm-%{[observer.version]}-proguard_mapping"
+	transformConfig, err = newTransformConfig(beat.Info{Version: "1.2.3"}, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, transformConfig.ProguardMappingStore)
+	transformConfig.ProguardMappingStore.Added(context.Background(), "name", "version")
+	require.Len(t, requestPaths, 1)
+	assert.Equal(t, "/apm-1.2.3-proguard_mapping/_search", requestPaths[0])
+}
+
+func TestTransformConfigSourcemapDirectory(t *testing.T) {
+	var requested bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer srv.Close()
+
+	cfg := config.DefaultConfig()
+	cfg.RumConfig.Enabled = newBool(true)
+	cfg.RumConfig.SourceMapping.ESConfig.Hosts = []string{srv.URL}
+	cfg.RumConfig.SourceMapping.Directory = t.TempDir()
+
+	transformConfig, err := newTransformConfig(beat.Info{Version: "1.2.3"}, cfg)
+	require.NoError(t, err)
+	require.NotNil(t, transformConfig.RUM.SourcemapStore)
+	mapper, err := transformConfig.RUM.SourcemapStore.Fetch(context.Background(), "name", "version", "path")
+	require.NoError(t, err)
+	assert.Nil(t, mapper)
+	assert.False(t, requested, "sourcemaps should not be fetched from Elasticsearch")
+}
+
 func TestTransformConfig(t *testing.T) {
 	test := func(rumEnabled, sourcemapEnabled *bool, expectSourcemapStore bool) {
 		cfg := config.DefaultConfig()
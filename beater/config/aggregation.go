@@ -24,44 +24,141 @@ import (
 const (
 	defaultTransactionAggregationInterval                       = time.Minute
 	defaultTransactionAggregationMaxGroups                      = 10000
+	defaultTransactionAggregationMaxGroupsPerService            = 1000
 	defaultTransactionAggregationHDRHistogramSignificantFigures = 2
 
-	defaultServiceDestinationAggregationInterval  = time.Minute
-	defaultServiceDestinationAggregationMaxGroups = 10000
+	defaultServiceDestinationAggregationInterval            = time.Minute
+	defaultServiceDestinationAggregationMaxGroups           = 10000
+	defaultServiceDestinationAggregationMaxGroupsPerService = 1000
+
+	defaultServiceSummaryAggregationInterval  = time.Minute
+	defaultServiceSummaryAggregationMaxGroups = 10000
+
+	defaultErrorAggregationInterval  = time.Minute
+	defaultErrorAggregationMaxGroups = 10000
+
+	defaultServiceMapAggregationInterval         = time.Minute
+	defaultServiceMapAggregationMaxGroups        = 10000
+	defaultServiceMapAggregationLookupTTL        = time.Minute
+	defaultServiceMapAggregationMaxLookupEntries = 100000
+
+	defaultWebVitalsAggregationInterval  = time.Minute
+	defaultWebVitalsAggregationMaxGroups = 5000
+
+	defaultBreakdownAggregationInterval    = time.Minute
+	defaultBreakdownAggregationMaxGroups   = 10000
+	defaultBreakdownAggregationTraceWindow = 10 * time.Second
+	defaultBreakdownAggregationMaxTraces   = 10000
 )
 
 // AggregationConfig holds configuration related to various metrics aggregations.
 type AggregationConfig struct {
 	Transactions        TransactionAggregationConfig        `config:"transactions"`
 	ServiceDestinations ServiceDestinationAggregationConfig `config:"service_destinations"`
+	ServiceSummary      ServiceSummaryAggregationConfig     `config:"service_summary"`
+	Errors              ErrorAggregationConfig              `config:"errors"`
+	ServiceMap          ServiceMapAggregationConfig         `config:"service_map"`
+	WebVitals           WebVitalsAggregationConfig          `config:"web_vitals"`
+	Breakdown           BreakdownAggregationConfig          `config:"breakdown"`
 }
 
 // TransactionAggregationConfig holds configuration related to transaction metrics aggregation.
 type TransactionAggregationConfig struct {
-	Enabled                        bool          `config:"enabled"`
-	Interval                       time.Duration `config:"interval" validate:"min=1"`
-	MaxTransactionGroups           int           `config:"max_groups" validate:"min=1"`
-	HDRHistogramSignificantFigures int           `config:"hdrhistogram_significant_figures" validate:"min=1, max=5"`
+	Enabled                        bool            `config:"enabled"`
+	Interval                       time.Duration   `config:"interval" validate:"min=1"`
+	MaxTransactionGroups           int             `config:"max_groups" validate:"min=1"`
+	MaxTransactionGroupsPerService int             `config:"max_groups_per_service" validate:"min=0"`
+	HDRHistogramSignificantFigures int             `config:"hdrhistogram_significant_figures" validate:"min=1, max=5"`
+	ExtraDimensions                []string        `config:"extra_dimensions"`
+	RollupIntervals                []time.Duration `config:"rollup_intervals"`
+	Exemplars                      bool            `config:"exemplars"`
 }
 
 // ServiceDestinationAggregationConfig holds configuration related to span metrics aggregation for service maps.
 type ServiceDestinationAggregationConfig struct {
+	Enabled             bool          `config:"enabled"`
+	Interval            time.Duration `config:"interval" validate:"min=1"`
+	MaxGroups           int           `config:"max_groups" validate:"min=1"`
+	MaxGroupsPerService int           `config:"max_groups_per_service" validate:"min=0"`
+	Exemplars           bool          `config:"exemplars"`
+}
+
+// ServiceSummaryAggregationConfig holds configuration related to service-level summary metrics aggregation.
+type ServiceSummaryAggregationConfig struct {
+	Enabled   bool          `config:"enabled"`
+	Interval  time.Duration `config:"interval" validate:"min=1"`
+	MaxGroups int           `config:"max_groups" validate:"min=1"`
+}
+
+// ErrorAggregationConfig holds configuration related to error metrics aggregation.
+type ErrorAggregationConfig struct {
+	Enabled   bool          `config:"enabled"`
+	Interval  time.Duration `config:"interval" validate:"min=1"`
+	MaxGroups int           `config:"max_groups" validate:"min=1"`
+}
+
+// ServiceMapAggregationConfig holds configuration related to service map edge metrics aggregation.
+type ServiceMapAggregationConfig struct {
+	Enabled          bool          `config:"enabled"`
+	Interval         time.Duration `config:"interval" validate:"min=1"`
+	MaxGroups        int           `config:"max_groups" validate:"min=1"`
+	LookupTTL        time.Duration `config:"lookup_ttl" validate:"min=1"`
+	MaxLookupEntries int           `config:"max_lookup_entries" validate:"min=1"`
+}
+
+// WebVitalsAggregationConfig holds configuration related to RUM web vitals metrics aggregation.
+type WebVitalsAggregationConfig struct {
 	Enabled   bool          `config:"enabled"`
 	Interval  time.Duration `config:"interval" validate:"min=1"`
 	MaxGroups int           `config:"max_groups" validate:"min=1"`
 }
 
+// BreakdownAggregationConfig holds configuration related to span breakdown metrics aggregation.
+type BreakdownAggregationConfig struct {
+	Enabled     bool          `config:"enabled"`
+	Interval    time.Duration `config:"interval" validate:"min=1"`
+	MaxGroups   int           `config:"max_groups" validate:"min=1"`
+	TraceWindow time.Duration `config:"trace_window" validate:"min=1"`
+	MaxTraces   int           `config:"max_traces" validate:"min=1"`
+}
+
 func defaultAggregationConfig() AggregationConfig {
 	return AggregationConfig{
 		Transactions: TransactionAggregationConfig{
 			Interval:                       defaultTransactionAggregationInterval,
 			MaxTransactionGroups:           defaultTransactionAggregationMaxGroups,
+			MaxTransactionGroupsPerService: defaultTransactionAggregationMaxGroupsPerService,
 			HDRHistogramSignificantFigures: defaultTransactionAggregationHDRHistogramSignificantFigures,
 		},
 		ServiceDestinations: ServiceDestinationAggregationConfig{
-			Enabled:   true,
-			Interval:  defaultServiceDestinationAggregationInterval,
-			MaxGroups: defaultServiceDestinationAggregationMaxGroups,
+			Enabled:             true,
+			Interval:            defaultServiceDestinationAggregationInterval,
+			MaxGroups:           defaultServiceDestinationAggregationMaxGroups,
+			MaxGroupsPerService: defaultServiceDestinationAggregationMaxGroupsPerService,
+		},
+		ServiceSummary: ServiceSummaryAggregationConfig{
+			Interval:  defaultServiceSummaryAggregationInterval,
+			MaxGroups: defaultServiceSummaryAggregationMaxGroups,
+		},
+		Errors: ErrorAggregationConfig{
+			Interval:  defaultErrorAggregationInterval,
+			MaxGroups: defaultErrorAggregationMaxGroups,
+		},
+		ServiceMap: ServiceMapAggregationConfig{
+			Interval:         defaultServiceMapAggregationInterval,
+			MaxGroups:        defaultServiceMapAggregationMaxGroups,
+			LookupTTL:        defaultServiceMapAggregationLookupTTL,
+			MaxLookupEntries: defaultServiceMapAggregationMaxLookupEntries,
+		},
+		WebVitals: WebVitalsAggregationConfig{
+			Interval:  defaultWebVitalsAggregationInterval,
+			MaxGroups: defaultWebVitalsAggregationMaxGroups,
+		},
+		Breakdown: BreakdownAggregationConfig{
+			Interval:    defaultBreakdownAggregationInterval,
+			MaxGroups:   defaultBreakdownAggregationMaxGroups,
+			TraceWindow: defaultBreakdownAggregationTraceWindow,
+			MaxTraces:   defaultBreakdownAggregationMaxTraces,
 		},
 	}
 }
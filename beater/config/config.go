@@ -77,6 +77,7 @@ type Config struct {
 	AugmentEnabled      bool                    `config:"capture_personal_data"`
 	SelfInstrumentation *InstrumentationConfig  `config:"instrumentation"`
 	RumConfig           *RumConfig              `config:"rum"`
+	Proguard            *ProguardConfig         `config:"proguard"`
 	Register            *RegisterConfig         `config:"register"`
 	Mode                Mode                    `config:"mode"`
 	Kibana              KibanaConfig            `config:"kibana"`
@@ -123,6 +124,10 @@ func NewConfig(ucfg *common.Config, outputESCfg *common.Config) (*Config, error)
 		return nil, err
 	}
 
+	if err := c.Proguard.setup(logger, outputESCfg); err != nil {
+		return nil, err
+	}
+
 	if err := c.APIKeyConfig.setup(logger, outputESCfg); err != nil {
 		return nil, err
 	}
@@ -182,6 +187,7 @@ func DefaultConfig() *Config {
 			URL:     "/debug/vars",
 		},
 		RumConfig:    defaultRum(),
+		Proguard:     defaultProguardConfig(),
 		Register:     defaultRegisterConfig(true),
 		Mode:         ModeProduction,
 		Kibana:       defaultKibanaConfig(),
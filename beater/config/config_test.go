@@ -28,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
 	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
 
 	"github.com/elastic/apm-server/elasticsearch"
@@ -130,6 +131,7 @@ func TestUnpackConfig(t *testing.T) {
 						"enabled":                          true,
 						"interval":                         "1s",
 						"max_groups":                       123,
+						"max_groups_per_service":           12,
 						"hdrhistogram_significant_figures": 1,
 					},
 					"service_destinations": map[string]interface{}{
@@ -177,6 +179,7 @@ func TestUnpackConfig(t *testing.T) {
 					LibraryPattern:      "^custom",
 					ExcludeFromGrouping: "^grouping",
 				},
+				Proguard: defaultProguardConfig(),
 				Register: &RegisterConfig{
 					Ingest: &IngestConfig{
 						Pipeline: &PipelineConfig{
@@ -225,12 +228,38 @@ func TestUnpackConfig(t *testing.T) {
 						Enabled:                        true,
 						Interval:                       time.Second,
 						MaxTransactionGroups:           123,
+						MaxTransactionGroupsPerService: 12,
 						HDRHistogramSignificantFigures: 1,
 					},
 					ServiceDestinations: ServiceDestinationAggregationConfig{
-						Enabled:   true,
+						Enabled:             true,
+						Interval:            time.Minute,
+						MaxGroups:           456,
+						MaxGroupsPerService: 1000,
+					},
+					ServiceSummary: ServiceSummaryAggregationConfig{
 						Interval:  time.Minute,
-						MaxGroups: 456,
+						MaxGroups: 10000,
+					},
+					Errors: ErrorAggregationConfig{
+						Interval:  time.Minute,
+						MaxGroups: 10000,
+					},
+					ServiceMap: ServiceMapAggregationConfig{
+						Interval:         time.Minute,
+						MaxGroups:        10000,
+						LookupTTL:        time.Minute,
+						MaxLookupEntries: 100000,
+					},
+					WebVitals: WebVitalsAggregationConfig{
+						Interval:  time.Minute,
+						MaxGroups: 5000,
+					},
+					Breakdown: BreakdownAggregationConfig{
+						Interval:    time.Minute,
+						MaxGroups:   10000,
+						TraceWindow: 10 * time.Second,
+						MaxTraces:   10000,
 					},
 				},
 				Sampling: SamplingConfig{
@@ -243,6 +272,7 @@ func TestUnpackConfig(t *testing.T) {
 						StorageDir:            "tail_sampling",
 						StorageGCInterval:     5 * time.Minute,
 						TTL:                   30 * time.Minute,
+						StorageLimitAction:    StorageLimitActionDrop,
 					},
 				},
 			},
@@ -324,6 +354,7 @@ func TestUnpackConfig(t *testing.T) {
 					LibraryPattern:      "rum",
 					ExcludeFromGrouping: "^/webpack",
 				},
+				Proguard: defaultProguardConfig(),
 				Register: &RegisterConfig{
 					Ingest: &IngestConfig{
 						Pipeline: &PipelineConfig{
@@ -359,13 +390,39 @@ func TestUnpackConfig(t *testing.T) {
 						Enabled:                        true,
 						Interval:                       time.Minute,
 						MaxTransactionGroups:           10000,
+						MaxTransactionGroupsPerService: 1000,
 						HDRHistogramSignificantFigures: 2,
 					},
 					ServiceDestinations: ServiceDestinationAggregationConfig{
-						Enabled:   false,
+						Enabled:             false,
+						Interval:            time.Minute,
+						MaxGroups:           10000,
+						MaxGroupsPerService: 1000,
+					},
+					ServiceSummary: ServiceSummaryAggregationConfig{
+						Interval:  time.Minute,
+						MaxGroups: 10000,
+					},
+					Errors: ErrorAggregationConfig{
 						Interval:  time.Minute,
 						MaxGroups: 10000,
 					},
+					ServiceMap: ServiceMapAggregationConfig{
+						Interval:         time.Minute,
+						MaxGroups:        10000,
+						LookupTTL:        time.Minute,
+						MaxLookupEntries: 100000,
+					},
+					WebVitals: WebVitalsAggregationConfig{
+						Interval:  time.Minute,
+						MaxGroups: 5000,
+					},
+					Breakdown: BreakdownAggregationConfig{
+						Interval:    time.Minute,
+						MaxGroups:   10000,
+						TraceWindow: 10 * time.Second,
+						MaxTraces:   10000,
+					},
 				},
 				Sampling: SamplingConfig{
 					KeepUnsampled: false,
@@ -377,6 +434,7 @@ func TestUnpackConfig(t *testing.T) {
 						StorageDir:            "tail_sampling",
 						StorageGCInterval:     5 * time.Minute,
 						TTL:                   30 * time.Minute,
+						StorageLimitAction:    StorageLimitActionDrop,
 					},
 				},
 			},
@@ -557,13 +615,14 @@ func TestAgentConfig(t *testing.T) {
 }
 
 func TestNewConfig_ESConfig(t *testing.T) {
-	ucfg, err := common.NewConfigFrom(`{"rum.enabled":true,"api_key.enabled":true,"sampling.tail.enabled":true}`)
+	ucfg, err := common.NewConfigFrom(`{"rum.enabled":true,"proguard.enabled":true,"api_key.enabled":true,"sampling.tail.enabled":true}`)
 	require.NoError(t, err)
 
 	// no es config given
 	cfg, err := NewConfig(ucfg, nil)
 	require.NoError(t, err)
 	assert.Equal(t, elasticsearch.DefaultConfig(), cfg.RumConfig.SourceMapping.ESConfig)
+	assert.Equal(t, elasticsearch.DefaultConfig(), cfg.Proguard.ESConfig)
 	assert.Equal(t, elasticsearch.DefaultConfig(), cfg.APIKeyConfig.ESConfig)
 	assert.Equal(t, elasticsearch.DefaultConfig(), cfg.Sampling.Tail.ESConfig)
 
@@ -573,8 +632,272 @@ func TestNewConfig_ESConfig(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotNil(t, cfg.RumConfig.SourceMapping.ESConfig)
 	assert.Equal(t, []string{"192.0.0.168:9200"}, []string(cfg.RumConfig.SourceMapping.ESConfig.Hosts))
+	assert.NotNil(t, cfg.Proguard.ESConfig)
+	assert.Equal(t, []string{"192.0.0.168:9200"}, []string(cfg.Proguard.ESConfig.Hosts))
 	assert.NotNil(t, cfg.APIKeyConfig.ESConfig)
 	assert.Equal(t, []string{"192.0.0.168:9200"}, []string(cfg.APIKeyConfig.ESConfig.Hosts))
 	assert.NotNil(t, cfg.Sampling.Tail.ESConfig)
 	assert.Equal(t, []string{"192.0.0.168:9200"}, []string(cfg.Sampling.Tail.ESConfig.Hosts))
 }
+
+func TestNewConfig_TransactionAggregationExtraDimensions(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+aggregation.transactions:
+  enabled: true
+  extra_dimensions: [labels.tenant, cloud.region]
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"labels.tenant", "cloud.region"}, cfg.Aggregation.Transactions.ExtraDimensions)
+}
+
+func TestNewConfig_TransactionAggregationRollupIntervals(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+aggregation.transactions:
+  enabled: true
+  rollup_intervals: [10m, 1h]
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{10 * time.Minute, time.Hour}, cfg.Aggregation.Transactions.RollupIntervals)
+}
+
+func TestNewConfig_AggregationExemplars(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+aggregation.transactions.exemplars: true
+aggregation.service_destinations.exemplars: true
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.True(t, cfg.Aggregation.Transactions.Exemplars)
+	assert.True(t, cfg.Aggregation.ServiceDestinations.Exemplars)
+}
+
+func TestNewConfig_AggregationMaxGroupsPerService(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+aggregation.transactions.max_groups_per_service: 100
+aggregation.service_destinations.max_groups_per_service: 200
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, 100, cfg.Aggregation.Transactions.MaxTransactionGroupsPerService)
+	assert.Equal(t, 200, cfg.Aggregation.ServiceDestinations.MaxGroupsPerService)
+
+	// Each service gets its own group budget by default.
+	cfg, err = NewConfig(common.NewConfig(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, 1000, cfg.Aggregation.Transactions.MaxTransactionGroupsPerService)
+	assert.Equal(t, 1000, cfg.Aggregation.ServiceDestinations.MaxGroupsPerService)
+
+	// Setting 0 limits services only by max_groups.
+	ucfg, err = common.NewConfigFrom(`aggregation.transactions.max_groups_per_service: 0`)
+	require.NoError(t, err)
+	cfg, err = NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Zero(t, cfg.Aggregation.Transactions.MaxTransactionGroupsPerService)
+
+	ucfg, err = common.NewConfigFrom(`aggregation.transactions.max_groups_per_service: -1`)
+	require.NoError(t, err)
+	_, err = NewConfig(ucfg, nil)
+	assert.Error(t, err)
+}
+
+func TestNewConfig_ServiceSummaryAggregation(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+aggregation.service_summary:
+  enabled: true
+  interval: 10s
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ServiceSummaryAggregationConfig{
+		Enabled:   true,
+		Interval:  10 * time.Second,
+		MaxGroups: 10000,
+	}, cfg.Aggregation.ServiceSummary)
+}
+
+func TestNewConfig_ErrorAggregation(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+aggregation.errors:
+  enabled: true
+  max_groups: 100
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ErrorAggregationConfig{
+		Enabled:   true,
+		Interval:  time.Minute,
+		MaxGroups: 100,
+	}, cfg.Aggregation.Errors)
+}
+
+func TestNewConfig_ServiceMapAggregation(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+aggregation.service_map:
+  enabled: true
+  lookup_ttl: 30s
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, ServiceMapAggregationConfig{
+		Enabled:          true,
+		Interval:         time.Minute,
+		MaxGroups:        10000,
+		LookupTTL:        30 * time.Second,
+		MaxLookupEntries: 100000,
+	}, cfg.Aggregation.ServiceMap)
+}
+
+func TestNewConfig_WebVitalsAggregation(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+aggregation.web_vitals:
+  enabled: true
+  interval: 30s
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, WebVitalsAggregationConfig{
+		Enabled:   true,
+		Interval:  30 * time.Second,
+		MaxGroups: 5000,
+	}, cfg.Aggregation.WebVitals)
+}
+
+func TestNewConfig_BreakdownAggregation(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+aggregation.breakdown:
+  enabled: true
+  max_groups: 500
+  trace_window: 30s
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, BreakdownAggregationConfig{
+		Enabled:     true,
+		Interval:    time.Minute,
+		MaxGroups:   500,
+		TraceWindow: 30 * time.Second,
+		MaxTraces:   10000,
+	}, cfg.Aggregation.Breakdown)
+}
+
+func TestNewConfig_TailSamplingPolicies(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+sampling.tail:
+  enabled: true
+  decision_delay: 10s
+  policies:
+    - trace.error: true
+      sample_rate: 1.0
+    - trace.duration: {min: 1s, max: 10s}
+      labels: {tier: gold}
+      sample_rate: 0.5
+    - trace.span_count.min: 10
+      sample_rate: 0.2
+    - service.name: frontend
+      max_traces_per_second: 5
+    - sample_rate: 0.1
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+
+	assert.Equal(t, 10*time.Second, cfg.Sampling.Tail.DecisionDelay)
+	policies := cfg.Sampling.Tail.Policies
+	require.Len(t, policies, 5)
+	require.NotNil(t, policies[0].Trace.Error)
+	assert.True(t, *policies[0].Trace.Error)
+	assert.Equal(t, 1.0, policies[0].SampleRate)
+	assert.Nil(t, policies[1].Trace.Error)
+	assert.Equal(t, time.Second, policies[1].Trace.Duration.Min)
+	assert.Equal(t, 10*time.Second, policies[1].Trace.Duration.Max)
+	assert.Equal(t, map[string]string{"tier": "gold"}, policies[1].Labels)
+	assert.Equal(t, 10, policies[2].Trace.SpanCount.Min)
+	assert.Zero(t, policies[2].Trace.SpanCount.Max)
+	assert.Equal(t, 5.0, policies[3].MaxTracesPerSecond)
+	assert.Zero(t, policies[3].SampleRate)
+	assert.Nil(t, policies[4].Labels)
+}
+
+func TestNewConfig_TailSamplingStorageLimit(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+sampling.tail:
+  enabled: true
+  storage_limit: 3GiB
+  storage_limit_action: passthrough
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, cfgtype.ByteSize(3*1024*1024*1024), cfg.Sampling.Tail.StorageLimit)
+	assert.Equal(t, StorageLimitActionPassthrough, cfg.Sampling.Tail.StorageLimitAction)
+
+	ucfg, err = common.NewConfigFrom(`sampling.tail.storage_limit_action: queue`)
+	require.NoError(t, err)
+	_, err = NewConfig(ucfg, nil)
+	assert.Error(t, err)
+}
+
+func TestNewConfig_TailSamplingDecisionDelay(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`sampling.tail.decision_delay: 0`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Zero(t, cfg.Sampling.Tail.DecisionDelay)
+
+	ucfg, err = common.NewConfigFrom(`sampling.tail.decision_delay: 1ns`)
+	require.NoError(t, err)
+	_, err = NewConfig(ucfg, nil)
+	assert.Error(t, err)
+}
+
+func TestNewConfig_TailSamplingExplainPolicies(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`sampling.tail.explain_policies: true`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.True(t, cfg.Sampling.Tail.ExplainPolicies)
+}
+
+func TestNewConfig_TailSamplingPeers(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+sampling.tail:
+  enabled: true
+  peers: ["apm-server-1:8201", "apm-server-2:8201"]
+  peer_listen_address: "0.0.0.0:8201"
+  peer_secret_token: "abc123"
+  peer_client_ssl:
+    verification_mode: none
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"apm-server-1:8201", "apm-server-2:8201"}, cfg.Sampling.Tail.Peers)
+	assert.Equal(t, "0.0.0.0:8201", cfg.Sampling.Tail.PeerListenAddr)
+	assert.Equal(t, "abc123", cfg.Sampling.Tail.PeerSecretToken)
+	assert.Nil(t, cfg.Sampling.Tail.PeerTLS)
+	require.NotNil(t, cfg.Sampling.Tail.PeerClientTLS)
+	assert.True(t, cfg.Sampling.Tail.PeerClientTLS.InsecureSkipVerify)
+}
+
+func TestNewConfig_TailSamplingLocalRoots(t *testing.T) {
+	ucfg, err := common.NewConfigFrom(`
+sampling.tail:
+  enabled: true
+  local_root_sampling: true
+`)
+	require.NoError(t, err)
+	cfg, err := NewConfig(ucfg, nil)
+	require.NoError(t, err)
+	assert.True(t, cfg.Sampling.Tail.LocalRootSampling)
+}
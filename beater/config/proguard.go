@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package config
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/elastic/apm-server/elasticsearch"
+)
+
+const (
+	defaultProguardCacheExpiration = 5 * time.Minute
+	defaultProguardIndexPattern    = "apm-*-proguard_mapping*"
+)
+
+// ProguardConfig holds config information related to deobfuscating
+// stack traces using ProGuard/R8 mapping files.
+type ProguardConfig struct {
+	Enabled      bool                  `config:"enabled"`
+	Cache        *Cache                `config:"cache"`
+	IndexPattern string                `config:"index_pattern"`
+	ESConfig     *elasticsearch.Config `config:"elasticsearch"`
+	esConfigured bool
+}
+
+func (c *ProguardConfig) setup(log *logp.Logger, outputESCfg *common.Config) error {
+	if !c.Enabled || c.esConfigured {
+		return nil
+	}
+
+	// fall back to elasticsearch output configuration for mapping storage if possible
+	if outputESCfg == nil {
+		log.Info("Unable to determine ProGuard mapping storage, stack traces will not be deobfuscated")
+		return nil
+	}
+	log.Info("Falling back to elasticsearch output for ProGuard mapping storage")
+	if err := outputESCfg.Unpack(c.ESConfig); err != nil {
+		return errors.Wrap(err, "unpacking Elasticsearch config into ProGuard config")
+	}
+	return nil
+}
+
+func (c *ProguardConfig) Unpack(inp *common.Config) error {
+	// this type is needed to avoid a custom Unpack method
+	type tmpProguardConfig ProguardConfig
+
+	cfg := tmpProguardConfig(*defaultProguardConfig())
+	if err := inp.Unpack(&cfg); err != nil {
+		return errors.Wrap(err, "error unpacking proguard config")
+	}
+	*c = ProguardConfig(cfg)
+
+	if inp.HasField("elasticsearch") {
+		c.esConfigured = true
+	}
+	return nil
+}
+
+func defaultProguardConfig() *ProguardConfig {
+	return &ProguardConfig{
+		Cache:        &Cache{Expiration: defaultProguardCacheExpiration},
+		IndexPattern: defaultProguardIndexPattern,
+		ESConfig:     elasticsearch.DefaultConfig(),
+	}
+}
@@ -63,6 +63,7 @@ type SourceMapping struct {
 	Enabled      *bool                 `config:"enabled"`
 	IndexPattern string                `config:"index_pattern"`
 	ESConfig     *elasticsearch.Config `config:"elasticsearch"`
+	Directory    string                `config:"directory"`
 	esConfigured bool
 }
 
@@ -88,7 +89,7 @@ func (c *RumConfig) setup(log *logp.Logger, outputESCfg *common.Config) error {
 		return errors.Wrapf(err, "Invalid regex for `exclude_from_grouping`: ")
 	}
 
-	if c.SourceMapping == nil || c.SourceMapping.esConfigured {
+	if c.SourceMapping == nil || c.SourceMapping.esConfigured || c.SourceMapping.Directory != "" {
 		return nil
 	}
 
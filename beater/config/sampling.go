@@ -18,12 +18,15 @@
 package config
 
 import (
+	"crypto/tls"
 	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/elastic/apm-server/elasticsearch"
 	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/common/cfgtype"
+	"github.com/elastic/beats/v7/libbeat/common/transport/tlscommon"
 	"github.com/elastic/beats/v7/libbeat/logp"
 )
 
@@ -48,10 +51,58 @@ type TailSamplingConfig struct {
 	StorageDir            string                `config:"storage_dir"`
 	StorageGCInterval     time.Duration         `config:"storage_gc_interval" validate:"min=1s"`
 	TTL                   time.Duration         `config:"ttl" validate:"min=1s"`
+	DecisionDelay         time.Duration         `config:"decision_delay" validate:"min=0"`
+	StorageLimit          cfgtype.ByteSize      `config:"storage_limit"`
+	StorageLimitAction    string                `config:"storage_limit_action"`
+
+	// Peers, if non-empty, holds the addresses of other APM Servers with
+	// which sampled trace IDs are exchanged directly, instead of through
+	// Elasticsearch. PeerListenAddr holds the address on which to listen
+	// for sampled trace IDs published by peers.
+	//
+	// PeerSecretToken, if non-empty, is required of peers publishing
+	// sampled trace IDs. Without it the peer listener is unauthenticated,
+	// and must only be bound to an address on a private network.
+	//
+	// PeerSSL and PeerClientSSL hold TLS configuration for serving
+	// requests from peers, and for publishing to peers, respectively.
+	Peers           []string                `config:"peers"`
+	PeerListenAddr  string                  `config:"peer_listen_address"`
+	PeerSecretToken string                  `config:"peer_secret_token"`
+	PeerSSL         *tlscommon.ServerConfig `config:"peer_ssl"`
+	PeerClientSSL   *tlscommon.Config       `config:"peer_client_ssl"`
+	PeerTLS         *tls.Config             `config:"-"`
+	PeerClientTLS   *tls.Config             `config:"-"`
+
+	// ExplainPolicies controls whether the policy matched by each trace's
+	// root transaction is recorded, for inclusion in the responses of the
+	// tail-sampling explain API. This adds a local storage write for every
+	// root transaction, so it is disabled by default.
+	ExplainPolicies bool `config:"explain_policies"`
+
+	// LocalRootSampling controls whether transactions with a remote
+	// parent, i.e. server-kind spans received via Jaeger from services
+	// called by non-instrumented systems, may be used for making sampling
+	// decisions when their trace root is not received.
+	LocalRootSampling bool `config:"local_root_sampling"`
 
 	esConfigured bool
 }
 
+// minDecisionDelay is the minimum non-zero decision_delay.
+const minDecisionDelay = time.Second
+
+const (
+	// StorageLimitActionDrop causes trace events to be dropped
+	// when the tail-sampling storage limit has been reached.
+	StorageLimitActionDrop = "drop"
+
+	// StorageLimitActionPassthrough causes trace events to be
+	// published without tail-sampling when the tail-sampling
+	// storage limit has been reached.
+	StorageLimitActionPassthrough = "passthrough"
+)
+
 // TailSamplingPolicy holds a tail-sampling policy.
 type TailSamplingPolicy struct {
 	// Service holds attributes of the service which this policy matches.
@@ -64,10 +115,37 @@ type TailSamplingPolicy struct {
 	Trace struct {
 		Name    string `config:"name"`
 		Outcome string `config:"outcome"`
+
+		// Duration holds the root transaction duration range which
+		// this policy matches. Zero values are ignored.
+		Duration struct {
+			Min time.Duration `config:"min"`
+			Max time.Duration `config:"max"`
+		} `config:"duration"`
+
+		// Error, if set, controls whether this policy matches traces
+		// which contain errors (true), or which do not (false).
+		Error *bool `config:"error"`
+
+		// SpanCount holds the range of span counts which this policy
+		// matches. Zero values are ignored. Matching on span count
+		// requires a non-zero decision_delay.
+		SpanCount struct {
+			Min int `config:"min"`
+			Max int `config:"max"`
+		} `config:"span_count"`
 	} `config:"trace"`
 
+	// Labels holds root transaction labels which this policy matches.
+	Labels map[string]string `config:"labels"`
+
 	// SampleRate holds the sample rate applied for this policy.
 	SampleRate float64 `config:"sample_rate" validate:"min=0, max=1"`
+
+	// MaxTracesPerSecond, if non-zero, holds the maximum number of traces
+	// sampled per second for this policy, and is used instead of SampleRate.
+	// SampleRate must not be specified along with MaxTracesPerSecond.
+	MaxTracesPerSecond float64 `config:"max_traces_per_second" validate:"min=0"`
 }
 
 func (c *TailSamplingConfig) Unpack(in *common.Config) error {
@@ -78,6 +156,16 @@ func (c *TailSamplingConfig) Unpack(in *common.Config) error {
 	}
 	*c = TailSamplingConfig(cfg)
 	c.esConfigured = in.HasField("elasticsearch")
+	if c.DecisionDelay != 0 && c.DecisionDelay < minDecisionDelay {
+		return errors.Errorf("invalid decision_delay %s, expected 0 or at least %s", c.DecisionDelay, minDecisionDelay)
+	}
+	switch c.StorageLimitAction {
+	case StorageLimitActionDrop, StorageLimitActionPassthrough:
+	default:
+		return errors.Errorf("invalid storage_limit_action %q, expected %q or %q",
+			c.StorageLimitAction, StorageLimitActionDrop, StorageLimitActionPassthrough,
+		)
+	}
 	return nil
 }
 
@@ -91,6 +179,20 @@ func (c *TailSamplingConfig) setup(log *logp.Logger, outputESCfg *common.Config)
 			return errors.Wrap(err, "error unpacking output.elasticsearch config for tail sampling")
 		}
 	}
+	if c.PeerSSL.IsEnabled() {
+		tlsServerConfig, err := tlscommon.LoadTLSServerConfig(c.PeerSSL)
+		if err != nil {
+			return errors.Wrap(err, "error loading peer_ssl config for tail sampling")
+		}
+		c.PeerTLS = tlsServerConfig.BuildModuleConfig(c.PeerListenAddr)
+	}
+	if c.PeerClientSSL.IsEnabled() {
+		tlsClientConfig, err := tlscommon.LoadTLSConfig(c.PeerClientSSL)
+		if err != nil {
+			return errors.Wrap(err, "error loading peer_client_ssl config for tail sampling")
+		}
+		c.PeerClientTLS = tlsClientConfig.ToConfig()
+	}
 	return nil
 }
 
@@ -113,5 +215,6 @@ func defaultTailSamplingConfig() TailSamplingConfig {
 		StorageDir:            "tail_sampling",
 		StorageGCInterval:     5 * time.Minute,
 		TTL:                   30 * time.Minute,
+		StorageLimitAction:    StorageLimitActionDrop,
 	}
 }
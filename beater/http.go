@@ -31,8 +31,10 @@ import (
 	"github.com/elastic/beats/v7/libbeat/logp"
 
 	"github.com/elastic/apm-server/beater/api"
+	"github.com/elastic/apm-server/beater/api/tailsampling"
 	"github.com/elastic/apm-server/beater/config"
 	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/sourcemap"
 )
 
 type httpServer struct {
@@ -42,8 +44,15 @@ type httpServer struct {
 	reporter publish.Reporter
 }
 
-func newHTTPServer(logger *logp.Logger, cfg *config.Config, tracer *apm.Tracer, reporter publish.Reporter) (*httpServer, error) {
-	mux, err := api.NewMux(cfg, reporter)
+func newHTTPServer(
+	logger *logp.Logger,
+	cfg *config.Config,
+	tracer *apm.Tracer,
+	reporter publish.Reporter,
+	sourcemapStore *sourcemap.Store,
+	traceExplainer tailsampling.TraceExplainer,
+) (*httpServer, error) {
+	mux, err := api.NewMux(cfg, reporter, sourcemapStore, traceExplainer)
 	if err != nil {
 		return nil, err
 	}
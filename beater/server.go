@@ -28,9 +28,11 @@ import (
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/version"
 
+	"github.com/elastic/apm-server/beater/api/tailsampling"
 	"github.com/elastic/apm-server/beater/config"
 	"github.com/elastic/apm-server/beater/jaeger"
 	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/sourcemap"
 )
 
 // RunServerFunc is a function which runs the APM Server until a
@@ -55,11 +57,21 @@ type ServerParams struct {
 	// Reporter is the publish.Reporter that the APM Server
 	// should use for reporting events.
 	Reporter publish.Reporter
+
+	// SourcemapStore is the sourcemap.Store used for applying
+	// sourcemaps to RUM events, and for managing uploaded sourcemaps.
+	// SourcemapStore is nil if source mapping is disabled.
+	SourcemapStore *sourcemap.Store
+
+	// TailSamplingExplainer is used for explaining tail-sampling decisions.
+	// TailSamplingExplainer is nil if tail-based sampling is disabled, and
+	// may be set by a function wrapping the RunServerFunc.
+	TailSamplingExplainer tailsampling.TraceExplainer
 }
 
 // runServer runs the APM Server until a fatal error occurs, or ctx is cancelled.
 func runServer(ctx context.Context, args ServerParams) error {
-	srv, err := newServer(args.Logger, args.Config, args.Tracer, args.Reporter)
+	srv, err := newServer(args.Logger, args.Config, args.Tracer, args.Reporter, args.SourcemapStore, args.TailSamplingExplainer)
 	if err != nil {
 		return err
 	}
@@ -84,8 +96,15 @@ type server struct {
 	reporter     publish.Reporter
 }
 
-func newServer(logger *logp.Logger, cfg *config.Config, tracer *apm.Tracer, reporter publish.Reporter) (server, error) {
-	httpServer, err := newHTTPServer(logger, cfg, tracer, reporter)
+func newServer(
+	logger *logp.Logger,
+	cfg *config.Config,
+	tracer *apm.Tracer,
+	reporter publish.Reporter,
+	sourcemapStore *sourcemap.Store,
+	traceExplainer tailsampling.TraceExplainer,
+) (server, error) {
+	httpServer, err := newHTTPServer(logger, cfg, tracer, reporter, sourcemapStore, traceExplainer)
 	if err != nil {
 		return server{}, err
 	}
@@ -69,7 +69,7 @@ func newTracerServer(cfg *config.Config, listener net.Listener) *tracerServer {
 }
 
 func (s *tracerServer) serve(report publish.Reporter) error {
-	mux, err := api.NewMux(s.cfg, report)
+	mux, err := api.NewMux(s.cfg, report, nil, nil)
 	if err != nil {
 		return err
 	}
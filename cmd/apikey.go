@@ -67,7 +67,7 @@ Check the Elastic Security API documentation for details.`,
 
 func createApikeyCmd(settings instance.Settings) *cobra.Command {
 	var keyName, expiration string
-	var ingest, sourcemap, agentConfig, json bool
+	var ingest, sourcemap, agentConfig, sampling, json bool
 	short := "Create an API Key with the specified privilege(s)"
 	create := &cobra.Command{
 		Use:   "create",
@@ -75,7 +75,7 @@ func createApikeyCmd(settings instance.Settings) *cobra.Command {
 		Long: short + `.
 If no privilege(s) are specified, the API Key will be valid for all.`,
 		Run: makeAPIKeyRun(settings, &json, func(client es.Client, config *config.Config, args []string) error {
-			privileges := booleansToPrivileges(ingest, sourcemap, agentConfig)
+			privileges := booleansToPrivileges(ingest, sourcemap, agentConfig, sampling)
 			if len(privileges) == 0 {
 				// No privileges specified, grant all.
 				privileges = auth.ActionsAll()
@@ -94,6 +94,9 @@ If no privilege(s) are specified, the API Key will be valid for all.`,
 	create.Flags().BoolVar(&agentConfig, "agent-config", false,
 		fmt.Sprintf("give the %v privilege to this key, required for agents to read configuration remotely",
 			auth.PrivilegeAgentConfigRead))
+	create.Flags().BoolVar(&sampling, "sampling", false,
+		fmt.Sprintf("give the %v privilege to this key, required for explaining tail-based sampling decisions",
+			auth.PrivilegeSamplingRead))
 	create.Flags().BoolVar(&json, "json", false,
 		"prints the output of this command as JSON")
 	// this actually means "preserve sorting given in code" and not reorder them alphabetically
@@ -159,7 +162,7 @@ If neither of them are, an error will be returned.`,
 
 func verifyApikeyCmd(settings instance.Settings) *cobra.Command {
 	var credentials string
-	var ingest, sourcemap, agentConfig, json bool
+	var ingest, sourcemap, agentConfig, sampling, json bool
 	short := `Check if a "credentials" string has the given privilege(s)`
 	long := short + `.
 If no privilege(s) are specified, the credentials will be queried for all.`
@@ -168,7 +171,7 @@ If no privilege(s) are specified, the credentials will be queried for all.`
 		Short: short,
 		Long:  long,
 		Run: makeAPIKeyRun(settings, &json, func(client es.Client, config *config.Config, args []string) error {
-			privileges := booleansToPrivileges(ingest, sourcemap, agentConfig)
+			privileges := booleansToPrivileges(ingest, sourcemap, agentConfig, sampling)
 			if len(privileges) == 0 {
 				// can't use "*" for querying
 				privileges = auth.ActionsAll()
@@ -185,6 +188,9 @@ If no privilege(s) are specified, the credentials will be queried for all.`
 	verify.Flags().BoolVar(&agentConfig, "agent-config", false,
 		fmt.Sprintf("ask for the %v privilege, required for agents to read configuration remotely",
 			auth.PrivilegeAgentConfigRead))
+	verify.Flags().BoolVar(&sampling, "sampling", false,
+		fmt.Sprintf("ask for the %v privilege, required for explaining tail-based sampling decisions",
+			auth.PrivilegeSamplingRead))
 	verify.Flags().BoolVar(&json, "json", false,
 		"prints the output of this command as JSON")
 	verify.MarkFlagRequired("credentials")
@@ -252,7 +258,7 @@ func bootstrap(settings instance.Settings) (es.Client, *config.Config, error) {
 	return client, beaterConfig, nil
 }
 
-func booleansToPrivileges(ingest, sourcemap, agentConfig bool) []es.PrivilegeAction {
+func booleansToPrivileges(ingest, sourcemap, agentConfig, sampling bool) []es.PrivilegeAction {
 	privileges := make([]es.PrivilegeAction, 0)
 	if ingest {
 		privileges = append(privileges, auth.PrivilegeEventWrite.Action)
@@ -263,6 +269,9 @@ func booleansToPrivileges(ingest, sourcemap, agentConfig bool) []es.PrivilegeAct
 	if agentConfig {
 		privileges = append(privileges, auth.PrivilegeAgentConfigRead.Action)
 	}
+	if sampling {
+		privileges = append(privileges, auth.PrivilegeSamplingRead.Action)
+	}
 	return privileges
 }
 
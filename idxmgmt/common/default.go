@@ -28,6 +28,10 @@ func ConditionalSourcemapIndex() map[string]interface{} {
 	return Condition("sourcemap", APMPrefix+"-sourcemap")
 }
 
+func ConditionalProguardMappingIndex() map[string]interface{} {
+	return Condition("proguard_mapping", APMPrefix+"-proguard_mapping")
+}
+
 func ConditionalOnboardingIndex() map[string]interface{} {
 	return Condition("onboarding", APMPrefix+"-onboarding-%{+yyyy.MM.dd}")
 }
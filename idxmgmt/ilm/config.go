@@ -263,6 +263,7 @@ func (c *Config) conditionalIndices() []map[string]interface{} {
 	conditions := []map[string]interface{}{
 		common.ConditionalOnboardingIndex(),
 		common.ConditionalSourcemapIndex(),
+		common.ConditionalProguardMappingIndex(),
 	}
 	for _, m := range c.Setup.Mappings {
 		conditions = append(conditions, common.Condition(m.EventType, m.Index))
@@ -148,6 +148,11 @@ func TestIndexSupport_BuildSelector(t *testing.T) {
 			withIlm: "apm-7.0.0-sourcemap",
 			fields:  common.MapStr{"processor.event": "sourcemap"},
 		},
+		"DefaultProguardMapping": {
+			noIlm:   "apm-7.0.0-proguard_mapping",
+			withIlm: "apm-7.0.0-proguard_mapping",
+			fields:  common.MapStr{"processor.event": "proguard_mapping"},
+		},
 		"MetaInformationAlia-lowercased": {
 			noIlm:   "apm-7.0.0-meta",
 			withIlm: "apm-7.0.0-meta", //meta overwrites ilm
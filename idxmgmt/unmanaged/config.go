@@ -71,6 +71,7 @@ func conditionalIndices() []map[string]interface{} {
 	conditions := []map[string]interface{}{
 		common.ConditionalOnboardingIndex(),
 		common.ConditionalSourcemapIndex(),
+		common.ConditionalProguardMappingIndex(),
 	}
 	for _, k := range common.EventTypes {
 		idxStr := fmt.Sprintf("%s-%s%s", common.APMPrefix, k, "-%{+yyyy.MM.dd}")
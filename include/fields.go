@@ -32,5 +32,5 @@ func init() {
 // AssetBuildFieldsFieldsYml returns asset data.
 // This is the base64 encoded gzipped contents of build/fields/fields.yml.
 func AssetBuildFieldsFieldsYml() string {
-	return "eJzs/WtzGzmSMAp/71+BVxvxyp6lSqRulnXORDxqSe5WrC8aS57e6fGGBFaBJEZVQDWAEs1+Yv/7CWQCKNRFEiWbbrtHszsekawCEolE3pCX/yC/HL5/e/r2p/8fOZZESENYxg0xM67JhOeMZFyx1OSLAeGGzKkmUyaYooZlZLwgZsbIydE5KZX8F0vN4If/IGOqWUakgO9vmNJcCjJKXiTD/2SfyuSH/yBnOaOakRuuuSEzY0p9sLk55WZWjZNUFpssp9rwdJOlmhhJdDWdMm1IOqNiyuArO/SEszzTyQ8/bJBrtjggLNU/EGK4ydmBfeAHQjKmU8VLw6WAr8gr9w5xbx/8QMgGEbRgB2T9/xheMG1oUa7/QAghObth+QFJpWLwWbHfKq5YdkCMqvArsyjZAcmowY+N+daPqWGbdkwynzEBqGI3TBgiFZ9yYVGY/ADvEXJh8c01PJSF99gno2hqUT1RsqhHGNiJeUrzfEEUKxXTTBgupjCRG7GernfTtKxUysL8p5PoBfyNzKgmQnpocxLQM0DyuKF5xQDoAEwpyyq307hh3WQTrrSB91tgKZYyflNDVfKS5VzUcL13OMf9IhOpCM1zHEEnuE/sEy1Ku+nrW8PR3sZwd2Nr+2K4fzDcPdjeSfZ3t39dj7Y5p2OW694Nxt2UY0vJ8AX+eYnfX7PFXKqsZ6OPKm1kYR/YRJyUlCsd1nBEBRkzUtljYSShWUYKZijhYiJVQe0g9nu3JnI+k1WewVFMpTCUCyKYtluH4AD52v8c5jnugSZUMaKNtIii2kMaADjxCLrKZHrN1BWhIiNX1/v6yqGjg8n/u0bLMucpQLd2QNYmUm6MqVobkDUmbuw3pZJZlcLv/xsjuGBa0ym7A8OGfTI9aHwlFcnl1CEC6MGN5XbfoQN/sk+6nwdEloYX/PdAd5ZObjib2zPBBaHwtP2CqYAVO502qkpNZfGWy6kmc25msjKEiprsGzAMiDQzphz7IClubSpFSg0TEeUbaYEoCCWzqqBiQzGa0XHOiK6KgqoFkdGJi49hUeWGl3lYuybsE9f2yM/Yop6wGHPBMsKFkUSK8HR7I39meS7JL1LlWbRFhk7vOgExpfOpkIpd0rG8YQdkNNza6e7ca66NXY97TwdSN3RKGE1nfpVNGvtnTEJIV1tr/xOTEp0ygZTi2Pph+GKqZFUekK0eOrqYMXwz7JI7Ro65UkLHdpORDU7M3J4ey0CNFXITtxVULCzOqT2FeW7P3YBkzOAfUhE51kzd2O1BcpWWzGbS7pRUxNBrpknBqK4UK+wDbtjwWPt0asJFmlcZIz8yavkArFWTgi4IzbUkqhL2bTev0glINFho8he3VDeknlkmOWY1PwbKtvBTnmtPe4gkVQlhz4lEBFnYovUpN+R8xlTMvWe0LJmlQLtYOKlhqcDZLQKEo8aJlEZIY/fcL/aAnOJ0qdUE5AQXDefWHsRBDV9iSYE4bWTMqEmi83t49gb0Eic5mwtyO07LctMuhacsITVtxNw3k8yjDtguKBqET5BauCZWvhIzU7KazshvFavs+HqhDSs0yfk1I/9FJ9d0QN6zjCN9lEqmTGsupn5T3OO6SmeWS7+WU22onhFcBzkHdDuU4UEEIkcUBnWlPh3jiudZ4vmUm6V9ov1Qc55nKVWePTVPzMknw0RmxbAdsoGaidtf3AtPs05hQbZsNRfhBjAynDYqFj3jwYmiiFjUM8KQltJLJW94xgZW8dAlS/mEpwTfBgWH66CGOUxFHKVgRvHU0kjQO18ke8mQPKNFtrfzfEByPoaf8et/7tGtbbY/2Z9sDye7w+FoTLd3dtgO293J9rOX6Xh/Kx2Phi/SAKJdjyFbw63hxnBrY7hLtrYPRsOD0ZD853A4HJIPF0f/EzA8oVVuLgFHB2RCc80a28fKGSuYovklz5qbx9x2NDYwZsq3suXOxvo5CM8sh5twpvD0c+3OwTM+AQECUkY/b28xt5qIKkC78wo4TZXUdiO0ocqyw3FlyBVSCM+u4DjZg9TdoX26YxE9aSCivfwO7T5q6R8E/82qpw9fd1CXLIdBvgTvzUEvGzMCXIj3EKBbXtZYnv13FQt0Wiewx5ihd3ZQE4pPoTRDDWLKbxionVS41/Bp9/OM5eWkyi0PtBzArTAMbOaSvHL8mHChDRWpU0Nb4kTbiUGmWCJx2hCptSFWUgWcIYzNNRGMZWhDzmc8nXWnCow5lYWdzJpH0bpPJ5Z/eMEBS0WJ4r+SE8MEydnEEFaUZtHdyomUjV20G7WKXbxYlHdsnxdWdgJC8zldaKKN/Tfg1qryeuZJE7fVWVP4rlXGkho1IojcgNX6WSRxN9GY1Y+ABsInjY2vd6xNAI3NL2g6syZdF8XxOB7PjnGvANV/dyKhiewWTHvJMBluqHQr1kJ1QwWtjBSykJUm5yDR71FHDwWh9SuoBJBnh+fP8WA65dIBlkohGBj8p8IwJZghZ0oamUov35+dnj0nSlYgDUvFJvwT06QSGUM5baWvkrkdzHI3qUghFSOCmblU10SWTFEjldVXvY3OZjSf2BcosepKzgjNCi64NvZk3njd2I6VyQIVaWqIczvgIopCigFJc0ZVvqglINgoAVqZ83QBdsGMgcpgF5gsre+IqhgHffQuUZnLoHQ1tsKJBByH0DyXKejGDqLONjl1MXwdCN7tohvo2eH52+ekgsHzRS1xNNo+AfV4Jk4b645Ib7Q72nvZWLBUUyr478Aek64Y6V17S88Da/IyxmbE0rwZTrqWOQFVWBU61lzIXWpNC9fvIthhvs56f5LS0trr10fRWUtz3jL5jupv7rD5Dt2b9lB5uqPaERo33NI8krjfDnfUnI7rgUNbTrEpVRno+FaFl0IPoudRvx9z9IxyKWhOJrmcE8VSa/42PAwXR2duVJRANZgd2OwX9vEIMjhomolg2dlnzv/xlpQ0vWbmmX6ewCzolCgdq+hMhd4/q8I1JvUmqQKdmmkLhzOaPJaMokJTACYh57JgwYypNJqDhqmCrHmXplRrtQNEsYnnSg4U0VqgxiPmfnbmOu7smAVzFcz1CAHu+FmwxNRvcz1FDD86HhwR+QmslKp0ZRHiRq3tZC4seP+qBG4AmM1oCHuHc89gNX6FNJ0hrQKF+7UBJ9d7+oJ/EMfb9PMEjy4cHlTJaJYRzQoqDE+Bx7NPxmlv7BPq5QNUlrxM1EGHM5LccLtc/jurfSB2oUyBpaa5qajbjtMJWchKhTkmNM898XnOb7nmVKrFwD7qlQ9teJ4TJnSlnKbp3MhWQcmYNpY8LEotwiY8zwPjomWpZKk4NSxfPMD+pVmmmNarsp2A2tHZ4WjLTej0nMBmijGfVrLS+QKpGd4JDHNu0aJlwcB9TnKuwb14ejawZjDKU6kItQLkE9HS0klCyD9qzAa9r9aC8BwoOvcwebq/StwXV4iypjYpCDeRsphV6OJFEXiV8PLKgnKVIFhXA5KxkonMqfOoi0tRAwGeF7djtbaU/NsJaqqTfyNZHXmgFobpe1T1aI/Rj9N8rQHIj/YHdLaFCy939tzWI4vsbsn+TgMwJODPcIk5nozjJI2xp0wmKTeLyxUZ9kdW1+7dhTdWt2fO1dcARwrDBRNmVTC9jZwMYbIOfG+lMjNyWDDFU9oDZCWMWlxyLS9Tma0EdTgFOT1/R+wUHQiPDm8Fa1W76UDq3dAjKmjWxRSwu/uN4CmTl6XkQdY072SkmHJTZSh/c2rgQweC9f9L1nK44dt4sZ3sjXb2t4cDspZTs3ZAdnaT3eHuy9E++d/1DpCP43EtH51masPL0egn1NQ9GgbE+ShQe5ITMlVUVDlV3CxigbggqRXMoC5Ggu/Iy7vgAUJK5go1oZRZTu+U5kkupXICYwAejxmvVdJasiB4OSlnC83tH/4CKfXHV0cgvJUmuiWH6zGOfoECBNuUSb/arp9kLLWRYiNLO3ug2JRLscoT9R5muOtAbfzt6Da4VnSkHEy9J+pvFRuzJqJ4eQ8M4YHGLKdnQbfyjA9lwrPTs5sdqyednt3sPW/KhoKmK1jwm8OjfliakwtqkvZie89k/4LXL6ythybL6ZmdyCnwGNDz9vAiWMPkGUumiXPh0Dy22gmaft6707hPCAcgMgCthQk+PzEluaQZGdOcihTO44QrNrf2BxjcSlb2mLbUTbvoUirzMG3TayLaKN6vgsbYsON/L/hAQ/MBSllj1Wf49qNUsK0mHJ09WUYzvH0/ztwe3Eb8luVowxTLLvuUv4fLJmtRzPh0xrSJBve4wDkGAHBZssyDpqux1xnDPr+qL1BQxkTDOQNwIhWE2CTuuSSVxZq14dfiL9o3Oxhs5G5sMmaYKkCSloqlXFsTB9wXFI1OuKaGIKtqnPOU6Goy4Z/CiPDMs5kx5cHmJj6CT1jT5nlCLtTC0qSRaK9/4lZyoXQcL4jmRZkviKHX9f6hkZpTbeDaACON0B4W0hCwteYsz2H1F6+P66vxtVQm1fVaVxRG2GjsfkD7qpwBF/HeAnEHNWVS2SP8W0Vza0uGLcWrJgzpiNSGPPekAjoCYZ9SVpo68gJeq935HbJO4AqHkpIqwyMPFulAAEyC41z2v+531DJqXQqUnsruiZ05paJ2YZEmXQ0iDIRQrM6CxiyX834y7z8TzXMT43ZtPp8njGqTFAs3AhIGngyqzVp0sYVAuFFmVNeRVLBWEJ1hmkFNa7oabyW6Go8ah2/QIOIaPAx5cD4UH+pQj7E2wDMnpGXkPId7D6a47LkttgsIxHZPsICR5SUsY3nu9mg6Z5OJFUY3zM7qCMWt/hm7eH38fIDXgddCzoV3vzbAIo65DLyfG5iAJVlPK9EhSboMsj1vGDa6i7a7BHTwfXNG4Iq3McV6J5Zjj/B9g24qzVSyWpKJfQN4JSIVXjTYyfGWs2DggJOT28QiFeT18eEZxELhio/DUDGtrHdXxwrK88+U9tYQJTCQV7ST7kSWS/bYNN+0a88ubF3XDB5MWnpDeU7Hedd8OszHTBlywoU2zJFMAwfgkf/DCApmXz1F4SJXFpXVjUzyQXa4Ph88Ab7rzTKnxqrHPQSJcC5JkZ/r9sPJukDMqJ6tihIcpoCP2HkwvFApZu2yTpgidQxHECqkWMTx4GhhRKTyQTMX3nQFq+AZXn3AB7u6qyDcUykmuFc0b8xJRdajL0G4TQ9RrSTK7ZYgN0RZz2Yt4ZH5VjjX+cxafOiug6BgLrqLi1gXBdbVXbKSefuO4osR6KFSFCL5gfBgJu+rhGSXZox/C+D1f65d8zEV9BLCbdYGZE0x0H7F9NIOiLHwd+CsDpqQFQIeYib8F7eHTGAqFMGzFK7WYCgwHMRE0ZAeUS8D7z4x7M4b7xB8R24N9J6QN3VgLtdxhCAV5ORoCy0fe5wmzKQzpsEvG41OuNEutr4G0h7FZkpII7af6xB51gTBjasq4YL2FSukCXFqRFZG84xFM7UhQ5gocVHlfkGedET9qvMpN7NXcNB6IAifd5N7B4sdlusaVIewh9yOp3CzsToxtn5RIwjngrSB+C6RZyEVxLGoBcn4ZMJU7B4DzzmHBAgr2C3D2TBMUGEIEzdcSVE04yJr2jr85TxMzrOBv6cE+ifv3v9ETjNM1oD4mKrNLbsa9N7e3osXL/b391++fNmLzhVK2x6EevZHc071HbgMOAw4+jxcoqrYwWbGdZnTRaw4xfYspm1uZOxmWbPWaaI852Zx+XsdWvDFGXU0D7HzWPxgPANwCmBANWvq8OpKb1hrfWPUulpwga+rO2SnPuD59NhLE4DVs7Y2oHxjtLW9s7v3Yv/lkI7TjE2G/RCvkI4DzHFoehfq6M4EvuxGWH8xiN547hoFW9+JRrOVFCzjVdPL6JKcvwpLdXPFzKrv0DaO6Fl4Z0AOf7diu/6mJ1tmseEmWfa0+vV/HR7oMYD3fMuuHTlXc/X97KpYkIev/4ZnS0U2fXaQhUcBTJj4Vcf5vnSuB4TahQ7INC1rh6VUJONTbmguU0ZFV1Oe68ay8LZ2RYtyl7WPZLexkiszdqn5VFCrkDa0XZkxct745Xa192LGNGsnhjasOtAfx1xQtYBJSZhULx+rjllF95haYylzRkUf2n7En8DgpSWo4BwD9B0sFn0uTLRrWRhVsXtsh+juxFBTrSyK8jDLuIuR7mIZKJ0pg9cSzIHSk8BUobnu0kOnVhlO1aI0cqpoOeMpYUpJhfnbnVFvaM6zOFREKmJUpY2fj7xm9IaRSkRhwHgM/av1K/581uOHYedWRRPpjKXXfdmJJ+/fv3t/+eHtxfsP5xcnx5fv3727WHqPKqxEsKKIinMcviGwA+kHflfHofFUSS0nhhxJVcpG/tb9NxkWjWwZCXrH8Vg/N1IxtPrirezZHpLOmldPf7d7SiF0vH79tvcgKRUT7n2s7ADsQcvHwpCNSyEp8kUz93q8IEbKXLvkV/BGQjolS6/R4kM67JDMww4yEOtn4rWf76AnFkRKkwPdMIVXjnRqTdvIGzRjNQ8Vpmlz9B432kD+PWdpGcTUggOYvCPjIDPiL+9ILAkPNpMHXFh/p45HVFnAZS87IAMUSATuXsxFlMhJPEhUFCaSVTOWl5HzE9wHGIkShtbOMSEWVrIaHrSeZSTWKv2T9eJ51lT+eUGnKzVGYqUKJgsxrAiQJTTM6paiDzRDpyuCrKYsBxedtm6dolI1d08flay5o2hN20yDWV39l8a8K9yOetF1+F7QQ5FmV6WI4uikoIJOkflzXRNCR4nCUjkRH4lyWGJOctz6+g5eEj1aF5BBJttIdXLRE1AaqZm1FoDElJ9NjPZKmpzCcqgo+wh9lY2EqIELExuQOgkMPGQu3QWRYpEUVQyhvUlhnlf1rC1Ks7ovwQrZ4CRU53Lc77YUomiCVAptTSSWocyhGgpIxWnRmD/jRh375COQOaK5Yn3bhB4NTWR6moxz5BqFtCBMIoztTXkXgdOMKgV444IrcJsA/mPR/5zHQlillg2145vMpGokgi2VThW0Blc17AulU4VhIa3qKZ3qKZ3q3yOdKj6APqDXlQJs78uXzqmKRcRTYtVTYtWXAekpsaoL41Ni1VNi1XeUWBXLpG8iuyoCaGUpVry0s8VLvyeviDUSikrFb6hh5PjNr8/7UorgKIBx9U1lVUEaT+T5cisFf1iNGyPJeAGYOGZQt/HLr3AVeVIP0K2+XrLUrbT8tTKmso7a95Q29ZQ29ZQ29ZQ29ZQ29ZQ29ZQ29ZQ2detkT2lTX4SgntKmntKmntKmntKmntKmGoFBWY7yz1/kv34NH+/uLLJM8CiEzuV8rKjiTJNsIWiBTguPUEkz37zF9YkAr6b7+Q0VC1epOe4z4cqpSrKmZxRqkTTmWXM9PkJOCBgWXiEfV6GpF2jizOB40E4rskYmMs/lnIvpgYfmL+QYF7CRc3Ht5luQZ1dJludXz13xZ++QkYL8wkUm57p+/xzBfYdBhs+uEi373vsg+KcNUCo7a+/A0gBjkfNx34AFTd+dL38L3owwTr6jEN4W5E8Rvd9+RG97y/48Ab6tlT3F+64q3reF6Kfw31vwZFXgpMh2V8QQ3xzv4hQPgkfP6GhFAJ3/fDh6HERbu3urg2lrd+9xUO2625KVQLU72noYVCvi0A3z3Sk3bbFZl5kvaKn9FVPM06FjqxQk4/q6e2yumRIs395KvOa7TM4bNauyU19VeY4Q20k6a28Bf3Tw0SmWH7HvyvbWx0ctiCVUpTNuWBrSxVYQ53z2gcTTEEPVlJngsrDL7izx097OA1ZhRRQVixUt4DTUssRpOmQ28NmJGYEeiUXJc7YBSQdfVJ0oWRIBturVtmJlHrHYMxoHDt2/ODv8ZW/Xoy+/uptmC6QHrmwv2U5e7g2HyejFzmj3AUvkRblKd9chOrlCkkcplXHFJM5O8KSRQ0EcFGRjA2744DESwUXsL2mzV++EiylTpeLCpYRy1/CT0ImBVh2IMRfR7QtNWM0Me33UGpGiQgdrSZOZ1YFkmlZKWRUTg4Gx/ZZrPwl9m4yiwdoC6DEBuKlNKYEP07qb9nw+TyZcMbYARrE5zuV008wUo2bDmpyWN21uDUc7m8PRplE0veZiulHQfE4V20DkbNgJuZgmM1PkXWkyTPf2h9vpDnu5tTWyf2Qp3X25t01ptr2XZZMHEIjvYXkJh+ELBMAFiv8crnV+dnj69iI5+e+TByzFtbRd9Ul203zO+tYCW/746fDEe23g73fB/4Kidu1uBASHmmh0Sjt+ew4f73CovWp0/LETHr89J79VDA6atbuo0HMWNdO2v7tCRM7+YhzOXOiaU7cx82MtSKm4BNfZlGEfUTesG/TZVSY0FKA4gOevnru2tgs/STw63Ar5FBx0Z9cNht2IOG3I6tF4OUkbF/8OBrQS50yxeu9QTeAax+lCia9ePX9IjkdjxUtnk7VYrSAUnLdRihAV7g28e6XpzM1FtOtipZiplIhuFXwPeldJOtJyGYErpmu2cHip0yv8BiCeNfPt0BvZI+MFOTk6r8Mb3mNLLhwLeC5wythxVdTLwR/95ILM7VsnR+du+Hbgqd1LS2NRM1vsNgm/NFO67HOelsmhIQUXvKiKgfsyjOsXVVTaNBpXX9lZrixwkGTUWQbX9QXlwBoIYUiI6UhBQHKo4g19ozUppdZ8jJd+GXSYsnoerd17ztHt00T6AaWapNiJ1KVvrfeRXZLmdGUJRlgzhGL8ZtgQn9qXIcVA52AXdYiN2joc8fRtL+hRMbLPio0DqCJWh8E+PkKweQgYxYpfPkwaXy2ZyLS/6IRiNsB9/NLjAf0aO2J7NEz8//eudtXFXeI4LyPjqiAt0EmJ7cl0s7ErdY46OSFHbw/fnFjCHzOLLPt+fmO1qYgJra9rcoU3kzUrMVFamRS+Ma5UiulSWhQHr3M0CJy/hJwGniSk8WEq7TGdPkOuoK2ez2G6smKERbl50bZAzNYtYXp+a4xZJmDjtpjVC3+9BuHEN+C+tywaFgwY6N0F7xCl6Szm4GwCDKiR/8a1pX+WJeRXpqSvVVOAQ3HmLviQV9YIHNdYwyl68pD6CXWF9aIuZnWtqEfyEqDNpjuL0Yypy0lOp6u7m/E3q1skZ8ZaKJYd4swEZm5UUiqx12xdVOiAHB4OyMXRgLw/HpD3hwNyeDwgR8cDcvyuxw37z7X3x2sDsvb+0F+63lZN4ItujV0Txm/HV/hUw2VG5rWLUsmpogWSHrrOTETBGNvJlGvaFw0EaeElrxMkkS3oHot4azQaNdYty56EkS++eHc/KgVe4qCihPUm3CXJNRcQXI16aEM1JaRgWtMpS+KgP67hTtjhrm7nicG6OAyquoAZuLqOx7wVR3/7cPL+Hw0cBZ741XQC15jVyQk0L+4V/w3W/SXkP4i8FgixZAvO3Fa9UCHFBrggoGN6OqOKpsYaDs8waHh7CzKeLT7IaGvveRyDK3XjjZpZB4MGG+UyndLSnh2qGRkNQUZMYY6Px8fHz2uF+keaXhOdUz1zBtpvlYQs0zCyGyohF3SsBySlSnE6Zc4K0Kht5jzKe54wlsUjpFLcMOUSQT6aAfmo8K2PAuiMuRu1h0nRsJ+PSXx4tLrTlxDxlATxLSVBBLr4ytkQvOEMcCu8K4Whwyy+o6D9+Xzej/SnCH1kgU8R+g+L0K8J6OuYAc4auluDODw8bObBe5P08nOSRg87Hrc8J6dnVmFjUFnzKvZUXLVcCf7HK++5c7TDJxOeVjk4hCrNBmTMUlrp4E2+oYozs/AmUEypBTXamn52KAdWQk4+GeU7sgN8UX0XD6iZMQVWP3gyI+Rc1bopvWYwuPdOYfe8jH2ybxeWSuKhUS/Al+B3RjWHKMkwYt37HNUVq8lOZE/t7/V/rkXOEWvX1B9HbQPH67tfQ933c/Vnir99B3FoDehWeCjW41MRvPE+XCgbOAxbjRQIrym2oLd8XfUu8uZDGNWU3zANXeWje4BGm3l4LFUsDtPLhA6jTBC2tkN/WShqALx33vnuG0C05pfClzcqmXLrfyZL9KLmCzuEljJIFGeT4bF4npBDkUEzgVSK2jztVOKyh+r2WwXvl7fWmmMGHfoODtxQpDZt3NecHN13X/OGGboRO519kUPnVV6+Pm7vhXcUSKPYbxVXLIN6oV8guubk6DzcfoMAC/i1i9HEyIRcsVQn7qErTJfxYNTcD1Qi4DmVNljmF66a89yRUERpv8yYwD2DDUyV1JGmxkXGU6bJxoZzgrqLCAuQxafO+XRm8r6OCdFq4P0osDtncPdt2FS5m2aa/cuC6hPS0xkraAv/pBFy30M6o2SYDGPKUUo26mmehC+WDp+nIrpVc9G+QL4L8F4EPH7QDFk7KA74nLvOKUsGdbRyhv05LJo9I4CMlpRa8TNHsRO8Fbj33GiWT6KUXIGjP+BObUW1QQCZ6NppXRcggHd62laUIOMDl3ogcO6ke8CIUlx6FutdUo2BtaHp9aVVK76n3MELDPBNoU5iysIdDmDOEmWZw50e+9RqYbuk0L7LzxTL5rBbg9i6cLUEqdaN2AHMpw81IqKj/y96Q5OcimnytsrzMwm3Byf+8Zgn3HgW5XlC+OJunuDOY199XQge/mRuSTTIpbc7sBSv4mnjbAcWcmgfJVBuyBUZ1J3aj61ql1BicIbnLvCaWuV/LQOnAUHgKhbUOUzUhKstMJnEtB4j9DCwE9WLcOP5oahPDbLUBOlN2K4I+yDV1TidJxwtk1CQxI3p76XBiItLcQywSqIfpO5ATcbMzK2+TuOSk7RZnBIn44IbDgHcdqtyqe3aDv1O3I9uqzeFAqRwoS0qrHGWk4JRXSlWYMspkd2C2egxCBo39JoFGo7RHJNHjeOCFRLCQ5i2w/jhshrTrhToDQ88yLAC3O+VYgk5Z7jnV5isZgXXFS6bG9f3AA6/D4WAhMtwwx6OcBwp4CCFQp/GGtwNobxc66clipw9WvfH0YPC768MXLZe010RynthaF4criCit8gpVMQGEqhVyhkVHq8pNWwqQY/344fNtQzjChCyQbPsakCu3LnZgHPD4KsJz9kGqu3ZFd74+HuPBtcHfT0KJnERhTlQWF/DqEoztVFSrS0yNzAmqKkjONBXsx2YTAUHaUIm1qyxuuERzulrUGKUFZrKoHVSgztSe7XA+HCuKbc1diAPPJlxpqhKZ3FMentvanUOt3ttzKdkXEGlpDULXzQiZ7rpHos07Nww5bhda4oDt7NXZOGERVC7sZGdc1e5x8KYkILDzcLdeKGmzDXyrHwRN8FzM9pNufJhmdy13qJxlTpdjT1YbaoP43uzzM0LzjCa53JuIbS2YtrcKCd33JIinxo1Vt+ArQn2Q4TJrqlXmZlV3aJyh7frrF/ONXDarHAMGmwIZXOVp/kE7WXIrowwFxUJ9qFUlWZBaGRMN1qfOYVRk0pEFYMHRLEpVVke7z5wf3iaWD2msn9IRezywC4D+wgFjbxhCqQMRAx7lclrcDzeEuYjJlHPIafH3W3Y2dvZbyIfOdA9vCCrnQtN/LrTgIN0eh+yTZCPc18x2hVMppYgVZScpRgF3mapcwp7IpX9DF6RkpdQQPtWms641SFSV/bs/0AZZkOLEtkGNfFXdWVGB2sDfwAtQ7ehL1QeXT7nHZFyKkhhRbLmpkLjduBCAc1ckjCtO2hj1mNCI+v3H9M4+KQR+J3SPIXkNFdDLYcoGFSMYu+RiytwcZBI4jWTiNUW2BZ4FZCOexKyvzPCjeMSLUgKKbiRddxdPcT6Opi5fsfsR9/izkhyzVhJqhLvA+Cl+HA1sWrNZIS0iUcrWvHEpTQfxDtbX9ZGhRtiX+rWcLS3Mdzd2Nq+GO4fDHcPtneS/d0Xvza9qBk1VLP7at99fjkUnKYVSCYaGME7ErjWxsh7q37IqGmcNSGk8uIGKzPStCFncjkdODsvl9Png3jyIEWMdDrOoi4BHp3XVBZR4TPsrVqDDZsOWQcF8GzI6xfSBE8VDG/1nsbcYOqFoLZCZlVekz4WuMHEf9R6KMmkiWrPx8P0CJuSpjOWRLgI21upZert9tQ2bL3JRVmZS/+joEK6wDVv/1UmfoDqNzzPee8zeFMGNDLqJZxjN3XDJ0bgTi9M26Qk5FOIdXvm8TOzZpNi7jbR1Ld3jTjEPl7kGQ3MLjJvCtg95Z3SO0wsE2p1m0ipQe1Ik7YgQXqzgtN/79WqALiVNXD5J8dgLraavawwCehnqmfkWcnUjJbaHj5t7DdR/s5zuMWjcyfJDDRLoHjBFPl4Cim0UXb54DIAR6rVHDse0dBms++vwx+Pjr+al+702K7Gm1p3lE7ZpzuT3eEwa0ImpqyboL+8TnIRZALQReCqVCl+4wMmGdSCVjR38Z9Gqo6GAbqFr10CysBVLXBiXbxFl15dyBchnypxnLKWxLmWndEb2lQ8QcGoMHEOPGbXWHkdNaghQYEims57beBT4YxKe7rQ6LdmmNZVYTUGIYldG1g7g6ApONnrr5pmSgqZy2mjgIwVNfLa3+9zfdDAFfl/24urv/HbfbWUzN5NRsPRr0tn2l/zNjP6xuxcH431KEMXnTt4Q2gH2vCjtH2TkDbi1Yb4Z9NpZ+K5LobSQOdJ9ONFF2vG9QYIF5y136TXgnbhvN5qQX6Havu04npGaM6U8YoMnIWGd6wVQIBCqzlaS0fFNZIZVjDVGH4KEDRStSIBR2ZUZDlECc7YAq6+5tZUFiY6porZNYOzsv4S1QxAiJJ5vWpuYBQ46dArDUKptLHEMJ8xyBELAejYvx4u7gxc802rnKoQGV+bjsoqVz0qT94umtXQqVamyOIsUU4IxCrDWtqaorvlduYDGCjIq6oSE2QdWUEdXWsiw9BoUeTVFDSBrielvmancBKE155RHz4EVRDk7/OBPzc48lUrkKxhCtZXEeAGtM/fpmc2sO55/yrw/t4ydfbJBOeBJWdhuAqn74Mj/zu0hluMaKuxQ1ALQ+0uk+ll1No349pqJhk4RrFWHpizkLbLsprorfbvAnEgptcozm68LX11iXvTw+rPWUlGL8lw/2Br72A0RE/30cmrg+H//z9GWzv/zzlLK7sA/EQwcRg6pzGF340S9+ho6P6otUDLC3QF5xSrPGsjy5Jl/gX8X63Sv46Gif2/Ecm0+etWMkq2ki1dmr+Otra3guqfsU99wkZWxtpK37S8sRbVY8WNW9+VD7TLmIBI65iZoRCJ/K7UIx6ud2ozkvLcKjLBx1Iy5eOog0iBPhvow8H0YtdTra3VvJXG5RygxufTbaM+aCTy/WcNryUyEEzRaslCy759TaCI4dfirIWYgZUFzomHYpLXbpJogRHoh1Y6iAC/100phr2BXChl5U048iysDT+7PDEU2WHQOvYWtTS3RjD/64J7dR5rKHsUDHIUsXb0SETqEFSFvFreQPGXeIOX2tabOHLEbWwclfWqUkBPNVqEy211zB68aZA7axVerWXqLv1wH27RQkyD4dWla+zgUXfC1s2tpQw/q5nF3vgDq2RcNbqcU7EIWgzYpRzS/jxgJJMMWW1Br+vd0UzoHuni0NpgMStu+L5+HkLQ+s4Z+pXhVKHE9mGy5wvtnFFdN/RrOY3crgXqTw1ZW8e9eVvNi5meVj5Ey4mZU8XuSqNyhwU0gPOFLqzCNjOmzJ6DaxlOlq7GrnucG7hd4zGM+Ayr+gzqsjEbbokbXixtHFbWmhLT57cVOWpso2JUr6y4yvp7GJ3MZ4s4ssxf9neZVNcD23NVakcDvEHDghS0U8daLUYdgYc72MZtahj3F4h7cmcI375q8hQ3ZOAf7o7GvYJ4u+pp0oSLdcXt7OLD1XurmjSZs7E9Rp98YLpowRMNaU9vxgR3YkcxiPGutfogG1rgBTba2GcEEonyapzL9JplRHPDrnqI5gLi6IEjUUEqwXxqZFP/vdcAhpKqkS9vBcTmJiAf3r8mORfXPkL/7qqcni7bVOdHwTKwEHDA0ziAQfpOGGEEchiZj4Og+DSqO0QW8wHYSlZYK4YStpACrvZA7IbrQeyv2dkZX9DGdaaMciQ2YY7N/xgOwfG29BZxfX2pIz3xNs1xkkvaG7H2nutrAiOAsaS4VBwD5dvMUDt+RbTMK/D+RJl0HzRzV0mwNLjMcRdfqA/Y05vcAvulkKpYgshuXcT6W3BM8d9ZBsPes6ABRsTolMJ9aFjE0NLNaDjsceYVlLtivK5k+EJWsO/N6xUnFZCbQAqvjgDSzds0O8TcOec0s/Qk6mUg1lyYLWhKWDy45TDXlq8sd0Qf1tvq3A3s+6PeItYhPrD1KAQbI/z+GgouYnTnUnwAd4L0ullwgH2iqSFSZS5yIjheotvx+G48HOvgvA3XIh1s3bCoHeAXaU+F2bAY6hUmaJ6fhtC86/byl1BYIBgMYcS4AEGU9oJP+UsWH2xAo+B7z5104m7cqtIL7ygYKOwEhI65WTmL+lJrE+vejjJjvxuoA1bb6q0C4vS8sJ4xs2iG0mZXuZwmGn5P/O9JKjN2lXjm67+uRWzs2q5Dr7ESj5uio6w0rkiRq/n2avXRPD0+f95qfe3eCCq4I2vCjSZyLsKMmFdhZXydMBHGTWWJIVi3LzeK2QkL7kqRF02aNnSp1nZ3X5rhjdy912YuCC2+OIsoAi/Q6iCNW27O7Dn9vW4VvYKcnrsN1caS7IGoGYfd4bAg9Gu5UFgHc1MfyRWjmdfLnLD2hF7ffkRiEg+gJw4ssDfnumHVpykrMTs+TOrT1KCoBbXHXwow/06P3eRrJ5WSJds8LLRhKqPFWpQ5T8djxW7QzvWPn1+sPUezk/z880FR1MyE09w/tTHcPRgO15632Gg3tvsb81SZGVePDACEWLmmE6oV17amq/EGRgKugaQfIElhVF0kO0itzHeiC5E8kacPCBN2v3UULuj4aga37TJyfuGiIIW1VHZLQel0jh2fHegaJN7ir11pIJ/zLS1K1lZVKrVMwZ/H9YhpmQ8Cxobagl4jk64jdWWP8A3Thk/96ppeniUsC4GFMd3QmJDDxUbGSjPrjI4iyd2A1Q4fvNwVcUqFSz0UYHySMqcpu9U+ucUuqY/8Z9knxaLHQoEpNne3Xowylo03Jrvj4cbO1mh/Y//FZLixQ9Od/RdDur0/YXdbL54eJtxdMbkMi1f+8x0JFodYYrkVjQ/FXjq3k5DooMnY6kXNUEWXMGB/hchNHyJvx3YL9/v/CmpQu+pwTu2KvIZwwOG+we+Qz0Hwn6nINqWqF0saMVcDV9UkuKjHC5zy1N+6kDf1ndc/X52++R9fLVPX2QZWyPKU6ecJvuyST5zDrxWRD54SyFhnGWKztR5/HKOYBOfVfFDUPkYCfoZisv6auhgFF7KQYyl9P3SvE997e+ut1Bg8CGVhwQuFDuee4CNqjOLjyqysVVBd0QrxHuaLxX/40vXSBPZ8Q9XC0kZoBEZ+ZgqDJKGiDvs0o5UGTznUQZATJ1ua3NpyheAN8tkc7nhCge8bNoBrA8hHzwZ16zUro6ClSXxhxz6xtDJsQGY8y5gYQDAu/itFvhg4Djkgc8VNj5d6/Z9r/tm1AVnDp+/tePTU4+apx4156nFDnnrcPPW4+XP2uOlNLHmY7gB6EIwDyiCUDF9SXYB4TiS2xvtNZSGNgie/lHZTKwRO56IY3wV5eP36Dv4WyhrDMG4DUXOoSvDjXBV2qitn8nF7VpgmV7CK6NrKpZpgFhGWXQ9ePfvowFqaaRjOW5Me7rh4ewtfjazTLy3ijmFwFwahWxfD5rYOJTqjTRC9srMElHflrdc9ZkOthmC25BJYVFx5N862zhS/iQJuoOqpcy9EJn9nJZszWbBNmnsMhxXZ4S5xmM9FfS8RHytQObE66x2rbToggAErlrMbGnmU62aLvTGbUZJOWTJl7Vlk9A03HYjJPDj+4xq2y3IfQM0KG5ggbwqzDAj7ZIH34i5nFP7O5B1hSgHJoB80auaFga2J6cx3Q1Uy/f35ADDf4PmY4CBi9IZ7+Gdr09/XBoDfNRxhree2uXT+Lo++6cqq5Z4pXlgBhR2ST4/Js59Oj5/fecTXR8PhqMmIart11RC221n0tKttH9iv2t3tD2rh9gf2afsDm7HVGSqrS1k+tWPXvmvPUZAb10zDu7jaZ2Vrd297f7t5WgpesMsVFmB7c/rmBLMLvDT0OdEALRirzX5wimijGIXQq/HCRC6OSkNhkqhpEKeCJlJNN/EuHtKiNwuWcboBHur47+TTzBT5P08P3x7WImky4SmnOfqz/2fgRJyv5pdgUayeDEurF5Vgj4xdtcwwJib9hoyIaOk+/3RZQVWsjpLeWEKK0c4Fkak1JwJ10d4CO+vDvZ1hi4Q+U1PuUZSDhkshwB5MmuYxW2Hjh7ftFoWofISqVrVg91kwaI45pbCDMi+k24JUzsXKgjXRrW0nWAfPjoJk2Pvl05INFL+V6lXQlBH6MUb20aC1YUGP6lHKG7pSFim7D1PKN2/b46f+jU/9G29f7VP/xqf+jU/9G5/6Nz71b3xA/8YoMo7//sC42B4/jR3EHl8wNSJKfxf7sFAZgLpsLoCIa7JmP/aUfx/tbe/vNABFcXz5nSlXF6hEgHoFMUiLAkJkHlUH9NFKLBhQz5DaMq4gsMNB8rxDZSEKI8QkrbS1k1WswU/1AfxUqg6hj2rRPjtvObFQL1/GlfVpd/gyoTmcQsNvkFmt6hr5tYsrcBefJJrXRSo8Oz98+zxB+wgM5hC20HdVSyszw9B86MQU3SXBlo4r48KX6oJbrWL5x2/PSbxiQp5Bfrw7H/o5+odZQXlev9dF7F8SllNteJqkcuk7KsA917piKkE4lxUVn4N8F9AFjPbZ0VugGwsE3MZHKAzI7azWVZoE3xj5mU9n5FDrSlGRMnIOVVHJ0eHjkFAJs9TdyechAGYhz46eYx299vo+nD8G+KigBMu+hMw/jgd0+3X8mP06+uuH8wF591e/b6ciHZB3H/7aag41IEdv/3rH3oYj8ll7nMuU5p38iS++yX4az1deP++oQ5YMLEf4O2fzx6xEqikVLsB1xauJp9Lk2bvPOLSnIv3cxdL8shJ8VSph35ppTuyMdukfHrH2vi5oD1w/VB6+lOoS1NHVJTQGEQmVjiH7DOcLAvJiQM5BRTnrkPQRzflEKsHpg5YopLkE82+JNd3mYb3oVKKOtwYqfICWDMak0DyDjm0QvtLZrq3h1nBj+GJjtEeG2wej3YPtl/85HB4Mhw9eFXZlXeWyMElliSWNXm4M92FJo4Od4cHW7iOWhC2pLq/Z4pLmU0vrs2VyHh9Dh4d+/OA68KnuWFcB+2dds+5he3/+MLkQLSqt1M2qDhb0v7Tj44J8ke48tw+k7qd6WSQgGCMPgvCDLnUeN/4OpoMEwbUpd7dGj8UE+1RKUefKPcb2PHFDhA3MGDifW9sXgjOXWNXe7u72C4/1dhmaR6zyM61rSBy1trWzfKLd0yVN0ebmpquubw1dGeJlYdZMcZpfYnLqigjUFS/Eqeo8WF3V1Nov7aDCQEivTBdRCbBJXGYT9ricUZdoOmg2q0ZXng/gl2A65dAuR2R1uEwYuu6h2sHu7u6rH398efTi+OTHV8OX+8OXx6Oto6PDh3GFEHK4ck532uzp0ghkDnGPETf4hdX1ZvG+uPaFgIieQMEcLshPkrymYkqOIMaZ5HysqFpgjwTv15xyM6vG4NKcypyK6eZUbo5zOd6cylEy2tnUKt3EIOlNixj4J5nK/3i9vf1i4/X27nYH/xiysPFQPuyM8j/GEtXBFPVgtFelZ1SxLJnmckzzoM0JtvTVRGuRf4Sl+ZmGpgf+a1qandh+57rB4li3mJrnF3+tVdEBef3XcyrIK2tEcp3KyBQdWHMkAcPzy+7vN2NlNlb+qKX80WbmbQeysYWfvbJvwKZsLfRha/kz24fulnW16s/f66tcO6nTRzpUt3035CFCk+Fhc3mhP7mPd6SF/sRk3IkvpUotsKQoJjnROuAKQpItrFGblJBb0cz9BeV6ymR4Jc6eCl2LsTA0FsBg6QwUwbq6mYXs9MxrdVK5+1y1oauyzHnIlViqQR83i1XlGx15Rti9eZTCKEabRcgwl5qJleU/vW3kPbnJut1ipTIzcohttloAgvS+5Fr2NLX9MihzCsLp+bv+XrZHh70grWoHHTi9m3hEBW1lO3iqvgeUKZOXpYyjSGKGJsWUG+jvJjKSUwMfujcs/5es5VKsHZCNF9vJ3mhnf3s4IGs5NWsHZGc32R3uvhztk/9t3m59Ad1o/YM9aj5VvBVOQwMKBj7PBYsryAmZKiqqnKo4ZdHM2MKyFoZMJboLPopbIESX4Fy5As1QYQf7u5BJLqVyJuIgWHndqnQIXk7K2UJjIU7Q2gbABlBgNPMDokqJ4DXgwtqZsgAuF7Gx7o30WGojxUaWNvCv2NQKjhWeoPcww10HaONvR30wregIOXh6T9DfKjZm6Q99eQReToUvbpdUFzPmkgOiBpE9ZYzgGV0nbbeSZeJyRsu3KW506f/igU+NFjUhA8qEBUNVsIK5YmJxydZGjUVBXh8fnllJeYiVX+tsKoQ/7ttyW0OKL+3X6Wkdi4vCMvguz30zZOc/ho99rpQEgJIfehqUOPr82X++p4HpDHuNAHnWFFnXGoPfg08l9LPkqh0OBnV6gl9FeZeBfZ/5nkNvjncHkCDyHOi8VMxx64QcZpkHYxJKXWBImxtivICa1Cql2gfzNoFDZky9r8dV0YfagJqVVFEjlee4VDeq6jzTgl5j2ZQBwfqHM7p9uTvaev4Ale1rp/J8/SyePyaB52vm7oTzJHWjI/DP/vOd9WqgOEy7Xo0rIA0hcZXB5g3aUBEVzTs5Ood3k7/4Q3Br0e1ufReYFMr4upuv2L6JqvlKhYbLfS1oYa0upqcZGT+jKptTxQbkhitT0ZwUNJ1xAfE5Mr3GK0NDuQAFyB7F/6rGTAkGFU5kxh7UC/bWWPkvIv/ftao4N+brBsjv713u7fxREhZloZxEe+dJzYvZ22RsnWiLumcaq692kPV1fZv0DSNKRd4y8+Ppu/OGXIaZXnNRfeoZuwY6mimMCHLfFynvyd999/bi3fm7gJl7nB9TJpNvyGAGcL51oxmB/OYM5xisb8R4tiB98wa0BfLJiP5jjWi7B9+iIR3B9Uca003taglI7iTUn90YsYRp9Butu6KHSrdzX3L5ykNwBYaKPY+KmUoJ7a08kK9OvbnHAP0y9VCd9YlyPa7/cqgDvnwDJprP6UKTCl4ZQMlHVzE6OBEKRgUXUyhg7rr3MnHDlYTE6LhXR+gkgHE3CiNRXNuoqzGjBhjLVRsL5T1YCA80213C+sp2iHawoWi6AuT+7DbztllXdCoaSlyXPuH2wgW7A2VGVBlR4wfBP/mC8I4hQmuq3yqaQzJ0GDPSzUDdp8ha3XVIHZ1SaaYSV83dGskkYynPoEGTVS+BlGomLu3zrc2XOpnQguerurZ9d05wfPLMX64olkH53YyNORUDMlGMjXU2IHNUb7sJIPhkB+4qX6Y07beSeNMxU3B3m1nNIbsSEwBvUVVpavH6Rv6L3rA2VqLeMyvYzfYacLYANpjJis5d4f0O5DvJTjLcGI22NsCW5mkb+scpPt/ansYVBBxqbtvE/25jwHsjv9YO+vnc+bT6mtQDUo0rYaq7ziRVc945k6tNQu4AvyzdjYbJaCcZNaBdWTl013S1JSashX2UyyoLxrK34+umV05LwVQqaKx7ZbaSgmW8Kq6gucFN0epu1rDUg89mAJ7b2nXgk4rjq/Barwgj9ukXrWrg5ZJlQW4LID3H5uW1ZhaKPaMbvLlt21u7zemtvPujLkQgL3CV9yGwOsh7+0zbz5p+zQS6pDuRFZ+PjGT7w/itXdi6BrXJi88JoTeU53TcUwzjMB8zZcgJF9qwFrMCHODty5/3hi1a5Dd92RbB+bXv3VpArLLIhMMU8BG48YL2BgpDmhq8eQI6PzIcQaiQYlHw3yMDAVEYPn4IDa6uYBU8u7KUgh+8dYz2SSrFBPeqXVhaZK7PbxjWl7bqIaqVmM5dUnK7BVN2gXi45+EP41znM6l8qQUoxV171OvFNYo4jdvtveE5JfOV5YqHOvdAeDCT9w5CIa9mM7EWwOv/XLvmYyroJc0KLtYGZE2xUiqrrl3aAe+tEB98SsY0IjR+vrg4g8+339i98vfeITjQvhR6G0GbbHQXVSr3bVw0wx5uJqIZux0q9yt17R+Xj9XwL4xltkjiMncP7PAWv9oko7iuRQtMArO292V//8XtILqKbN+RBnDhvCa4wXeu/GeW55LMpXJd/zsYWMH+XEgs3n3HLj2zwAK3nTFqzYCubTXa2e7ftIKZmVyVIF9voBSnimTMmeIS+s2dHJ2TUbKXDF2xxzyXc2uTTSueQUGCOQ1dTLKDeoA12Lu6gxIpKg095aP+iUaG2BDse/NbxdTCmnRrDT+qnNRgoCstzA43CqViruEOS2nlDn/obembbTcKPMJ6fV163zEShG9BofW5YdAuNiHkXWMgX367oCJrNCHlAoDcSobJsHPx8NPJxYCcvTu3/36w/8jzi/49X3Etz/U33FX+8JQKBNpmgWFVF3V6G2xgT11+qrE3j7dJoR1Nl1cNIpZg/PNXR/jCxgVU5MEzkpAjWZRUeTdZEYNMw6BRHxwSz7a+rkk8rBvVm94zlpdut90uwzSK0bhdDyEF16A9TaHOcppzJkxPdwFe0CnbnPKlq1d5HEPnXrVUuP9d1t97N0zdNyo+2B1m49PixrmcNjpHtWDUpRSafXXRhtMuK9tiIL9/4XbX2m+Xbh4HX1u8OWgfJ98c0H80s3NgfDluF23hF2R3btQefoe/PIbhNbhbGBU6gaovw+UccrErS8+l+uf3P26eG9e7pvdufWfYjAFbreMa4LrtJmAEjum60rhhakJdNpszgU4bX94dqB4GiIPVfUECxVKpMsLFVDGNQcAM/2zOSxquAaiTh9Yc3j1S4ZvEqnZDXqJkBZV2c0nt4citUqaeh1HrY/IpHJMw1oyKLLfESENHvlQKERSvU/c66m9uTOr7aIZhahQgcH4szYSWyrURL6kgdkXP8UzHcCQOPz2o6AnnXN48pDmnqzLeA4ngLHjRWu9Y7YIb9ATL+N2rVVffYtolpsJ1gkUlh6orAyIr4/5QJCt+B49GCh4lD4agRd9VjHtxWa6xMrdlja/T4zayGuRdY+v87Zuzzjkh5PS4R8ItXWVmhf7O03gv2O0U0a1tbmb3wF+no01jPvXafbwjtvq4E/YcGjr7BnUFS2dUcF2QqGsdFL+10EeJnsz+WodaW0ZX79a94dad6dy4nldiPyvfCjTMH/nAmi567A0eJsJO6X5MiM6IW4j/5aqxEP9W3WKgp+d8a4VWjbCLYFk8/l9CP9lxZYii7uLP9539C3iGuXA3gtZARfQ9IKAbKqY+ru5pq1Zru9K7RSBU02z01i0YBLy3giXCAbyrFPxSJeDroxy3j59TLdbXDTTGxIB7GuAbkExiT3lHZXc26N28oWozl9PNSSWgkK5O/MFZgkPERaC/6E11cGPYVYX4T78N7Y7KDjfNDl2IKefs0Q5BbigFllFlDYbQaN+06jqB1BWuR9JUQqICkjEMgpfecA6iZv6wK3hQFvbtWrH2ffzLysSnJ5xdy2U8MASagqCC4Jq/+5+eR8s+hx7auJPIYq7mVImrAbliStn/4fBPrSPQvKf7P7TZbG6rPbnqnn296/RcNCNu3YBOQkODN+xlgrpT3RC0AuYRH6B4lDSn2sePccEN9565MAPIfN9UmaSVNrLoD1SSauqLtGK58GQspdFG0TL50f/VQAq66KCgfZJzsYxktAK5RmQHQ3YUX9opLtfr7sO8ieXIC4KucPHOWxg79FpHo7Xana1bl7JCIb/eJoMvtbrwfd3EzDT6wVn2F5JgfRvLmIljZCLccKYG36sn63/FjgvHP4iYnrMUSCf5F72hvUivRLrC4i0dlLvpXP/Hmcw6WL6HdrgvxNJcCF0J3/esoOETt7AVTEO4MFwF+1BrH6caPxG2Eas9El3m3GBGnCFVaZl46GxXUmXicP1TjJVV0B8Ipf6VG9bfzCHy4qhaKuzuQfmzDEaszb+acN0og5hOG8vwix10FpS4MN4wJvRWoLmV/QuirQzAjkWpM4go1gHBKC0mUglaiVREsDnwHKtsF/KGNUkeur5WZRvktsOpccag7B/LYFcymV66AHErijKu6ThnGdHSYj6lIBrHDK5N4oDisY9GBE+WY96KGcVZqINydYlsoufEnbOSjF6S4f7B1t7BaIjpGRC+9WZBalWmU6AwJH6CfF3iNGLP/tvOnBPTocVqrIQMfAfdoLyh2C+4iZncDadumISc5YxqRjRj5P2rI012d7Z27BZuj/Z2kh74kwlNec7NIlmF72o9WqGrF0j8hB29rB34FNZ3mKZSoYYso1VZ2rHLGsTVCWtfBhVejJIxM3PGBBmGIe27W9tdotjavhNHK5R5EaasirmBLtilkdVaBxDzi761lIpLtVxJs4dtdWub/Txdgn7kFrN6SK7JPvlLjZz/DFpu0uQ5ofylfV8hX2efSpa6iIrAih31BEKBmUcvRz09M7Z3+9AaAHj4Mbr3xATtfukT07D5nKIEZU2hcU3EMGIzp66r0J645jSApbZ39PT4/PkgtmisSdIB3p3MqbSId4a7//EquRN0ayCB2PAGkgVWGy5SE9lh1lCyUkCWaLFE/WVTWaJzqGUU9YLS2fJenhA2fNV68B9NDGHCZubNUkQADvFbKCAyiP/AzY+g6Oz7ibNvg1sTXe6xc/Bt9NU9xUy8w75ZgQFvDoqiEk4NQ9eRvIEu1lZlpHW5B4LKGI4TV1DQDb+be+JR9Rr86D7MzA1LtZYpr1+0uutNHUq/1EVBbbmv6rgcogUz5TdMYDW9eFbnwymVNDKVuXMfeKNfjblRVPGIcLBVq5XCGHQgphp14wI6RDF1w1OmB6CI0lxLmGyBBkD9sL5elJE7h6e/DazkYmMprwfEzK0upxww87j7qrU4NDeV087rnuY3TGRRCAd08wFY6lKAVgplofQflgQMNvNmxrQhp2fY3kcP4MpID+KwkDlXLNROjGTqZwQ7Qb1irL2QVuEaJoyt8UKMrJ36axrLnE6Oznv6VlFeNEirJyygY1U+JCRgHWMCMBYAO9QxpXBHxtKeG4hTt9vS5LNXiGCMU7gCJeLKItvay1yK8L1i5FrIuRiQK39Y3U+oqvB6J3RV9Eikvf0GAhwHMYvLld0tRe0EveNeQA6+Xxw5PcPLV0dNVJM5y3PH5MJ6/PGrk9qb/C+qJE+MlPkGnQqpjZV8hoqMKqAx35s5DDvJm936+9v/RWWyLYHkfDozmwF5GzzbsEKmR+k7mL37T/125+f/fPPT7pt/bO7PTtV/n/2W7vz6t9+Hf21sRSCNFXg51o794F76e3ZtFJ1MeJp8FO99UXGWkdqqPvgoyMeAnI/kL/66/KMg5C/uvhz/5mIsK5HhB1mZ6BN3bfbcS5/8p3hk8hdSCSDuj+KjwK7UtCztYQaJof21g5VqzsoppOBGQmiIu0UfxEP23EfULA1qt2gCdS0sVm44mw9cka3gHdDk45pf8Fo8tFTk45pb/VpyJ7we1VKRkileMMNUB/54bL+Uu+FvAN7e1jBRAx+9i8NtWhuQj2th0+BT2LQ1t1q/bREiko+i9og2XnH+GivvYNYAEYEpoPMnFlPiGj2nMaTQLgIrYbS0HG9pmbmELdSgV7hQijBJgo5aK1wbwyKY9UrC5I0Z3aHomcsXIogH9aN5B14ExEWdlRjlIEYxtfbb0/MzTaSKh/z72dsgmkOGZLLWdZQCLhtsZCLVnKqMZZefU8qg7lKHN4SR3zz6yblNSyU/dWPyRi+3klEySpoXAZwKutpCzqeHbw/JmRcWb9GQf3ZvS30vXjYQuO4X2Fz/eW1znDuxAupL7upi+7e023ya86lwAg0U4LfMvMrlHChfw18uUSOMm8upv3Pywdp9a+p2R2kiWizXev12J6MzURIYKQ4roFnmJHCGvXQt5Xt15Canwj0cO3vrswVRWYKpwtLZ318fvkUK+22Di43f8AtDMRiBa+JqNybkMLfqYZT0hfD4m207bcLRLwx/uytwgD2CqRU1YHWJWne1cGgmMhdiATwANi347/eHW8noN8JESktd5U7DthZDK66qZe7+ytj1gPzCFdMzqq6T5wHh94X82AUkbnUrOjGA827gTyMIrHO6l47piVawQo/HO2e+42JuC/G5dTkPDMRadV4mGqLjBZGQ5C8V0JizdHRdVcofuvZyfoIMgF/4hDfALml6zcwDDJ4+48YN8ijzxr3bY+DUv/SYOP7H2hZ2xk6/kbPVjGb1LHkFevX66xeeTdb2CXIe9ikB62FAcmDX/6KptdpD4FTwJnx7VnLIOQxx/h7qVaDw3J1Vv9mRhoAeEkhYp1mkvf4XzhMfQ+I14BrDOV1YyV9l5YCYtBwQXt7sbfC0KAeEmTR5/u1h3qQtxK+oLIcLHX53fkreyIzlaGDM4/IZnqxfWywmFnc7iMHII1Vqlg5IyQtA6LeHTgt0A5/fsxz9M0jQENDhRoGnnUf8XfzdXfWIo3jkdlFi8PTT3POSQehWjoXDOo7kjIGJVXcoNCw1Az8+xnZh4Ou9I2401XjnArByrmBG8VQ3e7KEUjUhaMyXIcZBIXsTCh+4pYLlGerDdJJTjCSqEssjgGg5MXa6xJfKa5dF9jc0ekDmbAxGHpjsXBhVQaGhkAW6WSpYL4zrS7p5fbj2cfzgT7BVkN2wMUjRjBDRkEsNBkBnaIvVw7M3IR/nh5rtBPqM7jAopqTecoXh5IbPB+ATQkVITwKs4zp1oAvtw6CRNnSt/N+Bb1iFGxUjoxRPE/LGRRn9VrEKByYnF6+hqjZ0z9TB3VkqmTL0pTjiCsOE+u+KodOlbgfr8aFdAu4D7l1YnPbxOBPSn+nE5dXMJNpsdQoJ3HREeRJorls0QEmbwPYt98ON/12KZn0QIwkGavLJwifweLcmIeeYDkNV0fC31fLEXXW0DbhWYoy/CsP8GGuX35If46L5DJtKxX8PvqSlu3rhApKAkuQpT+bB5lkHh3/6xJnOir/PTJrOgr5nhS1ewneut3UWZZnwqhwgjg0Dn5eTcJMUPHJ3rI4YGQ5UzIMhp6i+cKSKQbykExZ+ZNck5dRdYgzIifPs12Lo+M2vA/Lz+wF5zab2CWtHtjF6hg2mcZjl+4c+Vft/qvb/cJB6N/Sp2v9Ttf+nav/fRrX/xxZ2j1NqmsK7vlj5ggaaL4KwegvNz/T9mmhutCcbjXxOLYMOEv/0Rlp3yd+7leZX9D2baY01/GnsNL+qr2iocZHKIg6deJyhVld3oDhq00hLPLvqGGlgnIVR7zHSjt/8ujQqHxdHVcdJ1XXB+gX5arrDvDk8uh2AxvyrTEQ5qjPiu0gIm1VH7sKD4HV3IelxTH54sxGB7wt4RRF2tbib1LE74XohuPwpZrKyvC4Lhem1Uk2p4L+j4tyIZBAyTvKHKEfGMpY5LR/TbBGunE0MYUVpFj1xwZcQNHf+U2MjnvrPPPWfecBuPvWfeeo/8yfpP1MqmVXpMuVJH5si7Wa4RRK1QNRbw2EDPs0Up/lqA5e9Le4mc5Z2U1VYWZ+eWbMQaK2nzRh6syBmAdS7iZJFM2pNuRZ+Ue/zEBBdj7QomU76Sgj5kHV1VatvV15aQz2hTMP/lPA/IDnhD5nnDKoOoT/A/lWHBfTkZTas4brYZZQU9yWR+ncYeDmCO18UVJiWh6n3/H6ZLtF+UyKGWBdbqXUfeNfH57S/vydtNR7Hx2IwoXg6Q4KCIIxGz42QS5rKoqTCa0FWrQMnaIMYW4mlcR6rDtU+rWoIGb5UKSqmEFEz4blhzkUL7Q680gcFOyDoVsCDXnEMYNTreUh9uD+g10xTfSUPV/W/GZEe05BXv2oJ1yDPII7OQRzdQ6IXEP7o6cSXc+gnR9mSdMvXOP0utfknVb6jyn/Hevz3duK/sBL/HWvw37z6HqeV+DpojhufRV/dyYRrWX07DwbZrA3NsbgXxq36WT18p6YubwbnoN3RC4fyrw3CrRISWMQgNP89HhUKNIShHSA4pgshrcfCzlAqXDE/oBq2SmfcsNRUalW+OLcnjak6u/tpf+9yrxk0P654nl2ulhrXD10qYe+uQWsCC0W9TROXSOjIIvCZQBXhm6gsccinTGVRcEPOfz7EkACB8dsMkrL9ED3FEyY7kxds/2WW7Y3Gw5f7++PRFmPD4XD8cv/l3t7+3osXo2FaxyTc41hOZyy91tWqeNORG76DLL9C0BdvmAqV/Loppfvj7a2XGX25/3Kbbe8MX75MX2T7NNtNxy/TlztNGzmafEUrOm6GckDucZMLBMjflUyEmkVKThUtwHjNqZhWdu1GOpLScCW6qVjO6Thnm2wy4Smvg7VJHSrf1OsRnZc6lSvr0HEqMtgaMSUzOY8XDDX9wo66yLVKM7UB8SMDMs3lmOYdvODXfQthy9gpGTX9zVos44P82V74mpjLecqEXtmVw2sc3pUBx0TqNub8YW+2jSSU6NCa0OEUAoTciLGppWRBzs+O/5v46V5zbbDWTs2MpNZ8nLM6/VyX2SdIPXdD6s3nXT5zWNJ0xsLAW8nwMRrd54iIaIqacmRTsVqu4vnjoDCzqGqR3zfeIai4qnil1SaQ/uYRy3OqNqdyc5SMtpKX7d5NUJ4sXRUKf5aFBRl9DWEy8uH963Dt5DUYKFrBda2S8LqM6+0VGkNJGml5mSWmZeWNVWyWWPWDqjd6imm0QerKka2t7fsajn/B4nfOkdnVBeDa0IUJeX0zJjGstr8o2cD3CjAz2nykoILWFbaJy/b1OVQHRJXFgGTl9XRAxorNB0TYL6asGBBRwdf/oqp75lVZLLuNq9XE/IY2Z4n782wlL2Plv6n3n5CfobvSYzT/X9A4ImdSGUv65OQTSyv889nZyfNQ6/abUquPzj40piGGqikzwRkHxbs7avbeztJaYsMZupIwIejeiNM03NXY3MF3ryTUwFM8Z9C3oWuAQ3U7OTHkSKpSqmam5T3LXL32GJaaddXIB670jMbh0feszI69YvMpLK1lHz1wWXvJdvJybzhMRi92RrvLro8X5Sobhdfl48CIKaBKHNZ/OztxpfUPhYeCbGxASxd4jERwEfuLi8zw+b4TLqZMlYoLQ8ZcQE0qSK4kdGKYggZhFl1oi0rl2sSkMmMbcSMS4opheLNVY8V0maaVUlY7RyUU8+vTGdxEQIU5o2gwewF6rMt1bzm6+XyeTLhibIHdKce5nG5ik84NxbC9xObWcLSzORxtGkXTay6mGwXNrd6xgcjZsBNyMU1mpsi7AmmY7u0Pt9Md9nJra2T/yFK6+3Jvm9Jsey/Llu5058vMX8Ix+ALR/YHWP4dTnZ8dnr69SE7++2TZdaz2hjosqu+a+oGLWwt8+OOnwxMvVeHv9iXJ2t2rj9ae+vBpL+ijr+6+CFzKw+en6L/Gs8c2XOVBCw2oiueSn5vd+aDIrB+O8GwzIrmob1FodQA3QVd++pJnV0RODBNEG7rQvkcfTkW40SyfECrC7tpVlRzZiX0Q7Wtfqw+uGxDcOgVjOb1luqp48vXQ2d4jiaopFNrQA7toaFKPeLQLomMt88ow35mqZnkzRlhQ0CKW9Qa7RuM9K2KmVNJqRxC3zw2/aaQHdHnP+j/XwJ4bc7Gp9WxtQNY2cvsvtM4fkLXRMLH/N9rzffRjvF1C6tXDDJ2WB4GJqQkix9OGHRsukhf9HSpq4eKjEX1NE1f60a7YfhpX6TUzhAqaLzTXRAoyk/MwZGHVsLAnZG7t4HD4oUc/VfGRIW9AOoQXXNfuqH8Hd24kVAx0pUueclnpUKy5uwUPUE8zdqn5VFDwJ7NPXN9bYWosZc6o6MP9j/hT3BKHT6CrpZshLgrXoRujKrb+SMixQerKDt19/u2UKYOOWN+rtSc+NqIt37AvVYvSyKmi5Yyn2FlL16c3HvWG5jyLU9ugkV+ljZ/PKhs3jFSirpTh2oj4V+tXfNJmPX4Ydk41qQQ4t1lP/7eT9+/fvb/88Pbi/Yfzi5Pjy/fv3l08dssqSGxaVULYOQ7fkMVwewzVvdUXNX9aKwMkL+WRveMsrZ8bqZh2ZbHqje7ZPKuV8jjU+e92x6nx9+e3bTq+51mOVUug4InVeanImh3ukEs670tP6+gFlG32NVYtZ2L5Ai9J0G+GVNqVFp9z6oGyPxPN/TwLgpX4lGOT8Ih74Y2LVeSmlAttGhIVzJCFb53dNAS6Z5M29uKeg/dQPBUFFdnlko3nVhs/0NMo08GHLe2AZEAuuuZiTja2w0C8MhPmittt1soMEi/N81qqtpsgdsTtZ6g7sa5DNqDAuSJBxVl2wzBGboV13G+PN2sr76hUN1MNkXmgGHN9Xeu0IAzGg9s6rPmn4yjRWmBNyBxSQxrdDuCiABK0PSAYCAOH5MOH0+OBtXYKKbzRQn76cHqsB7EcpFGN+sIeM7vUfBHKxWOF8VCTCS6Nu6s+kkIbVWELeepsgXzhhosxB7kuloSlIKWyzC6FK8mCGz6NhenZ6TFRrNKsURa/rmPvi55NoHMSLg96gFjTcEAo1N9uhzISn2VrsSe16WGq6Va6s7ubvZy8fLn9YnfpK+36DP3hPGP5mKPDlokT03TDxLnj3LawwM0jWvp3Y2jtQKhyNG2RuoIAtjtg1rCI6oz1FhWNOpyNrfrshFQI3q4n8+caO8VgBePYB2D/A9y25/JytP1iWWKxRy4pst0VMaw3x7s4RXdSPaOjFc16/vPh6I5pt3b3Vjfx1u7eHVPvjrZWN/XuaKtn6u8s+HDdCwgMD21IfOhUTVIXQIMRJc4yUETzgud913ptzlBSZY/nk3vnYe6dZfyzNWafHEBf0wHkEP/9+oH6F/DkDvr23UG37NyfxyvUv8An59CqnEP9+H7yEd2HridX0XflKnL79uQxevIY/eEeI0+L347jaDW+oYeg4sl7tDy2vqoT6YFgfT0308MB+4qOqIcD9xVdVcsD9004s76Sv2p5rJQs+RMEWdeL+TcJt64X/OcNvK7X+GcPwa5X+hSM/RSMvQyd/GnCssOK/h0DtLt4mC5lzT8oxe+0NkLdeiF2ObpiYrphFo2ZHd8anQ9Vsco29Hc1J10iOTFEgXeLpmztbD0UuA50XyJ90g7tMbdOyn5QRw8EFcyrJWC9NZ17xrCWRbytzmnWvW3ZGo72Noa7G1vbF8P9g+HuwfZOsr+7/etD/YjAM7Pl6kg/CMsXMDA5Pf4SZOCg/AIs04HVW4sIZ9lYuoq1B46bb82DEowUgK3lW7C0Bd8P0I2GVksozkt1oD7Msz2iAguyjBnJ+ASyq81BGDJusk/JWMm5hnqKBlgqNw4I78eBvoZ0ygioBsLk0HBZRI7xZfFelRbyh9Ft0y5lqRRZk4+Gbo9V2a3Cs731UO1wLpXVPC6xSbNUy9g4X5FOLDk4EEkAsX0F30bD5kwWbJPmPGVLY+PPYbD++1iqf2oT9d/ANn0ySsmTUXo3gfxprNF/ezP0W7Q/A3Bf37oMU//RtmOoAfQNWYZBc/wD7b4WDF/TqgtTfxM22yOipL8/g87j548z1zwE354xtjwBfAFLra7GNuXauNW70hLv4+9ury3xCmtDYC0IUNZ8/Sg/gC/cLYVevmIU1JeCKmarUlffOWUHa6WRueLGMFe5Ykw129shTKQyg+K7YXNeSRUWqLoLrGvQnjPzd6sjnnyC0LT3bPq3iqmF+27QDLuE6hS6RFqWdWQV9H/FaKurvLy0310lIe5Y+lZm48p4faMec8yMV41vmKJjnnOzAFjqGJI6QtGe8PcnP13+ePr28P0/cOUs82puR+n89W8/VodHw8O//+3Hi8PDw0P4jP/567JKCmwxSo37ItTvbDN7hAGYWPfSbiNUU4ZxXbeLevvOwoKpJpbnQZZG35uAf7cXfqMT2H4NfTLDkO75QAwwJXlmkXn+6wCQevLfZ4dvjy/Pf32O+x5H8QQYuKktICmYr/uMU7LfKiZSbCDmJgRCtaO/+fD64hTmgrH9cHlOxjWUN1RBXVWSQ04FDisqaOYMa60p1455/Mu798dIuCc/Xf7NfmqAHlFZREQhwD1jKS9oThRzuQFomD1jyZRcrY3Wrnpijtb/uXZ08FEZ+lGx7NKY8uOYi4/FgpZlwj6xB+SgAGGtqEXLuaEioypr7jcKSMctfESwbq8QSWLZVcz4zSoWcDgeK3aDHTvAavGuMDtfR1z8/F+v3ywL8DVbfMYx/pnfsA0s0XPjwvzkxI7ZlWHn715d/HL4/uRjbTl5lvz24uMR6hx/Rx/Lx9PCKiKveKhjaAkRm0Dqj3MuLAItfS1tWnUKrj5omRAJbceIA50t6gd2rXDigOf2bcTHz154OLY9CPh4zMbVtK6peX8BzAjOL0mhcTd6mMPL5m73yKUgrpUc4FJNHaf+6s7yWSG5TDNjRW/BqDDgmaKpFazUMFLyG4kBxEpWIiOUlJyldikePqiZ6T5ATDo8oLG/Zp1+5Jxf2iqxkLghFqTMqX0SW+ScHJ27EFFyEYPghka3EvSIwrNdDLDFTi1t5ASC5WEKlPFO1nEVKSO1PYeL54JcOSwmV2Elh5bhpYqZEPhtMRT33fR+Ne/Vg4rQM6nNILRiGvgo8poijIuGHZA050yYAfGPQtdrbHua+K5V2SUvE3I6wT5DZclcPsDpmefDRtbQ8/JqgGXMsK6scEgDjFHX7fL0jBjFbzjN88WACEkKCipVXF2aG5iMgvdwvKhTDaOpDkYvt5JhspWMdq8eUHxshb7awzxHnk/1jGkkAyksQpQnLKcpYR6GJ39or1lzkUqj+QfZkDX+3KihXBwXRHNTOY8rVpReyGpdWVLQlWKQHFDbSQ4wQvOpVNzMCktPzzBXiSk2kfCGJSjLMkGIBQCeLx3rAPHzK8Svb0yva3+0/SpKJuhH/Em7d2r0PIoMRl797fitHpBMFpRjRyZ7xqS61qZu0qSheTh0K69rQT+4zW4vTvpb7dpVO759eta7uKb1r1fWw8/TN+Tl4CbcBs39YqNym+Flhv98h8Cwz/iqiaGnbZRzBo4Yl/2BSSliUbfoC23w6NTaNRYAl3Hn02MIzZkyEWUJifWZYWG1wePrYdspolQdNxpec3j1HS2dCHBHbAee1XqgsoJruL6yeq6SeWi2owf+UQsYEPvp8fnm6dl5/UPo/jsgczb2Q5aYkogt6sIDlcpdkpYeECYysIZJxgxLMU1XWDXcSirNyLOT4/fPXROdkCLETPqQao+VmbVbEH65Nt3QyyBuCQfHs9SsyqRYhPYgCAScXPjLMkxJUsWoifqrhL3ylBUoA5h1g75jC+vcULXxWqrsAeaUawy+qhvuw7rzOFIA6nxuKFygyzZz/QdR7HgUBJxY0VMTh89a60fFoTGsKK0NdBopXq8ZvV7ayFz5ZfgFGNKde3DYdrfdHg/9i/wxl+k1Uey3imkDCl5ZjXOekuO355hr9vPFxdk52SQXr88hBVKmMtdLS4pVJSwe4hpPj5FNce3z8ObczFwlWGj3gpwT2WSkStZuFM8eewnnQQQzGi4d/LfaviqxdZTf0mLlds4QUINZYdaSoRm7o82Fa4Lim58ssfwl724eeyLim1ZYJ3jkfM72cufi9buj/7o8fnt+aQ/B5cXr82XXtuquJevvG51KjLQ21N0VKuK9DrvbKw3CrxaNdniroKNMdX5O7MG7vq5JJtOqzgBuzgZWlj2Z6+s1PQlpaioaWJsgja6UKMm5uIb1YIiEbw0Ht0SIgrE3NWoh5xqIgLLTdRj6GAsmkjm/5iXLOIWmPvbT5qO212pabFVBA29blKuZGZBS5jxdDFAzQY0A75O91LXWE5zsB8l+TCktWN26OvafOR/m5Zlj+ZevUMtaFk9V9Y3wfnDHSBUiEQKOQCToWiagLRQJA870UuKgyTC7YmE0HOJ/l8XdakPMLqImq5tEsRuu26rDmNlVA+2As8NVP+ouLblnTSGWATAcm0jn9Td3GEmH7jm7yb5dOdXuwgX8T/Y3QWgwHlIphNueSVDU0eQhik2pAm+qZmCe6EH0PO7/mOM9KfLTSS7ncD2mstpieiUVuTg6c6MOkN4CmAhbyvhNHQXDBTec5uT8H2+hOxEzz/Rz96Mb1A5Yw4J3L0iLQelqz+QYZL7o4OOHmgt4vEBQG3WDg2PR2UGEpqb6/9h79+U2cmRv8P95CoQ6vpU1S5VI3Xw50TuhluRuxfh2LHl6zpk5IYFVIIlWESgXUJLZGxuxr7Gvt0/yBRKXQt3IIkVasl2KngmTrAIyE4lEAsj8pUYyMCUXJUmnaMu1t6XsB6xqXrOWClYiXASgX+Zns0s0xpvYKpz5YmFaNHVNsQFXEKUufD7MCchloQO9fwYuTIsergpsQv/ImFYKuH/Sh4Xm7brGctEyLitNjsAEq2HUkYPlLfWpbn7PslC84tKnXjiKkCBTzCQN9W3QF1hjMUPkiw4r7BWMOtU10kdZrB67o4pd+ifJL4IVoySFsg35UZo97kxdHyO1cbZtMm1C7UKizzvNzaOQNI4R0advGotFF2lUe2rv7BUENqJeWUKcJClPUooliWfLbK71YfCmHCfQer30mYFxp8/AgzMw0yEdZzwT8UxrM7zjrDxcmwqXtx1TAXVvLz70ELbHbXBCnDH6BQmu9CRA6L9yyeL4Hs+EPm8vLtn43tJk9f4mMF/caJEVfTSmvKj8pjjKLG4TnGQHNLlRpNwEmqybHopIQuDQHnHjMyDOvINEtZyWInCwCFimnIQW49IUhGPgZXQ7CMdQdTcvxYEzyRmf8kzYuu0g9/xrR6AtNa0benZy+W6nAugCgb84nOQnTVqUOiKT1KzQR4Pjl2WeC5X5nwSgQPuwn9pi/AWWf+V8HBP05s1pge+aaJo2kZf+a0VsQIibARASqOji2XUz9NoUV4fkRbGysVbgBZTNu1Q1Nl63UzxkHhMehFTONgVDd0rlrH4U3nImU1Iq8grkcCYpI2xj0HjvCpB4prMKfe94KifoBCJAcA2RGZPp7JoKXgOCsx7R6S7QxeV7iNSvUHh60kjWpkbTkFQ7oKeY4agqKVtkfAE5Y8KvYbNd1+8bzsZUZpFef2Ms4UP1APf/RlsxZ1uv0O7zg+B4cPjioN9DWzGWW6/Q4VFw1D96OXiB/p/tCpFrCKjd/iRIumvX0dLBJHa11nsI66MC7T3xERqnmGUxTn2QSzkhMxQC9pdyFwtQXGa9k8XDHppqTygkTF8IQKh9zHUY05CkOZySdUnzlUWTF6NkMhNU/UMfCPZQaKevHyT2jkslD/Wg9pzB0VQL1hQWtjHhrrJ85VRiyIXkbDcKK2OQkjHlbJMz6iP0MG9C7f7naRNdG5pShqbaGfWfGRmSoqDK148VGuqvHvNoA1feV68Jzy4+3B0qP+niw93xTnFtmOJwAwy/PTmtp6WMyS2DB9y1bl+pPZ/ZBUEShu+1D6GC/ruTK7cZNgBg1LhJ+UTkKEnpHZYEnb397x3PAS1OANhaxRxHaIhjzEKYgt5dHU9RyjM1M0sepuIz4a2SHZZKKvAFAClkT1cEeju5hOtVqQRM5GqOVin7pTIMD8y8MWJvUnEdIklSEl3XuXjLr0BXEKY4nhAhvcatLHQfPSA4SUjkSMuG1jN0Q/s6T2joeSG+0JzZ5o14irZGnAfmuSDk0y21U9/yvyjDQevLSxPoFBEN6gfQXySkQm1kTGlE2FrG9Nak8OiLOZGNRvSLaxGegaL1r/b29CP6CbWB2QnQlQ41klzvyr/QqTsFHs6QoNMkniGJb/Px01vRGAuJ5D1HMR6SWOhdL+MSQkg0WKXi/urNmXBRwVshD7LbreqC50mjMPpO7Jva8l/5YwvK7ZyRUaZm7ecMxxqt1AuUsWENnnOQh63oUBHyJSSJdmIgiAFe03dsRVUxah0gdMEQRglOJfXOqVCFAjASBnBY/c/8bkIfnMcErk0Wm7TJELP8oAoV9arnScDU9RRVhoYk5vf1al4/J4rzxpft1v39fUCwkMF0ZlrQiqFnBhZyK3AtXhhoZd3KBOd4pppXHc5uu8kj0LdENtwPRDYcFCZfr6DEOXkFZExb3TRvY6un5xzjSKaYxmrKJCSlvAZ4WTHglG3BSb7kyTWw0d66raznZDQigLatejWKYrh/Rq7enO309F3bLeP3zB6yFshCxrj07Dk2GAGlslZXvEkSVA1kuV/XrJcbpkYJ9ODbtoxgFZuMYj4S7cwjfF/Qm0yQNNisyvgnAHlqmIuI8y4HER81LYuYoTdnJx+UyTrRHJ+5pnxd2a5yR6aYxg9c7dV2E0FD1p2uhg8GykqumKj+aAd4irFtkRt42LjOicCIhySV6JwyIYlRmYIM4Nz90RRKX71uXKM0kxu7dm6GSDdXy+bmGU6o92zAY41Cajo3GAPjj4TurErEJlE+jKTAjkCUtuReLXA/RkWH0muDwxBmnM2m9E8viFGL0H38pMuo0BG6AS6gBnhqPijubtziHnI20mNVjothUY2/pLZvdUq1EIhgPapkRgu6rBLR4tzlqViuy4na8TGDXhzzMWVV5jzThcF0VVlOebyxvF5XdwsUD3qyJ5IAmGDobYyQvaVDzPA1jqaUbfXQVkrA+2XjayiLtShs1g+KsGhYXlSE/Wp+rX77duWOXfq/6ShhOBHIQ/8iLLGh8B4LFPI4JiGAQphvCxX9XTX/EWWRnjxuKsd8LMwcdoUKbN+QpqbDTJa4AibJhExJiuMN1ro4t31UJiAVjvxndAQptro61k6lpFME0wROfvRtvbD1GFICIBtCF7u4MQ2CqYo4EcpfrLqAL/Dh6KjfHxWEsRHbU1Pqw4X+MKaDYzTFdm9mRUIFoNSkVHgGmo908hnjETEn7gWW88tphywBCgOOc0SqtbLcLq1Sp8MnxmS4T/EtEYhKlHAh6FDDSzj9zLcCSk+VQk6JTGmodRYSqEtaW0zhUhMGNuxhFuMU6HVNkimVti5NOXjyHZcmYoLqXDNGTDk4QvIXhJ6XBTLgLIEXxJ7vaL3YDJ3yoF0OLNGNes+sf2o5hI9K+uAQ4ppNbHTwnByR4Yj0MTkOD18+34+G5OWoP3h+iAfHB8+Hwxf7h89HxwV93NDxf8FztMqmQ1o86wTSKkWospoXocaFmZlg3yFRz+gLjmN+r4c/okKmdJj5KROmDZP7kmaQDeTOIyAbrOjL6PMSG2gkJIYEfDhXzmcIc8csHvkX+tsQC+DgXO0qaWgy5AqzyLo1/smFPtDNhHRRGcjblP9CsBR1jeitrVmWoEhN4tBA3KNqIG9yB0xndY7UxNBnY16Jn5rDEZ+PXTPdikrEI7JJ59dpE3YqAV2W7IynCfKea1vkVEm1YF+2VtG69+o3mKZeLLWPiANp8xC/otMQe94gWNadWcyvEIe2MJFr1CwnjjKbcmpba6dLJZPskVDVqBIB6lk95l5gbVFRjQ4GigTVvU3dLMxkTgTb3s79S8DTM4EHcIoKzLneeqVTVZ5aIk2ino/8l/uxHGY0ZeOMiokbtXxSwpRW6wXKksJSb9Y5LhSpyN8WGLwUIxdGhL0fciYhb75khYpakxsYqz07aFdbBSdjw9QUMx2NKUiNm2D72+2bv0HRQgsvxXutQQ8aN0C3X+K1uF/ZEKYGuLw22H/pdQJeLOFF6k17jT9b8BPcCu055pYTr5NzO0AXI90IT10bOCUl6soztMH03lvP6aZgVW8WWN3CcNSGkq9nRP5RRCi1A+LiUQt7i+qo5DZYchRzfqu2YNikoBKpK0uW9hYeKKqz7lVpHAT7waG/z4Kw1cI2K/9mzi5LP2X3QTYvtxLDjASBex9txWyYsonh3dMXu0HdzkophhdUrBQDF+Oce+Ze2M9MgPhVYxD9S1dNVYEIP+Y7x5TwmfICpxeETPv35iZuOuepKTLY6yXkTNBI11KcEHCRoMijBzalw2L/4pZUHVMMJ5+syLdo6tCKoShMq+t+CPyFt8fX9yKubbszCrGfU2lixoHePHcB6eMDDXpQ/5yVguNS5zs5cT/NAGkj3y5AuguQ7gKkv3KAtJ57FuQvN29fIUpad91FSXdR0ushqYuSrtLYRUl3UdLfUpS0XhOeRpQ00LLhKGnD8ILoYBwbVz+fitwFDtdGCHtZsmovAptZNn7yEdON4ggeKI8nGDHd3vP6imHTNTr/1cKmfX+wC5vuwqa7sOkubLoLm+7Cpruw6S5surGzLmx6LQrVhU13YdNd2HQXNt2FTRfCpnVpTHjWXOdf5d80X+dvmSpUalLFWAg6mtk4TAx1AAAtG4ch1xBxAESp+0ISf+GMT2f/NhT+2zkniuG3F1cfz9HJ1dX/cfp3qMk4SvGUQOWBf7PKjb+au4rfAiV5w4YOfYHtdhs0dbDc+szl4uyyh979+vr3HgBY79gQLYxCPp0qm2pIDvKmIRIGGAokDiUNg78CRa5QhQ89PqHjifFKHcwkt9sr1Uberqbo31t0muBQ/ntrJyh0RcIJzNvgr74YKp3CXWve6C1lcMwATiYOJwDz6HCe4Wxa6sgS3U8PBiwM+TSJqdAhlGOOY01d3u6/tzyUcKaMnNoo6VASRbqun9nmMt6N8ldYjoweui7zqrdZqsvYWnxsffFh9arggetBh9/doLjYb5iLTpoBeu26Mm3RwuUMstsNV8MUAksBGZONHcY6ImpvootpSUTZmAgJxkIf7BGZcpFop9/b20s8Hmv2LLBeyZj4M664cdR6vTFnZkspNtXnX1qaBZ20wvsvUzUkEwThin34t2P036aVXmGrh56RL4GDrsVS4vA2mFKZEoCu1a+IvauTfr+/v4d2tsri0b/UCWaD3tNWQV9tpF5bIfkyqdjThwupKqNivaOSmDaN4Qxq5DqBIgZPSFh+81XBtW2lKFe3CHyVqems20Nnp21oOXHat8Te1aB/9LJG++D7Bgmtd44+mke7VUjQWHpE/GHwtXtTI3LKp1NsEtwuNRdsrCOikpTYPIvqaD2SqWgtT1+OVWXfnDzbv9sgWJENv5bVgPMibTr8Xh9qif22Hibefn/QZESCfvuqEw3CfdIGp9mmLDlUc83KpofqA78n6eWExPEDx+pxzE1rUfvirV9eNy7q5d5veeRgELPteYMpF7FMxWsMBXR8hPfCycCIh5mwZ6F5OQqL/Y6oFCQewepEoXIs4NPHM4TvOIVCXLsRSeTEYfXnGztNwpfgqP/StBqS1MS3Q5A9WaLmdkiTycZKsl3qqsWURbDZNIUXdJda7aIsdV+blCRPpBUD+eby+vz07Lfz64+XJ9e/X1z9dn1yfnk92H9xffrL6fXlbyf7R8dtJ6TBz/NktyEpfDh/u2trawuJWbSLY85IYdQ4JBu6oleGNrgNdDMQDkx0asc00xD/u+RLGGeC3oEBvamydB1OMGU3SFAWmks9v4Qr0lehOifeocfHVFTP+95eXARB64qCTZRs+iTTl7XXeSVbsCD9/AhkAqkMzWOx0hjkCWB2FLA0V7zFZPoRTYUsqIXNDJ646O3iCawelK0esv9aosKbpnOCxSSYRkcbGpjTgmViY+V8Uya9Mixvz45QROEciY/Q2flHN37FVDdAJmgxZV7r9FJBhSQsNDflphQnFhNT+daPj3AX7vlo6FuSvGR8liQkhXRckFd5JPqvnx+fPn+9f3p09Mvrs+dnL85f/PLi9eEvr3953T99eX66ypiICR482qBc/nYy+OZH5eX5wcuDs5cHg4MXL168ONt/8WL/+Ph0/+zl4Gh/cHg2OBucnp7/sn+y4ujkS82jjM/+0XH9CDkZesmVDx+hvFU9UuuZN8cvnr8+Pj4+6R8dnr8ePD/pvzjff70/ON4/P/nl8PSX0/7Z/vHR+eDs+YvnR7+cPz/85fXB6fPB/unJy/2zk9ety9MZHqkQWStfZ16sxVmelG6r6WuH+g8Suvgg3ZP9BK5a7YJjyshURqNymPHuZ5ORjD5yLtHpSQ+9//TzBRulWMg0C+HG5YrgaQ+dnf7sogjOTn+2sYbtxfQHPtjUMm2uwQFJJU9d0/0amA3lM090CN4MJSRVKqVU6fLyzV7uRyM0wSwSE3xbjQKJDsnRcPAiOh4eHYXPB/vP91+8PNjfH4Qvj4d4/3BZrWFcXuORbKU4UT64RaXBkuxdUQipdL7w/YQwm11aWPQFYhzCi0nqpdn6M5BGVW9gv78/2O2r/676/VfwX9Dv9/97WY9A8TsEpIuvyLBxfVozO3j5vL8OZnVGb9uwqNXKUguOQgyZz0qN310Y2ylJHBcKY+nkVFtCXO0xqzUAjfSoQFhXszU322bThCQP0O86c9mZZ/VwoUqjtteu3TFRkk+oyaH1o+RNFm1F/hDZqjEKgpAvK3NtK7+GHa5Y3tziOvYXWt7pTP8GJvesUCRzTRZXZIm+rb3We+ONB3yYbup9gcKmXH8zIXHM6zYgDTvy/aPj619P36od+cGLQ7U/yR88Pz2b96gbl62V9jNfjvovAxxDAoukdwSm9qbk+YZq78tqndevCSd/dnnybifQV/+qHzUn05mSd507oKt/UznTd/6e2sL96zCTJhpEJx9BfFee36W8srN3l8jnGKFnqik7kcROD5ouxIqS6n389l+96b3SEGgPKNDkbtK+2jEwYTJgCJ6dvoNqjIoIpcm+JJ2MK0xbD0s51+g3Op6gEyGyFKs9u6k2dbrsZqEoC0ih3bgcdKLus9MdSHUUZTY/tS6NW8ND5FvdFaOJF5nxZ2erjN7pz58ue+i985MvWAgGG5aqPOa+5/vSNSPt5s06RhxSa/Pk3k0Nue3G2pw3O5XDO6UUylr8g5L7BzDkQ0RsmCm/K4GevX/AhL5g4Zp4xvF1xuimikXXsY5jpHpUEvi0gghK2v8AMQBS2DVPryFAbHMXVm5N1chkKbL9uRX1qocuIdzsQ0XPT3FMRzxlFK/C6Tp2erDnwdJD522xtWvY5ez39/u7/ee7g2PUP3g1OHp18PL/hK3Oqsw9eFu3kLvyPq6Rs8HL3f4L4Gzw6rD/av9odc50TtP1LZld43is5sFkurHNnGm/rg67S8C6JdWJ+PFypYXE4y3M0rtNTborff92518GE0TiWD0Qmp9y7pCTc/WKyv3kUN4qsmBUyORov3WYQ4NAyJeEszw/fRWUpnPThBvOiKT0rjKY7u6nBXPHR0cHz63wWUS+lMMfVmNW0D/bDH4To5AATP908ZzeWIoEh3DTNKQ1kbn7/cMXq5AuSEpxfN0aR+sB6SO6K4uQBctVvqOtXSXLh935ptMCpeQnJ3EywSwDLKBeEXssP+y+p3LCYXMWK2dF7bDcybdrOpzgFIcAfFAW8tHR619+eXn6/Oz8l9f9ly/6L88G+6enJytZDEHHDMtMSW/DxvCimAHmi9oR4VuK36GOPp9OiZKP8PNJ9dI+4hmEQ6BfOXqD2RidprNEchTTYYrTWYAuCXHhIGMqJ9lQOTV7Yx5jNt4b871hzId7Yz4IBod7Ig33QmhgTwkG/i8Y85/eHBw8331zcHRQGQZ9q7K7oqk2hwCPs+UVbs9rySgzJyY4JVEwjvkQx84nzGsirsjrY2xp17OjtTx8zS1t2STZgyMNutSwp728+jn3a3vozc+XmKHXardKRci9PW9P7XQC2OFuZLSfzHa2IICHcPTY+9mmyVoY0HUx+AQ2ryV+V2LpB9iImpv7zXpPHtyz6tS4MxVVPGjNwAb3Jw2BhPmOxaWgA6K+vt7o6QtHnABEbB1egCBhsn90nLbeiRAh8TAGw96C0yHnMcGsjqFf9E9oFOMCWwbw5urNJWJkzCXV90z3GOAzQiLEKIuVg+lcJwBBpuopE5fKEGHg96jPGWMkbj3dGPkir22I6lcdShcXOyTwFdBNogB9MEhCOuwEeWAqAHB78u7EAPUo/8D6hvf39wHFDEOYMBbKG50SJsWejMUucKI0X/Gwq9tt/CH4MpHT+CccJ2zX0rhLI7FTClXSyF/e5iDm95DFKapap6jcGwStlS4lIptuVOGoKAUzg8KZfiF12XGr1OuLdnDKWtpazUzd6CcZeWtoWzbytsrSY0XeNlGyIRFvMvLWH4uVxuBpRt4aOr+byFs7TN9y5K0/Jt9H5O1jjsq6I29Lo/OdRN62HKG81W8w8tbwuJbI28ulYmwrsbX5WqBpqmzZvkqMren8D3ywsSCv+iBb3fHagmwPXh4eHg7w8Pjo+dEh2d/vPx8OyGB4ePR8eHB8OIiWlMe6rl6FxNOkEnNqAiyfQpCtx+9abmOXYfirB9kaZtcT8HnZOrSzZGBrJnolKGhjE72LR3y8eER/CH70eMRaWXxj8Yg1PHTxiMtI68lc4KwUj1jD0GPf32w8HnEBz0/gSuerxCPWiOE7vQbyOf3u4hHLzH0/8Yg+Z99bPGIDbz9uPGKDQL7PeMQGZr+FeESf9C4e8SvGIxYE38Ujfr14xILgv/N4xHpev614xDoeunjEtpJ6MtvZleIR6zh67P3sWuMRFzH4BDavy8Yj1rH0A2xEv8l4xOJ1+drB/LULVqgKZq99E5wKEzcF3/OUjqlSPh0lVnPREuy3PtS2Y7HhML13Svox/ZNEOpQNrpJdlB4sIj6bi1i0gJuNDDq1SzCz6MB1PFU5auCnthRPtdKM6s/W2oDPIddI98pMyBSHxJXdOdEPp8RcNME9O0/UdhtC5mzBDojIxBBHl9fpwyglnzOolsARZnC9b9o1xSpg5mIo5TxUwv6ckXRmSvHk2j8avcQvXr4YDJ+HYXSE/9JCpJqLryjTstjgs8Yh9coamlotunpdLjITMDYkauuIJB8TJapilT3TsqmYZAU7wSyK9VbLdQL1andNYCOJrKxFWa6Hw9HL/dHB0fPnw4PDCB/jg5C83H8Z9UmfHD4/OC6K09L6lYVqu22tr/47ppShrQnrCmhCSZApwSJLzc4RlNgppVFgJ3Jfje0iURJmvz/qHz/HuD/EL/v7w+ee8LJUGywDvPvp4xv42Ay8++njGwupayqTIIOWozd5XHVp1kNdU1S9IvS1onnSEq/4H6YEShmiiN8zpR4ciXBCpqTn6o4mWE7M+xzZsNY2WLrrqdx7pqu72WJRaewV+yziLvl1Iy8YEhwqoAqirI2S2xTPNPSziQu/+KC42lOiUvLTxeniWc+dF+BywUoGBS4vDJyUaltXuPSKit/D8cOY2yLLNwYzSkuoWuSxBjrKRdfbAOwNrWlQHlRXQHUFYHX0pzJHtvOaddvovBMLDAoEn0Y2LiwmONJqJQvVPCutU4HgvlwQiaiatiYGuKcGmHGp7GI6A6DuCawbxfdLjdtudZFWNM2EhEaGrnhvVFOgVJ8mwcNDgrYSNvZwl9TrW4H6rlj434TP3mt0McMXOAKFarWOUoGe2X2exGkw/nOnB5y7Nl0RUc78SDZTPypCz7bGf271ND26ha2dqj4l5tjGq+I0nrY7hV1Jhz7kBX7N/ERwR6OV/6cbb7ZKnmyVxuvmpxt96VKsJ2uJLlXeG2XxCqVBH61CyMVIV15QBhhqgtGpMjSmLtiMZwD6nZuRmTfqQnI/IokydJOlMRQtvYFEHoiHBDOkZzAVcHrHdMQOifSOCBwzG7wNHoZr0i+7XgMvX7RLrw4PD/YEwWk4+dvnn833+vNPkieFUbJm4hsaqe1PbMojXW7bWTlQZYEEIawgQSe5GmtAGWJEah+CMyq58v61keFD8A4itxQNiSk3rr6BMU0JFv6QY8hwQjEfC92GehWg7yVh6I8MatnnYV5gHtVqXa6f7DTEFaVzr7lmsVC29x4LR2iv4E0wLqvGZiVlUa01/FzQowQL4dmjtSfDmObzQgKwqAUlGmSbcqjzHJgPWE5KfXg20Qhiq9QtT5e8NtOnA6/MfrOWDp7b1wodh4fV4/bDw4MCUbAB26QrAh0YZdW/Dokwxe7VLyaprI4Hp+9KpiWlqqw5f4M1R/sr/rmE30ugrLd2G51vxLh6F2ZimtsYHTPg0R6Y4vWpvqCC/oaZdE/1vM40s9rjcS3qYuYMkWkic3qAdP3kjXnblCh0l6MUAuqZpFgSNCTynpBifqC859ojLi2seW3/9gXp5zrtV97WKm8cTKqr2C9TmiTEzT9XlL+pBLQedNeWreDPU7Q14rxQ7h8qyftffLsV5dWQQVV50VRWvl0x+XKUkTtggt3epqb1JbTebP5gASk71vo6/OZVrWuo6W1YioqcWYXapM1ynYDNNo6wXtZn6HOGY+1UeCXK7UYkn+95GVxzdE2+hCTRS/OEC1M2OWOR8aorszWA/S62O35vT1GmAA7VqK25SuzvuvRpfggibdEw6LlaFTufMT1PAs5QVhgaklhnV1QncP1sL1oEX7Z6z4+FDKYz04JWeT3nsZBbQX4KYMrO61YK+zLgVZhLD2eTrF6KbLgfiGw4KJiVXmF65uRpK26cdxv4nbexpU8U1AIgU0zjfINaM02xaH0HKHlyDWy0N9or6zkZjXQZW9WrURTD/TNy9eZsp6cTeG8Zv2e2cHTp9EQbxZ49egPz5k9tv45/1fSX+3XN+iW2Qj4FPfi2bT7Y+yZzn49EO8MP3xf0JhMk3WD4wyfTfI1j7VNQOPe0n5sPPkEL4XzbHH9aD7FYxH/IM2044VG9J4M6ZeQOu82vOZozhdzNl6akmdKPCb4jcApDIK6Bp95xDpMpJcK4h9AJmBUOZcUxg9doZC2FPaPFDGHIDDe7RL0CeIZyagauVY2yCWZjIoLNznq/3LE+GuXpLBctuLZTAjFdfNTks2GG3pydfFAiPNFKe+aa8qd7e0xtwztkyDzQS1VaV0y1aQ/EY8hQi+SKMSmbrCOpONsW+RLeU2u+K3pQ2emdxEOSSnROmZCEsmWFANr6aNoIvT+2OmoRbKyKa/UWzAH8APemrqKuSr+XxFgqk7i0NmsuNrg0+KOoO1uWRC8DfO069snV/zRYAFBIJNUVJgvLzAiuprUdZAgzzmZT+qd3XqvF7z5+EmSUxWoS3qiXAhrdKB3UHxSDN85tDDkb6XHGcXFpY1GNJ672/sura1lRwzztYJ1Kau8ERJ512prEqhZWiPyKBvVywlOzD+MpivnYu5EUNTm1GCzqsjynPN5YLqwDptExAqonhLWrJGk+K41PVGJn+19bt3SIGb7G0ZSyrR7aSglsytj4WjW4BNzLd+e1uOta65j/kI5Zzv0ju2Y5IT+0c5aL4Qd2z8pC+FYdtDIfT9JFy4nsnLSHOGm5HJ+wm5YT+SM5ajnXP4SrtsmV3I/peRqLdPtwkTWs4JbO73VxLvL3JNfdIolff0m1/XerZeNqaUX0WAuhA4J+7DWuvW16wALookF+hLVN4nRM5A+5VTesP/I+3VDxdNb/R9ikGxl8r07AshJ4km7Cskw8yb25obBzNR6yMTdCfMK7ckPho7srX3FLblj+jn0WP2jmGo9tDocXOoPyb1sE0Og2bBgNg8RmACydEh0LjdEw5fdeKqmbi1cTMjPZB2LC75FaNxi6J0ObHwm5CqopysZ5YLXJjM4cqTaouX3MS0RU81/LuJreymNJP0w4Iwt2BhshKBddFRUHj3BKC0Q9mQyckonz9OC6oAdlnt7yP2kc472joI+eaan/Bzr98MmMAHp/iQb71wMdpPcWh+qLf+6gkySJye9k+Hcq9477R8EgGBw58p79/bert296+p1fSXjLdyzGwt5gP+ijt3xIY7I3ODofHL4wYt077h+ayjROuCIY4SmNN5UK8f4S6fbRMxvbl5JogmUPRWRIMeuhUUrIUEQ9dE9ZxO/FTkWA+skK3d9W/tx7jSXAxsYxs4448xNNXW2BFLCHtPtZ0SetIm/5H/iOlKVyS1JGNoUbUeFB9+bI1lAI+L5pJhwGh0F/dzDY3wUkQxqWqX8SW6cHj6nNuPZGtGkQ/1mWgHXXv9YI2v7M/AwJk1z0UDbMmMzmzUmc3tPKnNwsLEuF+LZ6N+gHg7Ll2yypHt7NghVPWWvPL7qLjaUzHtE/3py8a+MLqeesF4TTPI3K+OAz9KK/Hww+I4nHz8SOzutIcHhLpAPcEDosGwtE2RjS/wG9Xf8T2sdC8JAaVCnVBLP5lbD5gd2R4lpanCzsoCtNZ9qy2UpkzhV7p9NMA8V9HRcpCXkaqeYoG8eGW4nHkAEC+agZZGlD+TM7eBOdjawI/bxL2e5nRFiIE5FpKkXP7N3qKEOF1FE5S2jopaKYQGhAI8EuR1YQJniKnpFgHKD/JuS2h36nKRETnN7uQAIovSPxDDmPGXbqKR4BsmdJEpQxkjaOqm4C6YcMc/kAC/TMhnibVs1vRf53Gpicz57mz7S7LJdz2NMJJ6ZdAFGwyYBqWx1F1GiWpaegK0rRdZUMYsUh8XgMtsA0+X5oy9V4ym21N/C13FQjrNE/+7hp0um2vzcHCAU3KwwOjt3RR1SEKYEThPIMM20CBV57TeMyoim5x3EseigF5Rc9vSHFERriGLOQpGKJLcnGTquAoYsz7fkplcjxUp30q/a67YZ5gzuW94lBlQMOYMe/DA88k4JGC5B4ndXPYkZSPKQO8dCa/8oPzeuAWgYKDbVILsE1XaNKpoktPZqfCbTK7MCSjPlms6yhVAkfWYdA2fM0nFBJdJ0XYERW5IIh8kHkqXVXEyKIxTGwLtGum9/PRv6x8RlsR1Rfl58uz3fUPzQwdwwPukbzFyzqF0/RazNvdwpJYXl1088ZjmdinOE0CvS/AXX28z0ZTkic7I34NcBuxHu3jN/HJBoT1fRegcFrI3pKRDCR03/9JzTkCCsKI3/2f3ZqoRYslIxN+6lmZW3/a8vytcSlVRirxcLma25ISwBsvNCRA/QrSEGEPM09y8Lg5JtuHyECwOehBm14J8ReFZTxH5etkWI9itcnhk2eflzBsZL7ol6kMPnMmiXcEo5jWA393urebpge4R0JplSmRFf/VTZsb4Q/g5rHP4V35Bqy3K494sR1mBIsSfSvU4Awdt36tpUSvRaff0m4UJbj9B/nPof/UxnfC4amOHx/iXSdA7QfDPaD456PlVAUh0Fd+fjhdImCrwTQwDc9QawV9Q7svcLbVMwZmurkqBuimtlx3lYEG/NMFOeWY2Manl2c7diMXgPxXsiEr1sskU6sDNCFnwuJsuIdiOnANGov7KpyLa8ebVX/foLlNRXXagrQaMfoelnHXesVXb84+5+aMdrVtTP6/f4SBa0BtmNjSLknKCUas6jZwBT8Z2NtNOjflEo61tsfJws7GE77o9K4lAVTPyLhmO4OKVPfwrFdOKZ/U//42cnxeDBYQoxK8a43qvxmF8lTJELM6lW1tprKoD94ESyjFKp9RtLgjrCIbwpj+MogNDQt8EAC0iRU2LoiDA/j9oUzQp6SYJiXXJjHzCjmWNa6sJeqGZ2mnWI2NldW/aCvPO5BP+gbsAX1T1s9f0LQlAuJBLkjqQ/c9YtyMYVpkavdp/LYhCBCTOGODKx2EnMqrVCmRKY0FOgZlhKHt+gOoh9yZAiNmfWFylkPJSm9ozEZE4O/aa68JUk1COlOD9FpgkOZt+pfYKs2XLvqtXEKzaqmTCgK0GSKBgL0aYMTUON+WVcdVHc34mGmWN6peKpHwdFyQ0zYHU05U621uoL6SmN97pO1aNAxmyGHCAdaYkaoh1YZIbhIpSlR7YsnMESSTBOePqXRuTIULRoYuOOZYplpQSuRRtRDr+kV1ms7VuH65kVLCW/2rBw28u8shn/hxCPfOj9794+znXyxB6gfCcVPnYxgGEA/MbulbAxH1Ftv+D0U8icRzaZbWpu3fqPjyRYMgdqmobt9NajOfLoWQRNE+QASgqvyviR0lbd1EPQNZMwMzhAjMqKsiH6pWsgfLoyRp0XwBBWI3zMSae8FMzzWZ0+vLz5eXgXv07Eu24CewRfKeKJPl7u6DjTjbDdJ+Yh6Wy2vYEIP3U+4MgZUWPBaydGExAnYfThRFyQE5VSeLdgJ5X0lnHmXZZLgqUA4TLnQjvM9T+OoQUXZXRQwKmQw5ndwZrFrTBGoa9UY6MuRdqpqhmSD3oUb9VoPA8BWlPTAUNhFEEOVISjVGzuZJSnlKZVmIFBKxjiFS2DPBKwmwYoTr7oJXdcLziG/HPVf+sePUKvhtFQ8eO5NFBXKC4j14qDvYPRORE0seyCpJsuXUoVnUaju5p9UUo0jH89QzMdjg2OOrt5cImVM9U1ORMcUVkJbCyov8OQkQsJMKh8PDSnDKVV+zOXe24u358XemAkBHvIInoEFFMczAVilgIxsqeRwon/r5uzvFj7ZL7ujYwaFxlRXb/cAKFdOnDiwRDfqB6jHcRNAM6bFCRYTIqy+nZ1/3CVMrRrFgs3KzLhwXg0wdaPevIGCA4BGXbheGRKU8CRTdEXu3k/fW2lC1MuBmOD9o+ObHcfe+Z0ZVCzzGEW/JGPleNneHeUXa6JXJMWKQlcF0fLwweHMAbQabXOUhW5kLAKvgsmNAT43LcLPYUwJk0ag7W9Bulrej1LL+0ev3/2t1uzu6nS3lNCTKWa2Um3uH6Ue9w9cg/v7rrv93dXa/r7qa39vNbW7OtpFIXyftbO/vXrZXY3sr1Yju6uL/RXrYn/vtbC/1frXXc3rFtJ5MlvD1epcf5e1rb+Tetbfdw3rb6Zu9a7q+RUaErh6xiyc8FR/3A1tRKK5b/lFP1Mg4f+Ctk9tORKz9qjX3f2BPfqHm8o4NpXd4NhYkVp70g3JSBMupGeQtZxwTF3ltgTLiX3Ye7CGQPV3RpKUhHCrsAsn+/mLcI0Cn2gxLwkzmxhVoE/xF0g6JX/aJOVm8nRceunhKR3ruMlXSKYZKbauJVJolsNkMV/pD9d1etPAuhsfCIuBq/pxlsKg6M7q+GshejVC/nNz2YJGVx3TuS0r4Sq3noiAMiG9Q9GFMoJjBv0usu8iGtlpEcY8i/IZcKo+2nv+FE2JxBGWuH5SvDW/6mCNsPAqBATm+w4cRdfwwLVtUj0ZEiF0MJg/Rwqcw0sBneKxB2GZwztM6S4ehtFg/6DWfuQKcqFaQBdnLtxQk2slYtTjJ3SiRgoe4nHkK6olSNEfaKosrwuGuvbhucPt9WEJzEMR53fjGHLPL91TC+0t9dVWjb3epjicUEZgjrfqzLwQeC+07cuPnrpuYdDmv9W21yTlYMVaDpx5fPlxS8k49/rm91F4tLZ9axYiHt6Crhq7cGY/10wv/Rv4HWp9jGNdDh6Mgv5NzXAx4am81pY59yfscqz723U2oWHZdGShmhvl4isFI6JXB4D7cT/WCcsTWP0rtUJr6EpZnOV7A0vnTagley292a7T1bsz1QTRT+jq/dn7V+g3fq/ciylOlJEV5G8VWgoLPZq/2KNme46cTdckBFZz1fqb6+1v+lNNIxdsxH1tNcsC1MiztsZTUPV9rXqadeP89NLPFLaF3ERAQhHMpnFgntOpbjjVZ6eMs938zRKUJ3fV25o1vXloCiBYtokh5zHBrKV4R7lEIKEmH/Zqv1wEw4zG1S6rI+pW763Bi7NB/+VWO3LeXyLowY9zqSck5BGpnQfzaBEyJTKctCfG9qLR/NjMaeBtNiQpIxKu9o0e/t3/rqbd/HfncxUdqLxR5GvhfKuav7TQshaInq9zZYknPKo3O0tNZk8CCdfHStXBVV1lNTZ81Z4+8Ah9ujirdgRb5gSH62Mqb7HaGY8qJv+BnVn0mobOSpuUh3doG6zL0VY9/v//7/8nDFxNlSRjwf/64LXC+/l6ipOEsrF5duuvLSe2x5NZ26Y4qZIM6H/6DOzJ0e3RVk98SpKYhlgU0f0ergR5uw26F5Ek5rNpaef78I7zdhs6hjOxURavnWWv4YauF7hmq3bsml3Ybb0f+vB+dbtmzTPLS77gfXBf1LRrfsyXOrfPrlua8rbRUusS+dLWEzY9BHmQ8hxv2HCMk+kuSVPubZJOPrxF5+ar4vW5+jLHM9As81S9MJ9nnlYPqeac9XywbxWQy/gdSe9TKkndRjLvCI7xlu+pgFQ5tys4xJJ4miw9kpmoDKR3mY8gUy5j8hUatNPnK0tJAW5TOe1TGqZckJCzSCABp+efGP2CSMLDSeCb7HmcZmncyGMDWScm2U8S9CmNTbaTrk/e0y44ZhGoq7uAmDE8pWExbKK8L8gTibzK7M1GoFlmVxOidEXykMeVEuwQuq7rr7/yKmbXC6lUr6NMU+nmcSFRqo0eSrgQdBjP9LnqLhaCTIcxidCnj2/qyHVX6F6w3qvDw4M9QXAaTv72+WcTR60//yR5sgRfpaLSK3LmfKp6cfvFxJegTflmZcqKk2mBEvC8yHaRosPDg2XowHLyUAmpNhqkYwZyGcl8zkg6e+AUgTYMDl4DZSXVWmq+pHg8za30KkSiE9eKic2C2uIYbqhtBg4GGN4EjwmyVEueLKTUnVxI2WzjlzJbxczHVQdF0ZNjexYGBcUER6a4OgQ6FZazFkNi2ilT6HNcd1xTOMmd8KgwRE0e2txx9VjVTS7L6Rxm/bElOCKpqKG3sulBSCf1R2V8z1achJhxRkMc2y4tP1POqOQpidBvV1cf/IrwSzECQBkpSWs4qZqheRfWNfx8NG3nCC7Lk5rTWUr4bKth/t4nE9deMJHXSsmNmjssECcJjenMaTMehjVNZYG35lHIL4IZRBFVCaueVM6l7ZMXgvtOEachoX1gQkAGNNm/llwU0xFB4SyMAV8L/Hmowc/DMEtTEi3JT83saJoczXNj0Ri0nxltx6R4EPOXRsL9c4oEp3ha8NXnHnKUfi4Pb+lnEeKYRNc+XoD6U19TNr4e4VDyVM1C+CuvLMBYPbtzJXyCRjGWyJzAWJD+XZNBbs+DtGcOK3zP8oHUlkvH22r4ylK4gUG0X25RbKDy0qDj+3cGzVunhx86XEynJnHXd0YtRj+ZUik1HEPdmtI4ZRpX+FVILMH9ros2D3zkYfTZIfMaXIoQVrTdtQtARQPmaME8Nhawov5MnPmUYEbZeJTFtZoBB82ldxdyGmM2zurOq5q4rVnZK8yu6E/5NROSlI9TPIVSFJZGXfFlWb+jqvArE1hS+4fS6DyOjElaYzUeeQgMWU9N6iuQ5W3npuSep7dPTdSOsKcm7KUJc2ePKWZCA2WttjMt8/jQS0jAfcyJQhdn81aEcu8Cjn4eeL9/lXdvUCVwStC2aXtbbYNiC6kIca1+oGt+Bd/QDuPSkqlbUl9M8B1BIsFMGLx3Sb7MXQrLjHvRXysK/u/6YYiUtgfyBudCp3p5a9i2sIXonpFxgLbNBm67h7aHOLxVysOiP/hwu4eIDHeW4KOlW1YHIlsSiI/k6jdW+mGORH7VxUDUI5Cy5OA2ff20ggm5xip1tQDscq8l9Ov5FdpTXrPYe0Wj7Z2l3JwoK0CtopVcHbgJqJVIea87Z5hQIY5/WttcmagmwlDTRcay1KGae00Sj65bLdZzxv9DDrEqi1ZJ6b8ZE7V9hlltCj6icELjCN1joVZARgvEb2p80BMboFoSK4M0TAm+jfj9Yr2eM0inSmw2y9BFrbmmhQtgq650TXyvZ0TKy+yyu9xNLbCwwl6ceVodEiS5l26vL/2GRHEpkORzbFWJzQSn5bvSp8KnJm3hZrfEUF7zb+Uj+zWeOPiuqD7HK3t7j3KssCIpJJmQKUlxfL0On/HctqbG3EEmQwEmbYZdMMMiGiuRIsuNv00YKb09Pz9HGbBSOR1lt6ZE4hr4MnA4rI9WJjMvkwGPaUhqPiq5phBta7o00Iq2iixEYQBSo2vR9SFM8Uo2s/u78jnb2uexOVTx4msWq9kSwZvzxvDv5dcbyKwLUvWD/WCCiDb2YVOhlQvkhZ7umVpt8GbxkYUsJbyqgy1OEr4CSy6ityVHPlHFeN/10WRjf1uQ1Cokf5kzfFfcCCJ76k/yK8Prl9Z5+EJSKNTDvVD/3DUC5x5Kb5EI5UUU546cL6paT6DmcnUBpeU4mDVSuV5fpT1hCF1IAKy1aJBCNVNYAXVMdZAznyKsz9nNkphfePqVr1uxTZMK07TlPuTiQ4lbLA2Tolq4uhUxvBDcp1ekvNTkJRQE8p4owN+20p/3LjPHSrve1eCl+pbBg5aHUiXRYkMr2S6IeLL1QldQuXaRoGuxbxeegBOSKqG70BNS9lTN0QLUylYuhWLCbXEX7J5wOvbVpx5/YJ7c552LGPJwOs402H7h57e6XNmIxhLQ2SSHAEkSSqjVSAFNtpCrtGAgCoNR406WduPzjJLGZ3ICzreH9rSu7SahQNN6iKoQsy3srnUVqsBiPHCVuSxKRbfZ2vsulaV86P45BhCu4oFaY+JI4/nlvAhADR/shgBAwvJuUW1a3RIZQysR9VtpkT/58NbAvW5o37+a6BxVeWXpJhqup/gPnlYoGc7kvH1KKzLeqpZdRKMJPDGCc9pYnSnt7k5WosiVMYOrniEB0HPJ0Q1OpruaoJtSTIzyWh40ZWqduIbp30j6lfGffN0zOPeUlTyrBu2rOQGol20jER6K3MNIAHzwlWVxDujiq1CQo3XQ/CCx/shhQdJHjYtacVCb5egw1R0YGRCkL6ycY1TxUS1sZV7rlw/hFMagGGAburkt0D93X/P0HquG1L9sBex/7n4kON69+GCC8tT3IxzHAg1xeKurD4zpHWE5OA14RyOeopRMuSSW9Jai1ideT0jU+RHc9ybqiAhJmX81aVExKj+UdifF8hze45XawBGRmMbC2454vfr14veMSB3pNa3qw9VMV612lSYoCOSPjOkbXR3HqAfNnEBsL6k5RoyOlCb3u94czomoLhWH4lO7wfGF4sog+HV+EJ4O6TjjmYgBj9Zfv8weSa1Qgk8J5KOB34MwQxcfegjb637YbGeMfkGCK6EHCP0XzyzWKY7v8czfdgjJzQYmxfduXpgr85vAfHGjpVicDYQVQVSjDHJopd5Q3AQ0uVHU3ASaspseikhCWGQqu+vbrBycBuQqEc3HcvGylZTHr1CEab5J2NZ2wDMD3gj5nJ5iptjjDCyErY+PLj7cHSr+Lj7cHXt1LVoTX8hmaia/sEvRO7JXJoa3nq8PXpZTLUfz8/8ESa/9O7V5p++fBEkLF3ANNtd6KrphO9WZYiaGuiJTYk3oMOX3qlWbcKDenSE+kiT3jMWE36MsUVp6T4ZuYxjzMYDcKf2C1syloiCm5KDuXcsuaLQX9U5kgwPHUzqmfn2mZR2XT8xQWEryycWVD1yO+PGW/0njGO8dBX30jH6YcEb+A51++IT0v9H7SzTYvx5A9SP0Fofqi3/uoJMkicnvZPh3KveO+0fBIBgcoWd//+3q7ZuefvZXEt7yHXt9uDfYD/roLR/SmOwNjs4Hhy/QJR7hlO4d9w+DwdYiXW8KA2oIAVo2/OeSj+Q9lDCCkcUhHIFRNV0nOB7ptUdJsofIODC5YVbD9jQSynRYM+UXTN0at728QjRtRTzQVCXHxQrin9LmWhyUSSrvHpejqrH3f1S1EjVppVKWMlkR8ZIH6s8im2hyjkqhhWavzju3CzkLScpsqUVNRE7xQ+//m7eZThZ6HrbTYn+ELallMyMeIsLGI+m5W8uHnz3PCf2tPWJuPmCeF+w29+j5fYm4/BT6mcjCCQoJk1z0UDbMmMx66J6yiN+LneJ5qRvWCKf3lC0Ry1ybjzePG8/GG7O99QBuGYA8WUx7KDxYOftpymlfIw9v+R/4jjycER3Ra6e1WzFNOVQGwBF1fOEpjWdrULWcsYgMKWbLcHRpyDCKB7cd0QRL5RWrtnpolBIyFJGvhDXMLBeh3o6bQT8YFJbz5QfIDgaGeB5879ysGhYMmNI6OTgMDoP+7mCwvzvWIcEP4UXTt4ClHKolISktVHmuST9cMcHvJK9M7/djizMy6UBqx/qWp0CaBchFqAiSW7/hb433oYF224fRFAcfh8Wgzbr1rHbP0TIOcfl7tLnp0QUZaM4NB6gQgLFk1PzXo7LUcT2dbnAagGM3dT5iSPVLhKtei0S7WZ6JXYKFHOziBZ6PU4wi4PS3qGyWhT0D7PnEta5C7lLqZ15a53CVbo4eQQIlCpw2y/1gCqXFW4vH4Cx/w9psOHjiSmypXEp3SyDpm7WYDuW+3lCOw0V7wxyiY+w7k5ukWXe1wLQ3kl30uQxSHVrGbTlTzkqIE5mlxlkx9SOUB5MS5U0BnBAzh+0aR8McKGEPocKcNy4FZrZcrLWBhGtU0+oFdh6ZArJZKvAgzOIkpW1wAgxdjQFsr+0FTYjj2Bzw32NhUrzpFKczlJA0ITLFkpsb4xzfoEIZjCpl4+tbMmtB3rxEQdPS38msdEusgVNGPIWyI5S5TmvoIV9CkrRK85sXhlh/imIOkmPlz6f8nlUHcm78cxkjZ5l4vSurNnofLYjUiDNOndAEJwlhJDKQWsrvH2LhvxXUkzUlQhQxEVDTOS+qV7Baaq20DAGmlyYaeJTFDxKObiEP1ncBKwUyGrovrf+LOndzv7axCWZRMXsazcmgbivSCw00xFM17nJiSuhr2UJII87GEwk3jzrg0dwPaiylFDFeN3tjXo0DW2KeeHvfwsGjhRZX89ceBS03V2CVe4g+CHJHoGa+wykLeRo1jD/YmfT6IQkKqs9KlE+a18TRiV1fcwJiNzjxrGhJ589FgF66nkfUSvHFJ7phIqF2gKMBnStrtX0KN9SMQ0Vipjw7ydH/EtvlGGMHFZqQVM5sK4gKJCSNY7iTpKmygphF3sU3+Zzh2EYIFzjsoWEmdbhCEuOQTHgMqFcpgY9RlYILBtMMCSozbHEJSq0qilwRYjWhtOgFknwM0zfwkX69rNpdkFDoYR1vnXx466MgoLf6ia169GP1tJ9ab9rTAqn5IQcONlKlROgKrfYQ1HspSDmX1XorxmvtoIa/N6jhDrKtg2zrINs6yLYOsg11kG1PbQg6yLYOsq2DbGMdZNvDBN9Btj0VyLb8l4p4OvC26o9V+LKqWegQ3J7cKFUG6QdAcPObSzmv7p6WKx2aA1OZQyydfcW59NkTgc4HC3VyiMODCnEcZrEOJ/PfBxA3tVEvOApqcVqPh9ByqVruBGjl1esV2o6GQcKFHKdEfI4DKC6jVjJJpkmMJQlIqhay7RCHE2JWtMWgbwXZZcONMH2CRlkKlyAiG+5G9I76PiFkCj+DW7CcvR4q1MWZszR/JSBMpVfrs5/Nc7Vhps6xnE12s8lq1tvMORZzjr3sgAQffNjVAQl2QIIdkGAHJLiRk94OSLADEmwYoRw0qwMS7IAEOyDBDkiwAxLMbWIHJNgBCXZAgh2Q4LL7/g5IcJ2CaQckuGAgfRyeB02mOVglHcTgKrLoIAa/Idy7DmKwgxiEvw5isIMY7CAG55PfQQx2EIPmr4MY7CAGO4jBDmKwgxj0f+sgBpu5ZR3EYAcx2EEMdhCDHcTg+hCoOojBDmLwqw1jBzHYQQx6ARAdxGAHMdhBDFZxXuoO/P9S0iOeyZD7I1p36NqkRPN0qNm7vNE3HqbnGyToNIlnKCKMSyKKgF53BbRD5XOKLIRoD54i7Lc6wjTOUuIdcpJUJCSEgB4LB8OkWk0h/C1JeZSFJKqNf3NDYHrLf6kMgcEtEvYQwcIU6VOFBehE5iEhscxEjk0Ep46nHz4BSNGUTHk6Q5lGZ3L5w3lWm+O47tChNTKR8E9BWru9N/q1G3scIlDMQxzbTYPhqCFI1rnkSTbffDep0k2YZJWuldyUQEX1zAWVlF9yieOA8XQaJGH1zLUBZcfeNyQkDYvQJwtCLcwLeAwKqegEZASReHsWG8ykMYrg4h4LWb13NTevgKaDqL03ACwx01IeYKJ6UvNZgD5RiVLMxqSYiARK1EeSo0G//78qZ21aCVccJf1yZaCMYi8zVpUhKgWy2aEZzmSBv3kDo9o1tASVXnEos5pulwEFhBbyWUwiGIVRSkgd/F/+TY6CQeoO0Sr5Yk28L9joG/pUL5ZIynQjAbqAVB2bIap0CwuIUQUNe38ZoPcMvaEs+6LUKuRMUCFFjkbh2ix1msSZajacGJ0cZqMRSQU09/7yn/rKE4w9xMz6xAFu24QgyrC27Wbo1Ku/6zOinnkfUO5KPUtubVZgXlSN31QUvhhXu6zGm7c9lbfz2p4Q9GBWFtDoDIMlm9k8I3yzuYJitjWe83Sz0YAuMKHzjOgCfV2/IV23Ka0a07LYKnOixeC9hXdyeEI1ShQyeJU46oJwk5SM6JdXaOtfIP7/2Wo1pIL+uUlzAyGHYHLvaOpbRn/MJrjAiPPLhQiq3a2fvo9EQI4muiQSXdI/SaBzb6ZwdsRHdSTzMMwSqqNyIOTEPPPs48nbnaoGhOWxbqUBOjlfaV5Mp1TmKAa6uZ4PdTCDsFd99qgMNljpdrO6xmQ3JTvPVVFNlQMVyOkuzr95+RhTMg3gnZqBn5M53Tz4tSRrsnJpZikEthv6RVy4KC2TB475hsiz9EEf1hrVk+fhpyYpH1EIrzebEaUJH9yXZcDPEY0pG+fDBgfYShYnH94GHY5ph2Pa4Zh2OKYdjmkL+joc07acdjimHY5pwxB0OKYdjunKOKYdJFIHidRBInWQSPMHsYNEKvOxPqexg0TqIJEaRqiDROogkTpIJPvXQSJ1kEgdJFIHidRBIv0wkEgVYjrgo6cMfFSYHB28kfvr4I06eKMO3ujJirqDN+rgjTp4o/qx6eCNOnijDt6ogzfq4I06eKOlTs47eKMO3qjMUENITgdv1MEbaY46eKMO3qiDN+rgjb42layDN+rgjR6P3KXUr4M36uCNnoASd/BGTxzeKE9qRctfwZbVq1WM83I5mybs+eLMpREbiovbypM4RgIEoDMMKUPYPqnP+if4Th/OQyid/enirObq3lbLrjDzoOzTM3sDlecbKRJ65fzThzFmaS+B2nCEx+OUjCGw3TwiemiWX2eMaCqkHnoP66FZSG1gMuaGR7dO664R8wJRg+AcrIADsjD8RCByhlku8Qp3RuQP4rBaJX3tTL5zQR92rKyuFGcLRNORlPKohlccxzy81nusb4hjQ7AmH67KckwSh1IisdpPNTJdn2ewDMtLARQ8VJMtVo/luOcwhXpLMU9ZJsj3MOKYRRa3IZ6ZKJVGfr+jwW7Jt+QtsFDqvdz5glhnykV1hZc8Ucob3uqERXuFHnImyReQDZUAnxWTVNQjiIxMfMMSZK42Xq9tIAWEjjVw0EAjjcmSGTWr0XipwzvgZNd2uhypMWX1ZK5N730aY9jt6mnfSGZ1yVa/fmvqjh9B0ZfWaLwRXV5VaVtQs4y6PkAvS5R4UEE6oGqKkyJY0KX3daFf9wOwKhBhKQ0nJNLhWfZ8+C8I3dIhZlhrqe7kWicYeIfDrvPAJ6MWnNT7Ha0aQNgE01L/7gKR16Oz1HWKNpaXeDWpx2oBGBQnMjQkSpsEkrxeCetulx42IwwdzTHnw4xFMWgESbCctNiMz+nyjXI7fFgYxzkkSFO169T5OrDxMFFGEDUii5MhAaQifx7ob4pTIMGsA8vqwLI6sKwOLKsDy1qdvg4sqy2nHVhWB5bVMAQdWFYHlrUyWJZMMRPY35V/jWu1RWmZOVFwn9PMS7l3faNQJaHsVs0nIe9e5MU2tk3b2/omS8ckEoTj2AayxKQE2FTbDuPSkqlbUl/AvZjaewgd1ghnKssw3jJTdh4GkX5YaZTb2aQkJndYOc3mtCfPcjdpR8/IOEDbNgGuh7aHOLxVysOiP/hwu4eIDHeW4KOlW1afXoCaUwzQCmkGv+qQQvUIHftpbb5+WsGEPDGZhIKycZwv91pCv55foT3lNYu9VzTa3lnKzWm8493Y7ce86Es317J6PJAqQng9YahpQ7iQulZCEyQeXbdar+fBZvDUP1bwBl5NAXtJrjE/GEcRTUkoUTihcaTxSzQ6SDdEtUM0TAm+jfj9YsWeM0SnSmjmkNPDvrdN57fL1aWuie21jEd5mV12l7upBRZW2By3BChDkpu8zPzwpP7IcP7ZEyCfPEk2DShLu72uu3fvkEQ7JNHlxr9DEi0Oc4ck2iGJPuBErUMS7ZBEG0bILdIdkmiHJNohiXZIoh2SqGcTOyTRDkm0QxLtkETXKaAOSbRDEu2QRDsk0ceHt+yQRDskUfjrkEQ7JNEOSXQ++R2SaIckav46JNEOSbRDEu2QRDskUf+3Dkm0mVvWIYl2SKIdkmiHJNohiTbrWIckmg9OhyRqv34iytYhiXZIol4wQ4ck2iGJdkiiVWyMugP/v5T0iGcy5P6I1h26NinRPB1q9i5v9I2H6fkGCTpN4hmKCOOSQIyQnJDUi3VKSZIS5dgpn1NkIURu8BRhv9URpnGWEu+Qk6QiISEE51gIDSbVagqhbEnKoywkUW0smxsC01v+y9whuKPkXqc8Wqmbo+kstW4wAEtcSzJVe27lpP9DvXOp3tkqOaoTGkfNbujXTmC9OHsmdvJ7WEgHA7E9c3syd5WZ4DVl3rYMcVgOWWXlrNBXaDsaBgkXcpwS8TkOPmcknW330LYdz4CkQ/U5xOGEmEzROUeoqCbFLxtuhOkTNMpSmFciG+5G9I76R5gQ6vGMBOMA5ez1EImxkDQUBKfhZE7Kax0fG0hVVHq1vhzF5oy4hny4CtPVtsrJiU2pifWJiXPSEuckJS4ei1bTf+UwIS/KL8FM351GENVMviDCQh6RqHrwseTp8nLK3pjlDBRW05u9NMo6qNpyfuV6iLzyc55uKQODZK+0AWgaqMyGuzA73SKYR4MDN3rWgiXqoZAzRkK5U4vOmFZvlZYJc65oZaNOroZA+X40EkRWwM2K+ckOn8tmxM3swScw6GtiDbB3RSpLZ6E/imTKyOVtuRMzFlY4Wwo24oJFELxQ9MpA75SZJV9ImAHy84yFk5QzCNMA16zwTZ3kh4sXhkVQobWDEVN2WzscK0Xin2GJh1gA9uRtUN9jyu/FNR6N/Cj2YtfLw17moMuqdWRbtzM/smQJiSWZFgOImsOc1i3rKuBkcw9ze6lIxA+CsqEMBkKk9FotgajZhyxSWa8UFXKqA6T+rmYJqQkq8WAylGnejsAlLPhS2zsButQxQnng3NArI4AFzLJAURk081aTabcm3rwQVgs2MIfFiZTJq709w2MQ8irHPbSd4uGQyunn7Z1mjlJSCIR8HK4sEWhIIC4L7kIgz6aR4Vcv+nN53vuckYxcKya3a5bnKRGiDRTaavMU+m49SxfOqM1pnX/rb0SiiYdYUZ7Q0Eshsg9QgZJsGFMxUUOUopSEhN4VgPdREQHhoaJwo7aUo76Q/5Ox8UcdZ2qJj2PqlngP1bUI/eGBu14Vfih06v1UD/XaIb1+h0iv+eFT69vMExTyaRITSdCnNO6Z2OJwQqakpzMRMYtQgqWjolXmk/MboKW/lIRYsRzNMrvSEIiSh9wlOJjgyB4CC72lLLR4teVJqSkcrz7SoynMYz5Rqo0eSrgQdBibq99dLASZDmMSoU8f39SRizSxe3vm3DEI+fTV4eHBnl5H/vb558K68pPkyRJ86QOsh3JWzs0uidujfBmZF+J9ayfTAiXw4nqLFB0eHixDRwGuezUJqTYapGMGchnJwI7+gVME2jBnLw2UlVRrqfmS4nERL3dpItGJa8WchcB+HqPYgp9Dva17MkSJWhAt1ZInCyl1+YxSNtv4pcxWJcpopUFR9JQDm82goJjgyKVP1+AYLxoS006ZwpJrMycEcUrkhBedt2a3bq5D41jVTS7LaTv0UZ0wJGroLQQa6T/CsDLCdbvPhZyEmHGm9mm2S+elckb1iedvV1cfXIT+soykZETSlKQ1nNRGGs69vS3x89G0bbY8VKxAqrc9SzgTlbV7oYZ555AyE9dhEZOnwW1ehIWmG9OBjmY8DGuaygJvzaPgDBplsImoElY9NJtL2yeRH9W8U8TpUHzJETUHamob406iLbkopiOCwlkYE4QZImnKUzTBAvEQik1FS/JTMzuaJkfz3Fg0Bu1nRtsxcSd5XYWCrkJBV6HAxXd0FQq6CgVdhYInMgRdhYKuQkFXocD4E12FglUF31UoeCoVCsqsdAUKqj8uNARdfYInNkKVIfpx6hN0Ucgto5C/kyDkHzEGuaapJxCC3NUJeVJsdnVCNnW019UJ6eqEdHVCujohGznX7uqEdHVCGkbILdJdnZCuTkhXJ6SrE9LVCfFsYlcnpKsT8tBVpqsT0tUJ8QXU1Qnp6oR0dUK6OiGPX7yiqxPS1QmBv65OSFcnpKsTMp/8rk5IVyfE/HV1Qro6IV2dkK5OSFcnxP+tqxPSzC3r6oR0dUK6OiFL8tLVCZkrta5OSFcnZIGf2dUJWdcwdnVCujohXjBDVyekqxPS1Qnp6oQ8ep2Qted/ryVZtT2M+aKwNA5udgG1nAqf6zbo5SkRWfxAqJMrja6UxbImnTTQwEQ+iNSIp/Dlrs4IiPzH62ic4vS2igZagVeai0VUv79ZwNhJEQzIAwmCEYdbGSCuiiFbz0Tw1+CvSzKyEixSld8KPXqzRlhNPsBSuddxvT43kN2O9MqwKA07zaaZKVrwBs94JtHlhI6k2uWlNKyPYR81xLCvn7rXNBUSXbAkk+iMxHg2ly45rM++XT9d2kr8EvPwVqnuFZ2SuZQpOySxqAf3r0Wb93t8Y952ZmlP7/B0j2VNWZSYXZeljBblZlckIEECeRgXHzkmRTP6djXBHLUZJdR6pGppFdnUp8+JcQ6hU/zlEQid4i9VKmtygxPMrsvDuLyRiVKeJCtWfchVAE9BF/nItmdQSRxg4/wCNWsDj6/jcDXs+PIwzcknQgti4Cty+7ag45skUYMdv4z9qBfCYgT5/x0AAP//s1fbQA=="
+	return "eJzsff9zG7uR5+/vr8ApVSdrlxqRsiTLukvVKpJfojp/W0ve3Oa9lAjOgCSi4YABMJL5tvZ/v/oADQyGQ0m0bL4kF1W9SqzhTKO70Wj0V+A37I+nn95fvP/9/2DnilXKMlFIy+xUGjaWpWCF1CK35aLHpGV33LCJqITmVhRstGB2Ktibs0s21+ovIre9H37DRtyIgqnKPb8V2khVsUH2Kuv/q/gyz374DftYCm4Eu5VGWja1dm5O9vYm0k7rUZar2Z4oubEy3xO5YVYxU08mwliWT3k1Ee4RQI+lKAuT/fDDLrsRixMmcvMDY1baUpxg7B8YK4TJtZxbqSr3iP1I3zD6+uQHxnZZxWfihG3/m5UzYSyfzbd/YIyxUtyK8oTlSgv3txZ/raUWxQmzuvaP7GIuTljBrf+zNd72ObdiDzDZ3VRUjlXiVlSWKS0nsgILsx/cd4xdgd/SuJeK+J34YjXPweqxVrMGQo/ZxVzmvCwXTIu5FkZUVlYTNxBBbIZbOWlG1ToXcfyLcYKf/41NuWGVCtiWLLKn58Xjlpe1YNIkyMzVvC5BGIGlwcZSG+u+T0YBWlrkQt42WM3lXJSyavD6RDz388XGSjNelh6Cyfw8iS98Nsekb+/3B0e7/cPd/ZdX/eOT/uHJy4Ps+PDln7aTaS75SJRm5QT72VQjSLJ7wf/z2j+/EYs7pYsVE31WG6tmkMI9z5M5l9pEGs54xUaC1VgWVjFeFGwmLGeyGis94wACmSaa2OVU1WXhlmKuKstlxSphMHUeHSe+gHtalsyNZxjXghmrwChuAqYRgTeBQcNC5TdCDxmvCja8OTZDYkeHk/+1xefzUuYOu60TtjVWanfE9VaPbYnqFk/mWhV17n7/75TBM2EMn4gHOGzFF7uCjT8qzUo1IUY4SSFYNPvEDr9K8Cb93GNqbuVM/hLlDnJyK8Ud1oSsGHdw8UDoyBUMZ6yuc1uDb6WaGHYn7VTVlvGqEfsWDj2m7FRoUh8s91ObqyrnVlSJ5FsFYZ0xzqb1jFe7WvCCj0rBTD2bcb1gKllxEaeLMZvVpZXzMtJumPgijcWaE4tmwNlIVqJgsrKKqSq+vTyRfxBlqdgflS6LZIosnzy0AlJJl5NKaXHNR+pWnLBBf/+gO3NvpbGgh74zUdQtnzDB82mgsi1jP6Ui5OVqf+vPqSjxiai8pJBaP40PJlrV8xO2v0KOrqbCfxlniZYRKVfO+AiTjD+NGts7rB4oUItNbkxTwasFeM4ty1VZityaHiuE9f9QmqmREfpWmCCuCmI2VZgppZnlN8KwmeCm1mKGhU1g42vLq9MwWeVlXQj2O8GhBxyths34gvHSKKbrCrsqjatN5nY0R2j2L0QqgTRTKMmRaPSxk2zgz2Vpguy5bwG3wjqBFpoKh1tCnyaQd1OhU+095fO5gASC2KlISXVWAhhQkTSOlbKVspjzQOwJu/DD5bAE1NgTjSWDpWp6DX4ZRIGRNTISnMTIr9/Tj++cXSLNCoJoxvl8vgdSZC4y1shGqn0LJcL8OLXrDA0mx9jZOcbG/srsVKt6MmV/rUUNhpmFsWJmWClvBPs/fHzDe+yTKKRxEjDXKhfGyGpCkMPrps6njBv2Vk2M5WaKl08/vmOXECdNLPML0Qm5+7sxV5rVMaplWWRBT9Eoyys6gLqTZZFzWtTLK+bNFyuqAtswQLZYM6b55ZNUT5HB4rACf2RFAKyKq41XixXw3IrinrHezoggIelzrW5lIXowPMxc5HIsc0jFjFtn4EjYDN4kIE4lGmUmrJY5ZCTana+yo6zPXvBZcXSw02OlHLmf/eOfjvj+S3E8Ph6/7I8P+/3BiL88OBAH4vCgOC5e56Pj/Xw06L/KI4qgx7L9/n5/t7+/2z9k+y9PBv2TQZ/9a7/f77PPV2d/ppcLMeZ1aa8dj07YmJdGtKZPzKdiJjQvr2XRnjxB00FPu0r5XrXcmdgwBpMFNNxYCu1XvzS0Dl7IsdtA3C5jdpanWMIS0TNn3QUDnOdaGUyEsVxDHY5qy4YOXCaLoVtOsF+6M3TMD8DocYsRsnhEdp9E+udK/rUWT6GbdNSJ0zBeLzl+3Tm7bCQYRCiTxb3kFS3y8L+bIJCsToBvKfTODBrGnYtDu5m3ICbyFj6JgqnjZ86/TQbGVJTzcV1CB0IDEIURsL1T7EfSx0xWxvIqJzN0aTsxGNjtKRASsoZYYw2JOddOCUfY0rBKCGgjVbG7qcyn3aGiYs7VDIPBPUrovhhDf4SNw5Hqd5TwSI2tqFgpxpaJ2dwuulM5Vqo1i1iEm5jFq8X8gemjZ24Axss7vjDMWPxv5C1MeTMNouloDd6Ug+eMsbBnMmy7YcuNXG3e9SJOA41E84qzQOS4NfERZkcAWpM/4/kULl2XxSmcwGdS3Btg9X/QltBm9hJOR1k/6+/qfD+1Qk3LBK2tqtRM1YZduh39EXP0tGK8+cQbAezF6eUO5JAH45IQy1VVCefwX1RW6EpY9lErq3IV9vcXFx93mFa12w3nWozlF2FYXRXC79PYfbUqMb/QbkqzmdKCVcLeKX3D1ByxG6VhrxLEkZjycowPOIO5UgrGi5mspLFYmbfBNoadUqgZ/FGnSCjs4ImYzVTVY3kpuC4XBLgQY+ejRGxVKfMFdA4QlURgtra9U9WzkdBtyVi5VZaqmqySANoSPBzEERS8tiJg1JkmMhfjY4IZTDlCCJP5fofVDni5aHYc432fyHrwTcSJ7Yje4HBw9LpFsNITXslfnHrMutvIStqX7DznTV6n3GzARzd8hWeO/7Dvm9RyedCsWeL1hwR3R06H3t8rNSkFe/v2LFlreSmXXL6zUq7h853Sl1hUQe7ghThBk1ZC5r2Ih+mgpUY2bkAOvg0smwnXBWTWwIRXlekl73v7fiR9ZFSqipdsXKo7pkUO9zdqcNgPV2cfCarfgRo0O7jhAV5PMHMLzYgqenZ45/I/37M5z2+EfWF2Mmel+KDEnFRFZygf/YMJ1xqUYCrtbGqBAFJwmgKXrOaV4Y7KjF2qmSDZdz6+e9MKPWNb5IVYpbcCpoppMRa6hUq1RKDxS4x+Jnfdy9FIRHfVuesB7DSgwIBWNQnT3AyR4u9Yn7Gz1gDYpWpTw6YlqI2fLCug95e6cvh5txneY4z5rALW8LdStgMSBpSfr123ckkeopgQvL0wTozousXjTTIEDY2Y8crKHAhioYLFvGLii7fLe95YIqDSRBvOKoTaa17KX0QIMCP6yHKhnadmpK05TcfFmC1UreMYY15StJSxoPmhNSdKL3p4NRgfxkoEZitTuzgBj2FkGCiFMBbiAZaCYWNZllFx8flcq7mW3Ipy8RX+Ly8KLYxZQyk+yTJz0u6mKsgWDUh2TlQzs5Gc1Ko25cJLs/uGQDJ2B7YYNRMIfyNYYFx48eJjj/GwnyKqjQ3kCzMI0NqMsf9sOBvtvsYKYm4eNb8LOAW5H2b0YOjlMwoZPHZRIVZCULG+ah/i9X77MJPzITTbMPNoDRHwmouqIHPeiRd8xQjSRV6y7fasmOyfbqPmJvsn2qsbrEYLK8wjpnoyxz6O0/6shcjvAM8H22LCi9YeTb1Xkd0pOT5oIeYF+BHMltjfwoR0soeTtWBPhMpyaRfX3Vn+Ln7KmbSL1bPwDra94GUXHYX0n6jspnB6nwQZ4mAd/N4rbafsdCa0zPkKJOvK6sW1NOo6V8Um0DzzQ7CLyw8MQ3QwPDu9F61NzSahtHJCz3jFiy6nSpWnIZH70JkIdT1XsrKrxn2rqom0SCdg/y25dX90MNj+L7ZVqmrrhO2+epkdDQ6OX/Z7bKvkduuEHRxmh/3D14Nj9t9tHQ8kn6bjWjhufzZC74Z9NPnJW+qBDT1GMQrHCPw20byqS66lDQYcC+kxLXx2J9n4zsJ+FyNAXpKl9mGkXMAlI6N5XCqlacNANsiHDINJGrQWI/RKNp8uDJLfMYGUh+Xb+AGMvVc2yZIjIoMNG/vYzG1sE6ECtdn28hyNlLGq2i3yzhxoMZGq2uSK+uRGeGhB7f772X14bWhJEU4rV9S/12Ik2oyS80dwkPNVo1x8jLZVUHx+T3hx8fH2AHbSxcfbo5323jDj+SODPYXgd6dnq3FpD14hKj1fY02uJnj7SvPKeJfl4iMGIgPeF/S8P72K3jB7IbJJRiEcXhI2BNTlvkN0p5VPiAsgcQCZ1dzF/KoJKxUv2IiXiCVq02NjqcUd/A/ncCOMJHQo+kiJnitt1yB7hSVirG4SdPdyA/D/UfjhHU3TZsdDRlmL6o/+6yeZYPttPDpzso5leP98fKQ5uE/4oXKMFVoU16uMv6/fm+DJTeVkisqzBnjghR+j5xCez5GrGHvm1KNgMxJUn6gmNvk9JgFHDiBCBCixyeg9lMFtIUi0lT5IZacpwKKMDWoT9MwFXOda5NKIcuHDF9w7nS5NjeHn9aiUOTP1eCy/RIjunReoxjvZ2/Ov+Dfg2uxk7EovIJOIOcBf/yKxc/ndcbRgRs7mCC/xm2b+3J7MUMvn0ga+0ghML5EcZM7XuhNl6ai/envepMa3cpXVN1vZ9rKQJdxozX5k+xqz/hQNvH2Vzq1TCNFMGdcI/PwV8ZWxbKYUYhlKOhKzoSyDqOAFFPfkYu7NG5clw9MknN8R68ylcDibc21lEsFiHQyc0nQGjLd86HdvZTS2FH4CCY6TiDU1ISzWlqtewgFK8psuQSOBUOhKMV+9Jpi9j7dbd3d3meDGZrMFQfCC4VcGN3YrqKFYwUhQULsYK6kcrUj6NcP0Glkz9Wg/M/Vo0Fp8vQi4jZ7zpUMMhbiQwNjq+TVXKShyWWLJzIWWakW2GJT98IinHgTcqvm1I+N683IuxmNszreCWTUnQSHqX4irt+c7PV+QdFOpuyqEX1toMVIuvRDndkoAIhtkheCBuKyrIJfHjWCTXDRmCeC3/rE1o9OK9ynFZibWU4/ueUtuaiM0hfM2JTJpbMCnRJT2iQYMjinibCZcJE+NV6uAHiKib89PP0JlnXqKzyOoVFbaxg4GyMSMy3IN4h7a7eGIMgcoGNpt68INBC25IsDydx3aA2HbplHwzqXlt1yWKL/oGHGn5Uhoy94goy9k1eWBi8j/zQTKjb55iXLDZBuryupWJoUiOzdwiD/72PXevOQW5vEKgXSvrxuIeQqm6Uz4wbpITLmZbmj4beIUiEU3whSeZa60FvBSO2WKnBROxXilqkVaD+49jERUPhtB5U1DfOTK1pD6cH+Ao8O4ueeqGvucPi9bYyKw1rWXENxZJVQbqXLrihLNlqOji0RXVjpo/L1orsspPD4gjCVcqomsusQlqos71dUlWatSmDbN301AT7XmrpIf7GZupBCrRLZvqcZ/CeHtn7Zu5IhX/NqV26CzQgvnWVSTawD0tfAP8CzQmZeqLto1E+HB/SUTP7opZX4txdSaAwWGy2qseWyPaMjwuU9fdkfYIU6QPVDoPWbvmsJcadIKQY4usX1fc47lNBY2nwrj4rIJdCatodr6Bkks/7BITbe2X6J03leetVEguLquqGhfi5mysU6NqdoaWYiEHcuYeZw4o6ryQBABpkys+5Riyu3uFfdLAshOm8FDgEXm6KxqUCWGfU12PM+RetjcNrZ91TDIjwW5SfOgTBaxFYRU1IIVcjwWOg2P4QeLLCxC4l7h7FpR8coyUd1KrapZuy6yka3TP17GwWXRC3nKM4fVh0+/ZxeFc4N9fUy9rC2z7eVFeXR09OrVq+Pj49evX69k5wZ32xUMDeqPl5KbB3gZeUhw2TfyEuOu4GYhzbzklMzq8E7Ay5P5biFuH9ZbCVe9JSpL5FN/UdWmWHuajMMwDvjj6xmcnwbdkqimjq6uzS689d3BUmqBCl83t8guaAR2cR52E4cr6YsOonJ3sP/y4PDo1fHrPh/lhRj3V2O8QTmOOKel6V2sA0rhYbfC+rth9C5o18X8AYQSNtr9bCYKWc9amFKT86+iUmmsVFmtWrStJfoxftNjp79g226edFXdbLFLg6y7Wun1X0kH0mhUCrEu7Xh7mfrV6mq2CAR9Bf1oTtIboj31tiIL3IBZoDrt9+V3psf4L7UWPTbJ503AEnXaciItL1UueJUtE87vTIssxGlVtSGiKFn7RHWbGrmqENdGTipuay1a1q4qBLts/XK/2Xs1FUYsN4a2vDpnP45khSZdFJGwOKjJ1ra+fFdRm6cdV2ukVCl4tYptv/M/YbfP+Rx0ucBNgwvYR2WiHfZt43yC7XWl2lhu6yVUv9v0b58WhaQa6S6XnaQLjb401JSitcXWZkUDU+3ddWoPncAYzvVibtVE8/lU5kxojaYGF9VdhnrLS1mkpSKItuja2DAeeyv4rWB1lZQB+2UYPm0+UeNl+BEs2l7rKp+K/GZVd+KbT58+fLr+/P7q0+fLqzfn158+fLhae45q1/q/qfKuSw8+LY5pRF/oZUreSTQAqrFlZ0rPVat/61FSHBtF0aZipbw9sDy2L3HYgff60qlcMT04NqSVevoPzCl3pePN5/d955pSR87hDbWyCFYXTo9FkK2kkKrKRbv3Gu1YSpVAF82vLr6NrD8kxQ1Lcrj9bQvZCes38nW13gGOtKW0NdCt0LBNCsYnyAY2Ph2+iDq0sm2fY+Vy4y3mP7KW1mFMYAspeaHbe0b68P7tYju+GPYMbL3OEYMy6pzj0ei10L1MSEYsvBBQXowqStQ4BRI51dqrUM2fBD9d+MBX5kTQhgIT1QIhA0Sgsu21dyxZbECxUHyyIV4WbeNfznBoxa8UwnaDxRpWjxAEzXd1q6U6X/d2ZvlkQ5g1kkV48clS1ik5qubh4ZMjax44tGZp/As3Kp3/0hp3g9PREN2U74VhSWY3NPInD53NeMWdAQEN3ghCx4gq0ImhEz2S9LCkmuR86fEDuiR5NazqoGRbrU5UPeGORmp3rUUkfcvPnq/2ytpWLdfp5kOxytDcQOdH+Q8RGyOQPkJG7S6eKWBSwCtFotUUFnTVCtqSNqvHGqy8GqQGK4J4NRX3tRAlA+SqQrQWJ/iAa9CIOEAqbYv2/TMEdRSaj7Cv8apNsblvwMCGNjMJZKtHrnWQliuTiLCDK08VOMHkoN4i4JseuOKyCS5+XK1+L7A5UmlUy+z4Ct1POe8Nrby0kypl6nrtVASRejO/WztVBIuuR/HcTvXcTvXP0U6VLkCrWkcBbrqnKt0iQkHgc2PVc2PVc2PVc2PVc2PVP3FjVbon/V10VyUIbazFSs6xWlLSH+krEo15YhWba3mLQNn5uz/trGopckvBOVd/V11Vro0niXwRpYgl2oY3VuEIK3DiXKDCJvv+FG6iT+orbKtfr1nqXlnutJa0Mfj6TeeejqmUK89tU89tU89tU89tU89tU89tU89tU89tU89tU89tU89tU89tU89tU89tU79621RRlq1E/tu3jyXwW2n2IOgt2UaE1EXIWSlHmmtkw4pFxWc+aEGIISQTLm+heyJcVJN+foerE/xJzek9E3ScqmJbZsrhBbfH2fLGWdMTAiEwwSAfhaYossQFfPSx0O46rcQbGauyVLg35yRg8y/s3BOwW8rqhsZbsBfDrCjL4Q4d/hwCMqpif5RVoe5M8/2lR/eDq1LBh0at+u5zJb/sul78Du0dXFpoLEo5WgVwxvMPl+tnwdsVxtk/UAnvEubPFb1//xW9y1P2/0+B7xJlz/W+m6r3XWL0c/lvq/y34RNM4GxWHK7Bm6esrXfnh+5wguyr8DFTPtgQQpd/OB08DaP9w6PN4bR/ePQ0rA4H+5vD6nCw/3VYbUhDt9x3Mm6SNXM1bR3jP+NzE1JMqU7HHWmwfAppbrrL5gZZxPLlfhYs3zXInXO7KT/1R0RXHMYYpEP7EvJnJz+TYfmzv3fl5f7PTyJIZFznU4mb/GotNkTb2cfPLB2GWa4nwsaQBcjukPjl6OArqMAWxavFhgi4iGdZ+mFapgOw74XuxAJVJEBGlmIXVZnZdzUn5iJLENs0tcnTJxL7kaeFQ48TB/DXK289+v7U0TBPpOwoe5m9Pur3s8Grg8HhV5AoZ/NNhrtOnQIPRMkZggF0mMTHN6j2Fhk7rRhhwXZ3Yfv711iCF8MvlPsIJy2MZTUReq5lRS2hki78ZHxsceSL8Byjiu5w0AQsM3/XR4Ttqhuit2TYFC1QKs9rrZEi9GcR3DmD0tf2+3ubrObR2wKu1ADctqZ05V/mzW3aSAGi0EMsnKLYG5VqsmenWnC7C5cTumlvvz842OsP9nDJNE6n252hVF6LXc+cXQyI9typnZXd3aSfHx33X+YH4vX+/gD/KHJ++ProJefFy6OiGH+FgIQ7LK8xWU/MFayW+G/RWpcfTy/eX2Vv/u+bryCFrrRdA/9vWsk0zLfQtxXV8s9fTt+EqI3794cYf/Fb7dbDDAjkF1XrprTz95ePBdToECKqAoSLdv7+EhesIqDl/C5emTuRXKaN3+kgIvK/hLTT9Nac5hqzAGuBailsvIpNhHV0EVgC+mJYVCZzYuXeH+7QtbaL4OSl0JHQii04DskQCrSxXcGBiW0j3PjkJG8l/gkH3xB4J7Ro5s6bCdI4Viy6WPpPhzvZ+tGtNsVrd5O15wuX2bkzr4hiz0r6whk3rvTfj8UM3WKlha11FUeJ18KHk6Tjc6TRXYrpRizIeyb+w7+mCfCtGbjC0I3a7h4ZLXArcpB13B/vruTysJzOdZoyDVzNGnK8Gg2D48gCbgGPwKdxLBSeYi4hY8lltv62STfLcfJdYAzv0RRk7NQyXLA3q2c9ehjhBqJmiFIEtKC9hhhliB3SXdLUIUOaJkHZYzMeChAZqjVmSIGjy9Sd4g2KuGFzZYx0b0OGeYGDrhaMN+E9CnSTn3EPotyw3N9ESu1b26vELstLvrEGI4iNgw9NHyeEmIfwAjiI030EVR36i9o6GvHi/UrUk8PInrofuWNNAIcej0I0KqC0vAgE9yd+hTJp/ym6h0xIdGJUr30C6SlAuoyuu20P+ln4byW1a+6+T5knz4UmiQxZTE4FWUKdzf31ZOmqu3CBIheoU2N29v703RsEk0cCzML35S2sqUQJbW8bNsRgw0SVNHqaoQGLbjVDb7WZq6pIos4JEEzfMGMXUSeh8ITKVJZhkj3Dhn+thYk9TEOmxVwkvXnJtMBgu69ML0yNteUaM3NfzepVSK+5awpuXfgeKtoR7DiwchZCQJTn0zgQDgYfOwWUKuhCGsi/KDL2J6EVmapOlgN8WgQJA0cN1/wQnVU5OF4tqBs8L+oqLC81fqoucbLZwnsqeCH09bjkk00pwu2YWd1npbDwUKAO/cjMjZwspjdf5v6uWZoorlHKctpjV2c99um8xz6d9tjpeY+dnffY+YeuzG7/tPXpfKvHtj6dhqRrIFYWGyIRUwOafP12msLnBrFpCr/jMG+NXBIqs7ml0FmTh2BU2yk0XdqXAHJt4XPZNEh6tWC6JvTR/mAwaNGt5isaRr478ZQfVSgoLcJti/68CUqS3MiqwJbgKKRuVoLI2EwYg7MN0rPSJYrZbeAdKbB4aaoH4zYbzxmXuk5h3sujf//85tN/tngUdeKvZhNosgL9PgFipHh0+2+p7jWwfHT/B9hlFJbrcN07S+eFVqradSEImHZIU2meo6OBvfBFwy/34a040WGD/aOdtAZXmdYXjbKODg08YcOEyTmuMRlxI9igj9IEMXFj/Hx+fk59OPjvdzy/YabkZkoO2l9rZUUKmUBl7IqPcG8u11ripAnvBeDMMlxIJpO+57EQTR879hpV3QpNjSA/2x77Wfuvfq6wPUFruYza1+2icT47xeFrTO5TRPDehojnJoi/pyaIKBeR/5uUhzgIk61gAFH4UAtDR1n8AxXt393drWb6c4X+c4X+Uyr0GwH6ddwA8oYetiBOT0/bffDBJb3+lqbR007ErSzZxUcYbDiCpGLD4BLBuRq2REbEH4chckeyI8djmdelCwjVRvTYSOQcl7qTIN+ieBF1UmOWBEBC96FBKCnnsaMJJ5QijWAb/EJLgGgQRaAWi4C5eGvCnGEEP+M3OAncxugUXpdVIb4AqxlslRS0twv8R+53wQ2cAasixObuc7yKqVuAiK6Qbf+0lQRH4Nc0fw6WHZxg7/4a5n4Ya3Wn+PsPrg6thd0GF8V2uipiND6UCxU94jAsUieViTiGu+XJc06/R1SrXLjgqcFLaR6gdc28ey1HHisQyFhRmQhl7HFbDuivi0WDAC2bELtvIbE0PkJIbnxsf0T/C+X45ao1OM6VUnFHIZ/ML4sd5CYLxikSE2ESV9uL/v6sQojLq3GMj3TkOwZwg5SIvJWveXP2WL7mnbB8Nw06h0MOKaq8/vm4KxPeSSGNFn+tpRbFCUOp17cLLULeIfvtNrDIXxCDWpqMDUVuMnppiB2YRzQIJtHidQ4C9K5GGCoYs+NAptHKP05F5cXBTSCybYmlJqtC5sIg6+yDoJSIAELgpynlZGrLVTcmJNS475PC7hJt6c57026KDOPFX4AqxTJMPhUzHr6OEEnpEwkd0Rlk/ayfSg6Kc1uyEx+sXT7PqySrRtW+TnwXLnoR+fjZwOYQMyjt8B6lc+ZzgSQNSojc/Rxgc1AEGtOScxwvdue3nRitcK/gThFRjsMSgzProWfba0txV/d/l1qwN0DDKfvldIFH8MFI23fB4P5eqxUYUDjpETSSFpcVxIaQVAuwsTy/uYZZsQR8nfjL36wDB/ujw5w5zGMOx3EOQjkv4VwBh+wpm/ZDcaZ0b46z1Uu9CzpLEIGxtHbAX0Maz4hIlv5f+C3PSl5Nsvd1WX7EsUhCvwmvpzrhNqiooBPig4d1Au2dq87XxeIUX+w9jQalCn6HkyBc8EOw/NqOKuQUPQbQ6CFN7LfMzh4bdla0vKuZsFO/7qKuaUz+typqGpeppRMLmh4mbmNqC+oLgCKMeIcBuN8QQfACKB5ag1Dr7tqbkPnjdA9ScxonRcK9ZxIPJCGYIS+NDYWnZ4G4o1EaIM0N1Gwk7B3sdZ4eOcnbh1P6wWQlrUQ6C7DyUuHmM3YaZuJxdsNuol2D+dR7VfszzkpkjUytBW7+MVSAv4qzyWuuW8PyGxFlOGVzKh4Nj2dihsZW7EIYLYArGk7TUaA4hYagWjFz4fdai4xdCsyuYEM3eRk2rqEn2yXRY7oolEJAqJsMO0GMBp2TbMIU46JPYGlTXmdbghumqg1sC9vQLx56NPhDyoC69drhCtq+KCmRrmKqavBfIamK+7ogAo1JOeVV4CsaMSbK2fGMLU0uyj+GjiG7vCiGPTakdbPr1o1wj1D7tevN9mLoMz4h7xEhQus7ez2ILVGG8KSTsFUXRqHjcHfOjQEzd33pXmsyAuqbmQ7fTOUW0piN4VzBNjzzY4YzKH2VlXeVndXJEbFPszfe+aDQFE0NAAXk2VQKjZrBRTLDy3PTmHMOONsayQkb1dBOZgtrMIEohWmHxyLUsSyt0KTtloY4oZkdsgVtFtHs9hfZUbiKXoswIbK30i4o4+XWDPjmdFa5SC/BoxGxzIahLFPS1Vu8kRWOaH1Aa1nqI/zgltG47gwfjv5KqHb4inl7omjfIZIiULcDjeFiyKrxH8K3YoW9zmucG2zp1JpHbNaVVsaT5PGifcKxs2BjKRudPC3HruTCeU6ps5QcEhxKqRCNCptGAYlNrj4jgxFtQsmJwT3kWbguynT21ThkPBnsmBrJKKVxkFIBefH+Eda3YQrnKmOXgVce2BktuERXQL6pYtLbOezivDsNB0cHx23mew3U5n9HFxRNcKHNX1oNHkjYSSlMza3YA+bsLpwYTQcmcwikTpqztMCBcEjnMj5xc6I0/nZRkbmcuwO075XpQsKGyOnYs3/DkMby2dxvddymj5qTGQnXCDPu5uILrOR4UHmSfKaVnSBygTpn9P5JWzsJMz0qBUSOKA5LC20kVrjQWIki/hn3dLZc+J3zMnfHaNMZarj1LhhGafSI6gqoDtIh3KiyltnipsV96pju5yR2fxdMWtISS5jMVCVttJJYAgJVSaqZMfwZrrizit0IMWf13OcD3Efp4mpzFW6yI3SJj9ha/YrLedlLZ5YCYYRnV/K39/uDo93+4e7+y6v+8Un/8OTlQXZ8+OpP7SgqIstG2EfWwzf3U9EwKdHJ4e80lS5H4tLazhS1UxS2JpfGwYVQxMVwMiPPW/tMqSY9H0SAw7HTSwePuwhCO87GWdD2oujez6DqZqKBiEWRom0xy8hFzGYuxuz6+pFfCZEqKEln97TGBreboraZKuqyEX38CB8RGxNKABbuJHWbnD2fgunONZ+jcCtLeBGnt271+nzF2YZLX8pqXtvr8GPFK0WFa/S7qm36AjfvZFnKle/4BLfTp4OVgnNOQ0fX+JaqjpNh25LkJi7zXMea938LFNVqQdlE22TvmrVjV+uioGjws4PiXQGcnNnc6RJ4LKqizd6V2/l9W0qDamc3Wd5IvLwp3TwPZhUBZs4Dc8k/NXLuYpG1UE2abb636fEH9Ma8mAs9RWdkqSbG4knSv7OD+cQR/34nwxGjgrmKmiRXVIiZqozVIB/rHcGOCU5DzpaFvrlmc9W/Tn93dv6rRekuzrHog6vVzFgH52N+MD7s94s2ZtVEdBv017dJruKe4OQlalWU/dyGgkncyFVZzUuq/8SdCysOhQhrgYyLYbPhpLb4klwGc6FcxH6qjDRlHMBdxLEMvWVNpQOgYtWmPfAgwO/XyQU1LBpQzPC7lO3xhYvKHebjuigr7/Sj/smYGtdnu8IJjmCCrCZUHxDojeVQ+VSrSpVq0jpAhrFSqZuQ35fmpMUr9r+XiWuehOkerrVnH2aD/oD27AcioEGWEP54RI7+tn5uqMZ6kqML6oaUIQSg3QBlOTbp2kaC2ZD+nKISdnuvdX0pjapjHC9JrIW7AWKCM0raag+aynmD1+Jmi8z2SS3NlPESp9WRIePWAsWcKNKULswQJ2lDW7JRPY1squ7IHgerXBiVBvHCHMGOBJvyqigRL7yaioU7deMOGczKJstUC5wc4YKVzUNvZmBBWa3KhmppHRS30t1daa6UCjcRF9ixUHoQbRm6vx66CQd0wGmsS46Mka+Mj0CVRpF6d6k4DrZEv2VTbcyQ9aMkPSHwFzwty5YiZbnJfcAbpKvqOZo7DZ0BUyGQj5nyoL1HUdYT51d2Iyk0n8jTuZVQBUfI28OnzhSE8Wt2emHdeMix/4JEPoKMZa9BxPz7K5jugLe4HnT/Jvj+CUodyYcQPIA4V1bquPo+k/g/YDW0t7joRMNid0UtiCohwKzy66YKH4sVlknhuk38WXmwVnzbrigaoYf1T4U4I5QMWi3FbfClh9d+blao+ksxZ4PXrH98sn90Muj7SPfZmx9P+v/zN4P9g/91KfIaZo//i/nGYXdzmtD+2SCjVwd9+kdE6g4JbFO7dYpeyQUzVuG07/CB/3+j898O+kgsZwNWGPvb/WyQ7Wf7Zm5/O9h/Gc78cGxatdmo2sJX2sTUf7f9Bh7VU7cbom8YCu0KUblK61SZuTfTuCsPjGdINESQYy5L5DdijGUudKijjluKu2cDYXeLZV/UuShWWjXvlaWeA2eJxXbb5B40lsT+i1bU0mFsfItWhOgeOvUdzgRKdoFmO1tiTI/xPKcgnt8mZRMmSQhMUD/F7lBF/GlGXPjDqbRczeaqDi4cexFpcyOHPjGnvxqdGGkjK41o3OmlOyYpv9Y5UdEhdyQ66BHoCGYK2Z9eVyM2iRhwMsFrTWvMwuI/mti06fbHWrvNsWELtFNT0OKjaa53FgavMSqnpJ+fh3vi+AntraNrALxhwXgpc2t6zah2Gmbc7Z4wMoYNfMh3tQhvA46PpiBq4xFjhRIG+7erD4yzY0RlVuwuxNaWiqF+bN3WMd/Nad2+jCVoq9aZjyu7VeV37FAme7kwFIzqhqGRmG7CrohqN1EUqu+OgwZfLWwzISTR7MZ4e2xxJsRDbVS0WJwFcLkwMxhsqCoudlyWGSMhXUK3xxHg5TMeI8QX/lSfXnNszC6RuBu2pd3TGt5UNdnpzqP/ujWNWnCjqk1N4icHnd1NF0meIyb7u0qKJuDhVCmgOb6hthx1ulyHxKvSUcBJP0QnmyQowP2jq3uiNeS/HrZ1CoGM+oNyNPSJ59uwQS1iDPTi4XaqSlLvLWXAOLsTI+wmX0JherWETwISq7cQlaRtBxFNYRKrPmiNZfSiGm3NM3Mz4s3S4ajERZAFMgdiuEJorlz7i9NqvGJ1JUJrZNv+fdQB1qIdy9uAsNEA7POnt+iiuiHBStr2uw5pI5fLUhegOGMfgRluZZ4WMNBqTSCw08R97EXDJxDiTnegGYIbeOJ8pWHPGbacDmmF5+i33ZgedDztzkw40IZupqTn6JHYc2Ps/abfd4G3tadImptrk9iJ91mO41Jxu2oSPklzwxwEaDd3tAgMJzXuKEND+ooZVdb42CSddKhrdC6aJ23bNIkvbw9g9bYDqA3u14grtQlYKWT3ErH9HkGLEod9M/04QT1k4zkzOXf5UILIWB9yM+j3l+UK1Rxc0mG8dGQ4Cqgx7+30Cu0KXpu4Fl6TIBTPL0a0rYBLy+4oOGcEaqSqhgzPNSqzhf1Chwdn2y0mGuiV9ZboV126tH1JgMP9qCn/WvyBJ9d+FbUCNOshDeUSMU01AO0ayHgqb8+0gklfeG6Z0gVVTsTAS5IdT3PjAbcYM6QGouaavIZbuOPfLtrcWieF8wCnrqax1CsO0GJXe9N8KHv5x3iwQHQYIkTyHBDODeZN41aEJEsoNuAxdMmidjIZZdzqedi8k2KgOBMGNjiNKsmFd/dSG8SvIlSSzGCxhj3X8NlKm0AEOy/SMxIQSFSvsWGpJplxv2fh9wxVEsMsbI/hcbPFpqHt6DM6GQ3vdo2VlO2k1cL1as3SvDi/3Fm6+pq+iCY4iTWqrhm668KIPbemscc3DRMRbq7msDPEA+QmNTvhhxXxjldtmUYurS3QT0ia+Xzfo2kzKkJLE2eduqSmSOOezBnW6S/NVdHf3ay4esRRbZGEBdEoDswwwUThdFIKSzi3A+Qlqk+CXUabdRD0CDTdJv0CDMLhD9i7kyZdK6c5gpiImDWDhjY1d6gFx/JXlXP/Ls5p8K03NYqw9k5naLMt+Gwr6Zzno5EWt97PDa9fXm25c8F4xf7wh5PZrFEmuOKC3trtH570+1vBxry/trujQv+2kSo7lfqJBYCgrVX8x1neHh4Xfe36SsAt7PwWyTZRUVVdsnewxpgn4AEBuoCdkhs9JirMt0nKBUmvFtAuMGYjSE+Ua2Gda0wpNvMQ2AndgXRB4j3x2o0W8lFsaTEXZklqal1uasUvuw+Vg+3OFgwWmaIbqdEIUt2i3XYSqGtHedbwLCq3boOx5xtyZLVbiLmddqA76Qt1wBEqJXertKWCWg8r53yyeclzca9/co9fEuF/m38yW6zwUNwQe4f7rwaFKEa748NRf/dgf3C8e/xq3N894PnB8as+f3k8Fg97L0EeUMWcdlj8GP5+oMHiFEtELFfju8NeOtlJ1+iAY1JEtVSqSA0DuB/QVW6GEnnAJsLD/AOpeDocmV1J1NAtcJdvCDMUehDC37wq9pRuiI1ZJ6die3SqSQxRjxZ+yIuQdWHvmpzXTz9evPszvQvLI4TysMmi+24n8x9T8wkF/JoWy9hrwl3HOlIrsuzQQ0CbTT9GNb+qah8JE1GsseLvLcZ4y6lGIR4I6kyLAHplED9Ee5upNL54EIWZN1iOlHJdUXzErdVyVFth1sD62060AnrJeAkpp/Eh3aXpAta3XC+w5ONFYOwPQgvYEnAaq13xZcpr4yLl7hwENaa9JcJ13IFWiNGg0M1ByxP7obwVPQQ1sPkZnD0Xr17DHuWuNEkTduKLyGsremwqi0JU8Ml44f8Xnc090pA9dqelXRGl3v5pK7yLBnX/duhN//o7I57vuHm+4+b5jpvnO26e77j5B7/jpm2tPcl2cHaQgwMb323265oLBpLrZr39fdtYyJPiye9l3TQGAdlc3JVF+T681faO/y0eaww6wgR6x66eAwM2nGGoIbl8iPshtjd0VCRpK2o18V1EsK5tE9XDqz14mnkEF7zJgHdYpUBjiV+trtPvvcWdO+BUBmGSvGQLoWWhNAVvoxiMnTWwfKhvvbljNp7VEN2WUmG/KtKTd2OICTE3lBkTTEannlJ4IXH5O8bH3lTNxB4vA4cjRQB37cGsQdSDrF9F6fY5Bginsz5AbTsA4RSwFqW45UlEublscWXNJnELhZrzudDIt3lF3wrTYdWqMgb+Ex6drat9HGu657l8N2H1uimO0sPp+2VdhO2uFNz9u1B25YqP4UvHZKDcPjMvAkZbD7nvluts8gsKGKpy0T3dTFUpe2kfKdiLrckvWz0XBt3yELZ2unydV5MW+yay2BDjPmo5gx/k4gMu9Pn7i/OdB5f49qDfH7QVUeO3bhrD1HZbiV13wf6qt7v9ja5w+xve0/Y3vIwtDC2rzbUsXwB2E7sOGgWyF8LgjaHTXSv7h0cvj1+2V8tMzsT1mme5PAXldxfv3rjP424YeqIdts5ZTdcQDCNjteAzPB0tmmANEp2gOMQwcXqu5BXPlJ7s+Vw8CsjM3kwUku9izNa/sy+4Geini9P3pxGiwrGCyIe4N/7coy0unOaX+UOxVnRYwi6aO39kRKdlRpi+6Td2RCSkh/7TdTeq2eYk6Z0qWqoL4qNyuBNRuijHsCxE/aOD/pIIfaOlvMJQjhYuNj5VOJemvczWPOb6KbxJWxiIN+n+nWzsoQsGj2MkrcMy+ke2vJGqu8bR/940OBPEDbDtIjsaQ66xP615geLfy+lV7lJG4Jz6R72lCYt21AqjPI4YjfMnGeV7983x8/2Nz/c3Pt/f+Hx/4/P9jc/3Nz7f3/hd7m9sGGDkL+tMXVKx0yLPx2kABMvXuRqJpH9IY1jeGBhCYNw5ze7A3S38ueL498HRy+OD1vHvfju+/gczrq4c1gxYO0vCLGaowTHZI7Vi303+3PwAPnsBVrtsdY81mOxky6yPVRgBu3pjwSpUBcOwdnGqzy5OpZsS+uQs2heXS0EsVJQK3cF9RSjry2H/dcZRMlZxpKycsjIbIugt1RUYykwm41KlwovL0/c7mfePMA7uZfFlC0mmiEAzd6qbQoWrC+KmuSR865qBfflSc+DW0mH5OFU9pZixFwAV1geatvG3mHFZNt91GfsvmcA5LzLPcrX9wyOLoMV7aUwtNPa0marW3Sq+hflU0IWR2Iuz905ugAR8mZSFkbkdaumkSRcbY3+Qkyk7NabWHJVol+5UVHZ2+jQm1JXVi40zwI3CXpztOMPGLNP3+fIpyCcHSojie+z55ylANyB7cf6U+Tr77efLHvvw2zBvF1XeYx8+/3bpcqgeO3v/2wfmlsCyb5tj5HNKaTc9yWGYoFfe7ixz5Z2qXaU6+w8p7p5CidITXlGB64apSYcy7MWHb1i0F1X+rcTy8rqupP0VaeYlw4gg/fMTaF91C9pX0o/SE3Gt9LXzLtfrlvoW6t14METCeHGDvOqxS2eifOyI9Bkv5VjpSvKvIrFS9tq5f2vQdF+E9apzEnU6NdLgbCdYyc6ZrAyayP1Fm7LIlsnY7+/3d/uvdgdHrP/yZHB48vL1v/b7J/3+V1Plb2XdJFn+FKw1SBq83u0fO5IGJwf9k/3DJ5Dkeoby6xuxuOblBMp+OtuQHJ4G+DF0EFrd0/uzcPn2MqmfLk+fSlRe61uxIYJgTDv4nqBwSHdZguKcfmrIYpHBvt6FQLqesfhTzMF0mFBJY+eH+4OnckJ8mauq6ZV7iu/5hkDECUQv5G1n+mJx5hpUHR0evnxFDzvH0DyBym/0rjGlABE8n2T2zJznaKhgI2m75vp+/+D4HpxX42yElry89n2sa2D8DYcX+qGaPlhTN9K6erdzJwzE9sp80RRXS2oLiTfa8nI+5dRo2mtfVo2ScG5DAT9STq4IBsVZRVMuE0E3d6h2uHt4+OPvfvf67NX5m9/92H993H99Ptg/Ozs9/SopiSWHG9d0F+07XVIeN3WPEYmM/VE05836fDFBZbRFj92BObJiv1fsLa8m7MzVOLNSjjTH9cq4IyHENSfSTusR3L+9icJp3HsTheDmaG+iBtngYM/ofM9Vzas9MMb9TzZRv3n78uWr3bcvD192+A+37PBo92v1MDnlfxtP1ERXNKCxTJWZchxkOSnViJfRmquEfSKRfwtPc5mmz5dPQv7X9DSX1Q7hQIdjdWbJu5qXV79tTNEee/vbS16xHxEgkCZXiSvaYxdVnjnH8/vO79+Nl9mi/EmkpH7QhslZ6WYGPJYpa03hN1P2d+BTLhH6dbT8/+wfUpZ1s+bPfzSpXAgJ2SMdqXv5MOYB74lQaV/o74V6rC3090KFpsfcHY+h9QJuIacmJx7NYrfqgXR6TUrsrWj3/jrjeiJU/CTtnqLcrLfL6YxKK/KpMwSb082A2cXHYNXhagqfFtg1NerDRPEVPZW5tItN9RudBUXYmbR3OJtW8LKNikJ5oag21v+UFlnFwTq4vVfaTtmpM4nbtfy0e19Lo1Zcavt9WEYGwsXlh9V32Z6drkRpUzNI6KycxDNe8aVuhyDVj6AyEep6rtIqkmTMt6qaSIvCezgaJbfuj87o2//FtkpVbZ2w3Vcvs6PBwfHLfo9tldxunbCDw+ywf/h6cMz+u53d6vLpq22j7c+40Cq0iic/QbR41AW90OfixAO/TTSvcExdY4W4FqkFVIvwSiXJBZ8FP2zpvEGp6YBmd8IOTjtCiq9UOIjZqeNe9PK6p9J59Eo2ny6MO+vDW209lkebJUHhvbLJSYkuaoDzoGurZk7LJWqsm5EeKWNVtVvkLf7jzn5VbXIFfXIjPLSAdv/9bBVOG1pChM/KFfTvtRiJPN2loq4O+1R8cP9OhaiH+zVsVxCnFccYuXdCO6IWsdCAsKJqRtqu1t0/Wrf0P7Skvvlk71js6v5yWLnYG5sJiD1TzW2WbOmMxYq9PT/9iBD5Kc4TEkk3lcc/vbclUCaLzcZ1Vlwd64lCb+A0HPu2F7vzn6LHvnWXdAhliYDGajKSzz+Evx8wpCCf+C6IZyORzVlj7vcYU4n3WUq9XA7mzukh0xYFIhQywPci3Dn07vywh3zlYMfJ+VwL0tYZOy2KgMY4HnXhT14hEKOFO5MavWChmLeNnBvcIehiPXSKPnQFM2LONbdKB43LTVrNy16YCqewIG7WYw5VM+Uvrw8H+6EHap0l92u38vz6XTx/mwaeX7N3J4yJY15a6yn8/cB6OvWH0S+fV0MHSCOINq8RvWGywuUOyaF5OFgd32b/EhZBE+5t+rAR7l1xvgs+xJE2MfNFQJdP88XBNDi5r8mQrb6Clv0BACGs8bZZgjjlukCVZo/dSm1rXrIZz6eycvU5OKZWh+IdoenIrv9Tj3CqsTvhBBUcX7Gc7q+V/y77/4elU5xbRfMdi+DL8dH10UELv19xh3Uj4a9m7oKohW32vj22abT1GYY8NV8BBOfK3LP7RohKs/fC/u7iw2XABavEH5/+Vlb1lxWw6UU1TkeKEN2+TwWjK+6mPfvw/urD5YeHQwjNVEyEyv6OHGaHzt+70+yR/LtznFO0/k6cZ6AU/KlH0PnbOdBAssuvZyf613SiMQd/j450gtff0pluEMK+swYmDwrqHwhG0IGAmUzvhaUDtpuKYkPXm00FGwYMhjDLZnAatLC1rkzw8vBCMG+y7Rb2sngE76dwcJu8TzeuTM9/OTWRX+ECJjTLLAyyIn+tRQ/CS+5YE0RAnEFWE3eAOd3eK6pbqVU1a5/3Q9mhWHeDU2FZHa6NGo4Et5nj1DIX5o9wQc5X0YlpY3K+XKIdoM54/gjYpzD3DzSZ9426oVWx/f5B+URWgUTTS2YilYk0fq7kF7JRg0J0V1P9tUaSSEblzlLbzJn73DE1pEOa6hTkJFAshaO/4SSzQuQS15R789KJUgRqFeRrafKVycZ8JstFm2vfzfz9cMk8fPYiJFe0KNzxu4UYSV712FgLMTJoYPPmbbcBxL/Zwbsuy6/fJP9mjTcdNwVjLnc1x+5KOGAFNTgvs+Mdz9mHS/ZO/YXfimWuJHfPbGA2l2nwo0W0EY1xF9z6g/c7E3mQHWT93cFgf9f50jJfxr67fv8R5zQ9QYBYc98k/t9lDoRo5Bpc+C4zGMaj9Ql7TZkeq0d1ZeuH1iTXd7Jaxp6o/bWQp+EelbtBPxscZINHWty+z1ZxRZeuLm0T8LDPSlUXobtSBz++ufSKrBQ3ur9Yd2j3M5x2Us+G7nKD21lTatj11GkPwuFj41boIDQVp6nwxq6IEFfZF+3top6veSzIfQWkl/4+9MYyi4c91/PutL3cP2wPj/3uVwzXxDBK2Gw3mg/BAJnre1uDuCUV2CICrl+7ga5tJbmBcC3mCsf871rfgrBt48wmUrVyzPgtlyVO4u/Iz2k5wjVRbxCmFUvKyvHAxYCz/38zbAmRf9fJtgTPNSXyKZiuzLstIZEc5vC9hw+OIcbxBzHg+FelfUlTSzdDe3NSOBXjlaoWM9wvRWAZWNycds/Y53jB1RAfZbIYQlL8H8E7dnsDXOixn6vlg6XRz+vu+Y1gw9FWK4RqI65zV5RottyQXSS6stJB4+9Fc11OlQ5HLbijuGXVJS5RXdypri7JWpXCtGn+bgIaz7kHRsyNFKKD2PUDvkn9QoLw9k9bN3LEK37Ni5mscDK8FjgfRVaTawB89IT4QCfq9FsZu6urj49k7H4Mee9YHPiHq6uP8W6jDAvECdKw1mW4xgXXT+AON5vIDGRNl4FSuv5x/VqN8MFIFYssPeZuTVMp3FuWftoi9DI912IJTeZGXZ6X4+NX96NIJ7KtgeTfyzq6oqiJn+AHKf+DKEvF7pQui9Uc2MD8XCmctmcemqUXQNZp26ngKGzo+laDg5evVqI8E3aqijVwfsry326x1A+V7DEfcckk6m6R4x5kR1mfDnssS3UHh3JSywLJcHecPzk5xUkDYMvNXXODEpvhgPmRSO9PtCrWhrj+KPbXWugFDhDaagCdOp5GNHwoLY7uMgq4StCpgpHIeU2LP9zUHy/bbh3w6OgN59KHGyPd5jvj7upzZJThyTP2oQUoHL+N4HNyADr69dz9Vfu4X76TePj9m6se+/jhEv/7Gf+jLq9Wz/mGz/Lcfifp5I8gqU5A21KbyMFV097mJnDFufwcORiy+8Khj214Tlc1rixC4fT+8Mx/sHvlTuTxayRjZzhCTocw2SxFmUegyT04LB0NmfEULEENrvdUlHOabZplNwwOukqu62Fshlaaaiwn7pzlvJQ4mDTrzKyc8YnYm8i1T68iLDN3c68W+uvVcWtCPxEYssOlaenKjrIJbXEj3G9IT9GRtreEo5krXFr+a29tfth197YUyX/8ze0h2u/f3QIPfu3tjbB92v5GSP+tlR2h8f20XTKF31HdEdQV+s7/8hSF19JuESouKRP6+2g5Yi76qGqzIqm+rnjef6tve93Q3TUrc+sH/XYN2GYD1w4vGqK7GlxgOiAiUS8x5rlIXaCL1sP7/SAokAiAPBRXoRsOJEAbtUZ9xARRQWeFCv/P9risFRpw5+R5bw4mFVZ3uCRWL1/Iy7Sq3Um7peJYHCWMMh364ug8c6eav8RlEmFNeVWUEEYeb+TLVVVFw+uCPvf2G8Hk4R7NCKZhgUcuwDKiMjhgE8d6mjmvGCjacdcrtPDIiD8rWLGinHN995CXkpsNiVgUEZz/jHyIac1YE4LrrSiWCbNHgFlzxbQTADTYa19OLd2pKz2makv/0KyY/eIiGqjUaVhf8dmqVAx9uK7WkMXG+XVxvsyslng33Lp8/+5jZ53g5ukVO9zap8xsMN7ZkIhB7peIDvbCTh/BP2Bfqkmqp96qySMaavu8U/YcL3QOF9TNBO5HkmZGoTJ3a53VvDLAProiUHawWWOpNRRdM1uPllt3hiO4QVe6e6REuAo0jp/EwNohen83eBxoJJKty7UwtK4Q/5dhi5DwVex4XXXn/BKFMCNAhChS+P8S75PFYYyaU+Iv3Dv7Ly4yjOij+wEOqmdftr22HsOBnNnTzj1tC0PnpHdcwQ3o7bt1Z8IVvC8VSxBE9uBR8GsdAR8hta6Pv+Om2t627mJMN76rS5zQGbmFclfsBCl78ILevVuu93B68riu3EG6JgsLZw0NkR4CfV+Y+ynqYTuGMcD1WP8ZpoHCvMu8IUmkF91LIaNtGE9Baecsobkg3Irfa1/vJ5EBF+7ecFftNsFF/bLyYuzw8Ulvl5OmcQsl/Kz4hbLA141hHe7xn9c2XT1x7cIuDsgwd++VNxDo8vfw005C9qW7Q9uvcd/4N7zjuhr22FBojf+T7n8aG4GXK27/d9dstqcVK1c/Mq8PrZ6rdsUtAaQdGiYaR686Fb7GM6dq3DVeLloLKIWSl9yE+jFZSaSJkHlKRnB7PnkSnOW1sWq2ulBJ6Uk4pNUfF56NlLLGaj7Pfhf+1WKKD9G5A+2zUlaizZ6VCgYbcsPIDocAhUqOA4nxMhIuq+BikXjBVyDiKVqYBvSWlsYStQf795KywU1+e1kMvhd1Ky4xa3RcqF+NTbDhGssABFj4ykSX4cyt/64ZbPUngOuWf9xiVqylKDrZX/gtX8n0usq7Rf7fjecdltNwdP8j4sjLXF7m7hJJMhzE0iaEb0TvB1XQioljBmbCuHJhuIUkQSbWqaZvEFjG/GmPzMxLaV1hlrQMNQ1Vc7PdnGublutfVE46tbsfyHdEDwlsyMx55qVVtbyCp+SOPyscxMb9awSXoPSSvbhNRiC21yEoozLeCNPdrcBL7P0LZrAH+BuLcnKInG4Vha/SElWu3O1/SrNK3DmdA2N7pm7T9aVYjot3waAllBP2tO9cxBpzx/6hB7EqWKHyayoQx1ZUSIPCl4IZhaPlcu62xpFwaZO0oHhENq/7NoSBNK6HF/EclOG1VxMrVtylmLPBa9Y/Ptk/Ohn0fXsGCtzYu0X0AVYcUBik2du8bVleuRr9nf2rpBZrjrbpeMUqLT+njqmfJJgkyEG5bX8mbarkbiUnMBn7WAoEEowQ7NOPZ4YdHuwfYAm/HBwdtEtDyGYf81yWyCdvIna1nVBI5wWyMGBQNFGBLBc+EUDGTnMEeCCLViVUYUWDLFoby3dz8ipso2wk7J0QFQsOIHNyt/+yKxT7Lx/k0Qb3vIRTMDF3fQh2bWYt0eGE+dUqWuZIeTZ9UN9vqpemOYwTMP/mKRYNSGnYMfuXhjn/Gg3grK1z4vGX+F57vS6+zEVOFRVRFZMSiYLiRh68HnQlZPDycBVbIwJfv4weXTEB9qNCsOzXtPxsd6ypu7gmURipm9Ocq7A8cITrubQcHb04v9zppR4NXJIO8rQyJwqMJ8c9/DjMHkQdDpLzTIODBGRxeGFuI3yHgNsFlGMlL5P7ZXM1d95BoDp8tBKVzpSv1Anh/Y3bwYTy30wY4oDtzpu1hACa7D4JSBziv+HkJ1h05v0N+bdh5inkngYH3yePHggQYlGHgH37BAaQm6vZrK7Iq/WhI4UwrTcZeXPcgzu2LcBJT1BobNFkpCed1xCghzIzAsuNUblsPoTtetuU0q+VKGg8900tl1M3UWwib3ExtVqKF1AMZ66VVbkq6Srw4PTrkbSa66ZrDOewGDnBcV1UdFBNjLeNZ+7iPqFx1bvpOUOUl0a5wRYYOH3Z3CzmSThH5n/tYecSI6VueszewZbThMxdmKeQxDDS1mSdN3ea34qqUDo9/4BwCUZwIbALFfHoP2cKNz7zXoH6souP/nof03MpI9NLy0LupA5nOSea5JuKndx5xUCiUHkd0zARtvEJMbZ1EdI02KnenF2uuLeKy1lLtFaUBXS8yq8pCdj2JW8OLN1Qh+oTl1YaKawbV6cuVbLwnJ4degb7OoWhMyKGYDb8ZaRJw3Mt2E2l7qoeG4bFSj/5+KFsZsLUsy4DXh4dtxhAGsQurmWx+esESTGDuoQ4dvHRn8BE0sQNuxNlSUqOQLK4/KKI87b+o5XgelKsUuUun1QK0TaGY2kKrl1BHZVTN2t1XKq7x6//S47JhoCUcjK1e5F5u7LYxSbT5ffgZPrhX837gz/867vfH777z73j6YX+vx//mh/86d9/6f+2NRVRNNrz8F2iHFvnAXjY/YO6tprjgujs5+pTOFRc0Cp1Ad6Tnyv2M4Fk7Gf2LyFd/nPF2L8wkfxbViOcPO7/ULVN/pJ0zR599CX8lUJm/8Lqygn3z9XPlb+Vms/nWMxuxyJt5Hc18nJmqpJWwYkMWfReCnJFPoJOOaWGq23D3LkW4MqtFHc9uoA7RgcM+3krELyVglaa/bxF1G9lD+IbWI3DcoWWM2GF7uCfwg6kPIx/C/HlaY0Dtfixkjg/TVs99vNWnDT3V5y0LaI2TFvCiOznqomItj6heA32OzdqxIi5Ad3Nn/4wJWl85DTF1F0XAQEeLVs5wdOyd8pNoXF2BZVSxEEyH6jF5toC69FsKImDt0akRbFirHAQQQo0QAsBvASJq6YrMelBTGpq8fTi8iMqK1OQ//HxfdyaybbWJtta1i40eS01Mlb6jutCFNdy/ogmkfNVusKdKtDcUufrXZK4efIThU3nWn3p1uQNXu9ng2yQtRMBuM5+swc54/Z79jFsFu/dUOzFo1fqh+1l1yPXfeAv14/VDYxd0rbizJeSzsUOXxmafF7KSUUbGgQVJ079WKo7J/nG/YsaNSLcUk1CzikUa6+iqcPwozajq/WuXr8/yEguSuYgpWUFvICNiB248HfpQvJJ82S3Ja/oZQLK2mvLVWVVQs8gZ//x9vS9l7C/7spq96/+geW+GEEaRmc3ZuwUlfUJlwifkNnGsJn0cWH3b0qBO9wTnJaqBmqTgHR44OwIKrHAxui0SxO/P+7vZ4O/MlHlfG6gm2HKgb5Gzfu6qgjUu7t/EuKmx/4otcA9AzfZzrr5bsf8jKhbYzqfsmIcz7uFP60isGVhG/SfQMEGIx4fyH33AnRfic+95HxlIdYGCXnfOKKjBVOuyV+hPV4FTydUGcvo0nfI+b3rAPijHMsW2nOe3wj7FQ7PKueGgDzJvaFvVzg4zS8rXJzwYwQZnJ3VTs7+QZtq0puPkP2Uydp++yqoycY/gXBkTHzJGDadHivd/vEXnt/0muKL+PrfoZccew4DByPWm2DhJa3VMNmJheAjJK5hnYfzb7GM/48fJz3giAULuOFwyRe4m7gu5j1m83mPyfnt0a7MZ/MeEzbPdv7+OG/zJcZ3iv+/D8+pdPjD5QV7pwpRMtsKIoGYINZvwcUMvDvwHEwiUnMj8h6by5lj6N8fO4F0i5//yPvo/w87aKAlQEkj4h/SZw+ExE+TeuR2SJyuSMHtdV54e/G2csQoVwSSC+FcrFDk6vs/egG++4gKXx+FuNs24ykEgH1uhsqCvNkRE6cwLRoLxxB7NNEo4EZgRKrzPOP5MJ3mFBy9X1frM4AZNbYYLgsXKS0fixwyNKbH7sQI+9UX57LLyuraHXBF7TKq2ptrRy8exiPdCIUkxkGAvYFMYFOUkhFdRUOpjGGrQIOrpx/fEWvoPBcwNpHPJIfBfUvqPSkMNW71A6CUoIp3GDquezpNlAsTyqC9bBjG1+C3o4Kg+sooLfOMvfM1L9jHcdAhKHtz9RYxD397ponhzrlWuTAmiS9FMMGig29TqdZ1sIEfhhpwvyLvItK2j6e5kGFNZ9RXM1VwwdIWEpcWSfoknK0E/jokmr0G2s9P/C8okU1BWMV8oSZyfDQQeW8ZY5e+HYbrWSveFgGHVAd/uDEmpMJ8fwz88nv6Y6iazwpcLvBLjCVlDyvGZa5nkSXZc5/MV/fJdHgoi40z8G/bONOheIOGQkPzd++k6RD0j2ywpST8g9ttHaKghDdET3A8MAScupCUiBG5B6hjVhFI1tLBU9FKOHItOEDTZhEg0yUpF5TE6LE3FNlvtqHzd3/qsT986rG3YoI34Ecuc/Qj6qXyaw9G2OfT/p9P+38+7f/5tP/n0/7/KU/7fwomy5cAtDfvgEDb73hosazhoBGwX8FDCyP947poslq2tp99tK/20WT1T+ekdUnu6o9/LC9NVv/4blqLhv9v/DRZ/eqOmqxyNUtLJ57mqIUiUfLRiBAWtXRQVx0nzTlnEeojTtr5uz+tzcqn1VE1dVLNuWDt2d3s7TDvTs/uR6A1/gaFfvus6YjvMoG+YEnlrnvRRd2pJD2tyY9ftirwwwFeSYVdBCzHTe1O2AsjMwzGmrn8ZzwWClVNuHFkwiv5izOAEjQvxqxSaZM/cK6EKHARpY1JT8KrFGPLxGxuF13be3CNfMri8vfP98883z/zfP/M8/0z/+T3z8y1KurcbghVJIZphHt2oiUUzX6/38LPCC15udnC5eCL02DkabdNhe5poN/FVnD9oulBoIEzjk0umuVqFpx5h7aG1t4ttN8IVXL3eSyIbiDh6uVs1RFCoWRdx8O6GBuG3dqdJ1QY939z939u53T/UGUp3KlDPh6AfzVlAStObggwWyxtNcV9T6b+hwO8nsBdLma8sksRppXr97ugFkWNhkjvCE9tn1Z9zvLzR9pWUzihFkNUGmXtTqCgl1tRoqaXFNUPvApWEMw6FwRtCeNSY2kUyKupMGSIGXe2q+vw5VrzCnc/apxYhi4Uh4O77iAYfe7ADqQwnT+jo+EY0Wjo+Zrz4TbmFt9/10yKavYUU//vZktPZSiYX2FkZVriGbejS7eXPiKiUHgfwml/8TiH1eKolna69c84/Ye05p9N+Y4p/w9sx/+TG/H/wBY80flrYb6uuDXme+Qxz2/COWikjT8mjx5UwkY8roPdgUzG8tIf7uXrVsOoAb8L2xxv5u5TXb7Ry1kXPHzWi1kl0NCL1CvtTvRPoKKHtAFNiHj0qIS0gYXjTGFjxKTr2jsF1/lUosy01mJDM05z0hqqM7tfjo+uj9pF86NalsU1MWhDuG2fUivhylnDqndYNNM0pkZCEguCyRqpWHVzYOynzNVsJi27/MMpIHFW+fptnIBWRBAd9+Hl0fhg/Eocvy6Ko8Go//r4eDTYF6Lf749eH78+Ojo+evVq0M+LHx5ReYGx+VTkN6belG46I/AdZgUKnb2II23CSX4daTg6Hr3cf13w18evX4qXB/3Xr/NXxTEvDvPR6/z1QdtHTgbfEEXnzR+BqDBZy5h/mIsqpBLmWk00nznnteTVpMYqsIpEyriU6B4OdcDxcXsCWQbZFGuzplS+RS6x89rkai42RPBFVbipqSZsqu5Sgt29UnFGqXINN8vtQveUPTYp1YiXHb74x6sIEcUaRBTcilWIXkHxuf7Zlfi1OVfKXFRGrDHcU3i2/daDp2PAfSP1MufCYk/0BG7F48zEqwmJp/iSEG65WjiG4fLj+f9lYbi3CHi4s3YiyDnOPhiVomk/N/Pii2s9J5Bmb6erZ07nPJ+KCHg/6z/FovuWLSIZopEc1cJizRPPn4aFnSanFoV5kx2BSrDbqw1OFc95uXcmypLrvYnaG2SD/ez18t1N7niyXGwI+T8gvjUHvko3g7HPn94GlRUtGHdohTSNSRLvj2HpeWxLlAZRmijoMgjTuvsNDJs1qP6q0xuDxLSuQergfLS///KxC8e/2wzES8e7toBLG1KZEJl0LRHDQctu5F64K8BOefuVGa94c8I2o27f0EN1wvR81mPF/GbSYyONE2UqPJjgqpGqdo//wnV3zev5bN1p3KwlFia0PUrE0y+p1Phv2/1v2B/c7UpPsfz/6J0j9lFpC9Fnb76IvPb/fPHxzQ66nTjidn9XZvXZx8+tYZjleiJsDMaN5YpF/OXoYN3pbgdDvzf2odg9DNMKVwP1XjjcsUC5LN6SpXD3NnSIeudOt1Njy86UnivdhIrXIDPBatOkJk+fSOlHnpZHP0IZYG/YfYqk0TBPJOsoe5m9Pur3s8Grg8HhuvTJ2XyTF4U3x8eBIjlDqxEsAcahbUBhxk6rgAXb3YUD7l9jCV4Mv1BlRuj3HctqIvRc4yCtkazcmVSuuZLxMXIEGkeizSWq3OFxaLomBhXLu+lFJIwOwwhuq/Enpqs8r3E8Ro9O8/H99bhfZ4K6ExxEo3l0e4Erncv16HF0OMUIySyxEO5MOlxXuWenaEfdxYE50Ed7+/3BwV5/sGc1z29kNdmd8RJ2x65nzi4GRIAHxxp1N6R+fnTcf5kfiNf7+wP8o8j54eujl5wXL4+KYryudIRj5q8xUytKt9eJRq6W9W/RVJcfTy/eX2Vv/u+bdemg/PEayH/TAqZhvoW4raiHf/5y+ibsqu7fTdDPJ0m2HqY+oT0P5dNho08e3b/Nb68b4QtDRMlvf8irJpXnrtBAH3dofm7BQ6C4AcdksZeIHB3I17rqwGWChmH4uSyGTI2tqHBq5MKEO/r8UExaI0o0iMfZBVVz6dUJBNH711QIBhMgoNu0YKxnt0w2VU++HW+2D0zieuJOjDE9EO0uqXc5VUc5HxlV1laEm6kIpL9oQkQDLVFZ7/yt0T7P6jmDw26EO3a5MtLK21Z7QFf3bP+05fy5kaz2jJni+vzdEv+LAAf+f9DHNdLZ4Cjco5/y7dp1hK3BvXsPOnorqomNW06QDcB2ieTF6hsqms0lVCOGM03o6EdQDN6OahxnxHjFy4WRBm0MU3UXQc54tWjmhN3BD46L393Rjys/ooxn7J3bHeIHdGs3ZfXoKopwDIEaM1Obucylqk08rLk7BQcPa4aG49gMr3HcMYeNnYkv0jx6wtRIKdz9sYr3v/M/pVfi4EQGFkdID4VbRnrb6lpsPxFzf0FqG/NfMb6dC239lSrhrtZlKZMmla1wYV+uF3OL+OZ8KnN/s5ZpVm8K9ZaXskhb2+CgaJwxRePB2LgVrK6akzLoGpHwafOJGi/Dj2ARX6srF9wWK+5/e/Pp04dP15/fX336fHn15vz604cPV0+dsto1Nm2qIezSg2/txRB3J4xCLxP2Te7PEmWOyaJoE7VSGh9YS9uXKG8xdCxWM9ErJo/lUy6rROL+AzPO6fL38Pl93wWVA5vLHTADmxedYK0b7hztoUlrxdXROABdleGMVWgmUS6YkyM3LEnp9ndd9U6yv5HNq3UWUC7kROLMvDgetJfPuMBKneBOqSbPgC+cG7IIV2cnE7JybfLWXDyy8L6WT7MZr4rrNS+e22z9QJvf7qJMws9dL+VFxtkuokj37OUykGDMxLHS6zYbY8YLLy/LZldNZsJVMHa2228wd1Jbh+2W0PKaRRNn3QnDIUkbPcf9/nqzYIk0PIJR3W419EoHMfx4r2vTFoSgHmXr/Jl/Jq0SjVDVmN1hpuPhWc6Rd4kCFJJGk8gXwrjawM+fL857uMBjpqrgtLDff744N02tKU7GSs6on2GZgdRyEYh1RlxyJpMaN4MlVJ+pylhd+yvkOfkC6MbscA4FjPDigNUcN7nhGDKr2ExaOUk3048X50wL5K3TY/Gbc+zDoWc4qpYQ8neAwO/tMY4tySyXMrLQZQvu4Vzrrkzm+/nB4WHxevz69ctXh8XaQhjX0BpSuFmd8QC2S5J9uuTipDKdUJQ9tG6XuCDtis75r3NCsITEF1xkB5NDjVOsmhMEnCBZAcciOWdsaSW2dl64BiODK2AcjKZ4uxksrGsHiy7soZEjXKdtVyQvBy9f/fAI+wObsOSyWXG4BpeeorDenR+6Vd1OPrsnZsoHGxr18g+ngweG3T882tzA+4dHDwx9ONjf3NCHg/0VQ3cN879rRbAdNgiMlawh7PjQp2g5RPY5VJSQZ4CSsZksV6X1ljXDnONqrOw5vPN14Z01lEnC2ecA0K8ZACLG/+PGgVYT8BwO+vsPB90zc///RIVWE/gcHNpUcGg1v59jRPfEiCK7nkNF/1ChIpq354jRc8Tobx4xCrIYV9QawvgrapDvFhv6GlY8R4/WiB4Rt37VINJXovXrhZm+HrFfMRD19cj9iqGq9ZH7uwhm/UrxqvW5MhfZ/wdF1g0x/yTl1g3BCX6bJjp5+msUXjc0/v9egt1Q+lyM/VyMfX8xdiMn/9+UZUeK/hkLtLt8mMji69yGx1v8LhonlOh1tctJionCiPQXGwm4JbiyNPta9GXxSMT2qzAP5pHs9ose7B/sfy1y8+/P248OdODjNpuvRnXwlag692oNXO9t50ZQGC3d6bRS0KyD3/Z+f3C02z/c3X951T8+6R+evDzIjg9f/mn7K7F2OrPIvj+XrxxgdnH+PcSAsPwOKpPQWnkWkR9lt/+1yKE74+vR+lWcFNc5kuymdCpj7p73fBgN24JpDuflJkofkMlws4k/kGWEwPnYtXLbgDFLjwBmnI20ukN81AjrVKq0hESI47h7DdHs6vq0Klu6C5erJDC+Lt/rOTBfg/GJ3La4dClyVRVtPRpve6znHfkYvNz/WusQh3XLanLtL2lWevH3JScQB0KR7pFWehF2HGJJhw17UzUTexynKazNjf8/HNZ/Hk/1/2sX9Z/AN312Sp+d0ged0v+PvNF/ejf079H/jMj9+t5lHPpv7TsGRP6ePMOA09/S71vC4df06uLQfxc+2wOL+/8fhy7w52/nrgUM/v6csfUF4Dt4agFPLSbSWL1Ij5b4lD67/2yJHx3hzB2u4I012sEigHBwN85rX/vkBRQWZe4UszVm5Enm6gcydpgbhd1paXHehCvSHXEjjg6YqHKFSrRkcf2odCRQdwlszqC9FPY/cIrMmy+uyvKTmPw7jiGgZ7122aU7ncLMvSyrprLK3f/qq62G5fwaz4ZZrDtW4SoztOqRvdHAHAkbTONboflIlji7n1dpDUlToYiQzac3v7/+3cX700//6SkXRTBzO0bnn/79d/XpWf/0P/79d1enp6en7m/84/T0tz88IsatKfb7+tIkdwyCpSXXnsgzX4Dpz73ENGJBeLh020UzfR8jwThfufJtBCu/BHZhLsJEZ+4UW+PuyYwg6f0oDG5I9gLMvPxTj+H/3/zfj6fvz68v/7Tj5z2t4ok4yHiQI67OFIQHDSn+WuP8QgNriwZ0ggro7z6/vbpwYznYAVxZslGD5S3XEoWSrHSHaniwVT3DQeXuModGcgHz/I8fPp17wX3z++t/x18t1CPclhDFAvdC5HLGS9w7h3OQQiUhipDYcGuwNVxRc7T909bZyc/a8p+1KK6tnf88ktXPswWfz1GW9hU9KCAn29AVLZeWVwXXRZQJB8tvkKQtQkWwWaYQjL1c+767qbzdBAGno5EWt9LNF9ZhDIVhvM528Yf/8/bdugjfiMUj+D60jP8gbwUOf+aucclV+aoxKOzuYZcffrz64+mnNz83nlNQye+vfj7zNsd/+FDOzxczRJZ/lPEcQwjiB8cM8/OdrMBAyNe6VHYPXP0qMl3TPGCkhc5gfQ+0uhXndO4yzZiIn7+ZcILKVjHg53MxqifNmZqPciLF83tKaHobvRsj7M0dQVgP44AvmShtG6d59ODxWbG5zAiLcs2Z4JXF9jDmOTZW1PTP5a1y9jDXqq4KFBpLkSMsEPCDXgp7katJdy84pZ62H1Hwy8CIdY0b1YLNS443cd5exd6cXVKJKLtKUSDQ/uA5YEJre4aWQ6WT3QZ10GXph3A8DnaHpKOynDHS+HNOCBDLGxIXs2Gk5BQKL9fCxsJvcCi9dzPE1UJUz50IPVXG9uJVTL1QRU5AC2EsVcP2WF7iIPAe3SjVc6uE7qDOwq1VxbWcZ+xi7O8Zms8F9QNcfAx62KoGezkf9tybQMli+/dMc9qQ022XFx+Z1fJWohS8h+LaGXcmVXq6tLRuMO6ih6NF02qYDHUyeL2f9bP9bHA4/IrDxzYYqz0tS0w2fKWpMF4MVAWG6CBYZCmBFLfvOwxhA4gQHmeshinEpFsICf8IajwuTlbMSFu7yTR0ovRC1du47LMyyMqgOSBCDYgxXk6UlnY6gzy9wKQjqivGkGQvUFCZYFaDwE72sDJI2KuM3ZRzAf6Gi+lNE4/Go6SZYDXj6QxjAsta7/stQ7Af//38vemxQs1wkJ8bped8QUOWFj2CMONOdGHWZoucr8GT1VftgmrS2xcfVxLXGqk2Qq8x1rfIN4Zwo92PzUqW/D/2vv85jZzp8/f3r1CxVWf7OTwGDP6Sq62nCDi7rsdx8gbn9p539wqLGQGzHkbsaIjDXt3//lZLLY00M9gDNraT+Oq5uw0GSd1qtbpbn+52ro1FxJw7Q//7jgvj0yJCwD6U9cveMXTOGaxNZ39I9Q+15rQqJKYNHp1AZDFZQIs+mXGn02MIjViSWpIVc5n/oAjLHB5dDxumsFJ1cDRVCFWb73LfEmvhKGxvtKrViwpmoQALA9R+mvDINNsRdf1VEHkp7Of9wcH5x0H2B937T9TJLRvpIefzSCdGW19YJBEmaYk6YXEgvWESMHjLhflBI6ibSjCye9b/tIdNdEyKEEv9NRQuXaRTvi2RBKum7rSEg3+RuWCLgMfLmT45ahHwJ/VfoDA58eHFyKyCZHulJctIhlTWjnwbc2nn99ogpcn+BU+CNdwpbAy+3BJjulnncaW7pD2ih1JHB7PNsP+gunY0C3BM+VRgCQcf38WKbppCS2gWkHPL8Lpg9KYqVywatsQYiO9ZH2gBAZr1dms+lBP5NuL+DUkgdiBSaeDNF6Mo9En/cqCqE/16dfVxQA7I1cUAIoYp93kkqnIgDLZEeFfReN5XagoKLqo8PIgvYCVY2e4FWAImLahJy5TEMUmmHksFZy2BaTYqg/+wp8iWmGN7R9GKFiurNQOOSDArDDwZGrA72lxgExTd/KQC+TGdbYt2OBH2S6ukkydWzna1c3HxofevYf9yMIRDMLy6GFSlzTQS2RKBO5+cTiXQbv++ChX2XuOQxN1zzQXzV2AjNEIBA13dqRjnVD14d3YECbi/yDKA3dmklwUnc2cnk6eYp5kU1cEn8K0nJQr1AW9AA1EFkdCt4eQrkWLBSLsaZkzdCVkaO95Ofhs1xoLF3m14E85ZEFLZ1Af+dbDR9oKlxdItba59coGPgqV1MudR6C/ryjJRFoF6T9a3Ljjd8mSvdfeDx0TJjGWtqy3G6Rjm8COq/OE7ZWVV5dNi8UJ0PzxBAs80EgFHRMtZZHeCqOcuA2gxUOU6MCOWq5Jms9FQ/7cq77YLMbuymqweEAj02kAzSeaIAdVSduAC1NWPiqR599CkKVK3ru0iDbJP7nCSuvg9kFXdrhxy5EG8pVUPlxoEvIzz4PM4xu0ZG0Ndbgy0EpvQBB7riGDSPRF16/tq/0eheidV+nQc8Vv5PJYEmccEzyJXvY/oSMl4BxIIy4R/Jcxn4ZcMBRPGYQp94gf/vpQNjFi6K/bwjzgoDJitRb29KFk0Rld+JlSQ0bLADxwTPtZ8SRMaC4qDy8Ai+kFQomUB4SvTchGyzEnNjFcD/SFvNWtYvYo4t3ABT4/mz+glovJmugtndjXhiGopsBLYHCpyU9h0YARk4Eyg/GdJBY6YPTiFMezxn4vYz7oOqGAh/rpssIy1MU8LQ8KZUNuoeovkXeqeGv5Ak+A+cUFpuhgubSIYtJIPfVggvPkDo2lM2FfVeARDojhoqHqkQ72ylJMvoVjQCDp5mYdgIJQlKXVCaTrcmZg5xjQy9rvkLc0uEhXvxJdHkYZRRJiKvsFdjpEBGVq1Yq8yejEOrbaEdD5P+DyBB6RouY5zrYLBW9J7O1Lq5VbpjTHRZ0mDUTCzUThZ8IWIlkqa5W9wSAJPN5FEzAAwRZAImglSiATXCdXhNlCacCt9JYKDnHiE/DvjLEA3l5Clkz2F4JVNb/WatNxfe/jBtZJPI2QSxBODFYWjQp7FQtdtAlG69sL5Nei0a08t6xrajUPUG04ZR5sBHpLNkCFcp96OuyvCixdgJFTYl1UgHCwvo8aBmDs3q8SABo/5DPocYN92cuV8jGMaTYED7XYHl3uFgi5wbzPqT43O4IqVCpHJSm7oTvPoNE+z05m/osPyUmA/H6y1l8PbfuF8EjFycdFz6C5B0xTe60pwffbPnIW8hT9AsclUdXSx9DpuvVLFxS05cTsbKwG+Z2V3PaqijlfjuGDTCeOeH6bLEjRzYYpNFE8PQDWlu/AeoqMs1+RVLofHaQjliLa1JtvRMJMV1nfJk3RKuhIBQksWuYjTZDkMBS8pgvM4rIPyoMmSnA8+SKR+YYW97splbWs3cUmlG9qjMQ2KnNJNxu9ZzoTxoXS2y+a94PEkTOFlB+5feHZLFyUM2fl/pBbxuPaG7B8fekfN9slho05qEU1rb0i743UandPmCfn/ro6HRW6m45w17nyGtsb6HrX+BKJGTa/1OgD6QfKkqMDfJgmNFxFN7CKX6ZQtiQ8XszQXrYuvp++71A32hIl8P5et/FmC9vI44grGNGJJVk5Jm6RaaxFcXkTm06UIfRphlf468fXxzQw8Qi55CvyALyrLWRqacI/N5MU2YVxT6+3k92jERcrj/cAv7AEghni8zRMFiEse33Wg9v+zt2pdWzpSuKbSE/WfCzZi/p3Pj4U1lD89ZmgDrfjwJto9//ilDXbS+ccvR3ueM9eM+vdMtgnB77u98rW4k8c09cJ5hTNZTvDOVUJjgV4QhD4cqx3wqwG57F4ZZxgLgIVoJuGQEhQxT8IvECvsv/+vvewQXLkHQLpWEacBGdGIxr48gtZbHXRZ5gsIIuUsTKATUrwqULpWUoHNABj/BbNAuZPC5cBdppdDKHQCZulmhpabXFHchir23+ot+IhsXyXioFiErE4+LDPx1r+BwG+YhpMpE6k1uOaFmgOgTkk4n7PALG0x0pYhjqrCPMimOkZYzHDo5kEgoDbm3MPveT6f1SA0U7M/sMaUsGz1eIlAJ3iNTGbyvpwnzA8FODLYGlG6llF4gyk86mFOLMbj8KsZUX5HNq1/c3Cg3u7UNyDMveeRq0TWt4TIAnjlX8OZiQKPllANfw7xI3qT7Z+8eUlERUrSW04iOmIRFKaOIhnBlx6VLFYJ1F9d9IVBBdd87i1uat5OXsgsbji7b9heYdc30bM7V/beSuE2xsh4AeGdvyCKMg6zLQWx1LAGyzjIYCvwBQExGjZXRowEMcCn+MbmigqKtUfIOYQp5zRJQytORQorkEoCCw7DUPh3hD4Yiwn+BCRITkJEKQtUEVeu6hYHsK+nKBI0YhDqLBXz8jNB0lW8rUHPW0ZF6s2WOIISDHUyqEhrWg0RCE/BQDjKlGb1TJU0SOiLmSZDoNfEYtTyxGLUdA5fVvjVXZ5TGVN3N83GqNVVn4WYQxpvGMGRmbMk5Fl9CZzlDQHK/uMef1wLeMrnQ0nGcPtyzsZjiMF+gVqzczStkfpddnXR36urehw3Mb+NdZDVWRZB5VLXcWypBEBktazgeECcV1SQ+XnLcsNgl2D42retGaVWXKUUs52oph7l547cACgNg3bbEhk7ApClhhlEnPU4SPi4XAUAypZc9LsfQWV1FcV9M5QtK66xAxN4bEbDqAJxd9324G4SOZA2p13rQk4EWrIkjPKiA3hA2I7IFLx0XM3becGI60YjlqTkDHoUsjAu8kDG3Z9NoOTs25coOU213ORNCFxdIh2flvHlWQawDzTgsUQg5derhls2Wam9E2qy4iK2iBzXxeSBWAkfBxdEN9d2quYjlF5+EcInMaAcw7/NGpSHYYnKZ9VGJRyTa/iRJ3uAJ/gP4Oi1aV3u83isHgTyuJg4KLGX4LG7TKjC4B536HFECXdL0lFcRFFWCst4KZprMAWPT1cvjvgkjIvEWaqLStVVJDnhWXOAxxZQ03cL2E3kTDoiCW96er3lcI6d32s34YjGdEiDWRhDj/OESc8ingxhwHths5pO8ElD3wGOD6yP7oBF6Cd2aCyQf2PXnrb8G6AbExURyKB/ECPFFUKPEZ9HEfMh46W0o7/p5j8OIV0mDqyjHPGJwDNsGhXouSFKjTCTNZ6A2XzKZiyh0RZ7XZzpOQoHMBRm+bvhGF5GieqOtWfpIOnDh4E8JuDJYREgofsxJExW7hCqPeo1DihVVcCZACfa28lL1QltjzuNxthhxlZ0T0mrD5T3ZBHHYBHrFWvfDP8NFzhU4UlCYXaBwFmRTdBiHjCMuDskZ4/TprKEFBjwXOEnJYzFnxT6dNiLwQz3Gb2BjLeUzLkQIWQ32FeNGVnKKQjkjKUJpFPAEnicJVjoYd0ULjgw4P2EPrxNyPWaIdkMagEEtqIwf7vkKSImQpVrFjP11i0Yy34g1Ll0liFjCXxsU5p5tBY2Q6U8QK6KBGZcw++kRaGuQ/lPEDhpENISJzY4PGYdNhqzBmVHfvv0uBWM2Om40Txu0+bR4fFodNJqH4+PHHmscA092HJEqhHSYmknyS1HWlygr/5hKLKTCepYJeqhvADy4FZtfwCp2eFoYadM4Bjg/FJIqpEZbyYeAVwVri0DE2MSn+Q1RL2FiiubQRF9kCv8f64+9amQpJ+Bqx36mCHnnCJt1tiRC/iCH0FvMY3KIFilBYzpt4ymwj2K8MdrOMGjpb6WZFvjuakGYr4KmvXajIpZnWM4GDCI0+KnKFfMpmMfj5srRPDqVJSkx1PvWpqoEQk4uI7kuJIAUU74SvYWAyPoH2utiNsoNRhIgo2ltiviAOopAHHDNMS6tQmadKMWsyfEkW5MZAbF68SsTKec6tGqyVJOJRvul0lUbgHwXblpNrDWFVSUQQ+CmyDKOnXTOcmciXhnJ7MvZT09BB7IKKokzsxWz0VVeaIXiYl6OkC0sE9ZyuWJDuPJIhRTs2vZoZRHGu4Lspg7Vz3ec1zAUi28H9H1UpAvMZRCUk9kRiVkw/OxQ7QrNWZEIz17ZB/+YPEYiZrRWOIYAbZcPF56vv0G/p/mkXO4hJXi/ZgqGusGQPmwNK9xXedySzU1ZIRTg/3XvifkDy2pASUuzesye9axE8wNbRnmmhJrEkyifAOiJI0NnpgxAMHgri5/Qleo3lttOV07WvW6KBbO353tQAt8GzuCRSTyG2LwqLf0zl3JdHDKScT5DbhgFFNQAbIPnSVzvgVS42j3IjcOvZbXtv0sCVt13Kzskzu8LPUt7QfpvNwChhleXQF2LRdlYMqI4T1QD7temWcFgmGBikHUrAHA+awjzNjOTIDPtULMHu30qpxF2JhvzXqXKAs4fQ9k2n43R9w0jggncwUy2JrF57EIA/mqBDwDE0k2ebSKTSlYLI460phiGfmMXbrFqgk1G1xm4pAOBB59GzWsfBcxY2vPCJ/cUL4RMw4zWtkMRIUP5J0Vl39P89pQqfKdDLvX8I4xyO2e5u0ApJG/rwDpV4D0K0D6iQHS6uzh1lvq7QlQ0mpq/e7/ipJ+RUm/oqRfUdKvKOkfGSWt7oSXgZKWa9kyShoJvgcdDP1WpEuAg0qQsAYOlyKErSxZQJlJZzaevHjE9Ep2eA/kxwtETFe3vJ4QNl0i8wV8qTv5+lfSKti0bQ++wqZfYdOvsOlX2PQrbPoVNv0Km36FTb/Cpl9h06+w6VfY9Cts+hU2/YSwadn/LbWf86+yT1Y/59ewCxXg9yIqBABKEYcJoo3VsqkPtd60gYNzkZR+hee35R+4wj+McQLS9/786tMZ6V5d/Y/ev2RPxnFCZwxsG++PuPDiD2cX6HVWkg2M61AP2MbbCBN0xXUM6rw/qJPLX979VpcFrPc0RAswfbMZj82SvWxosHYVQV4KpdZ87x9yRaZRhV16HIIBaJWaMpO4wWqMbFy1oj9q4WxO/fSP2p7nTMX8qTy33j9sNhQmlW+t2aA3gDYHjxNy8+CtMhRWnWdZDhBqfEs4ASynDuyE3ZvNIwCAAQ0TTiPFr2zcP2pWlfAYlBw4Sgp/A0uvVX6MN7u8peNmX0coh2ZKg2IaLxJZJBD3CArCgTRrucJxlQWuNl0iE8ym6AnUWTTc9Mg7MxWOha60GRHdDcTIyX3BypjxBG9zKMkOKZ0ynEhTEgJMPpXKQsU2WZpwAPdAnpfl26d0MoGlcDygBWVinzhnT1Cut2bM1OAMhVIwkZuOTGrm/Ru7hiwE1LXN6wctjCCOapS64+qRXfbVM6VraZpS/8abhWnCZOla9RNxcNVtNBqtA7JXy7NH/aWMMVu0nmqOvGqkXlUm2TzJ8+sRmFTkkdvvKMembddwlmJkJpFNDF4Qs+zhi4yrOorLV3MJPMnRNNrtfl4WGGhTb5a9Hjv1r8TBVbPROT0oMlF+voJDj3tGn82irTkJGpq6CtKtdsTeBlu6t7UjPT6bUUxwG6iTGk8UImoOfauSFbv1TKqiMj9tPhaFfXv8rP7bFYwVi9FTaQ0IdaHqsGetIKs2dwu8tcd6GHsbjWYJi+XfvEb1rhNmXM9e2stWOKt1yppbdada2fZWfeS3LBlMWRQ9cK+eR91UZrXNXovrT8nq9X5/93aYzYiEE2+4GNwTbCh2vKaygU4GAfHcXIAx9xdCx0KzdhS69jv0T2fRWPpugKmIYQh4DyX0Cw9lI679gM3TqanVnzl2EmROvnqdximO6rME8e0wf6Q7v1Vxev1wPmXJloRvIHEpJIyD0M8atKgpldgFi8R8jClJFkvzonB1MRie9fq/ng0/DbrD386vfh12zwbDZutk2HvbGw5+7bY6R3dLgEW5BPt4Fu+2xIWPZ+/3dW9tyPYK9mkEKUj2rnHZfh+Poc45AJGyulHIgIlK7ZgtUvkf++wrZOzB8wAfk+siSUN/SsP4mogQ/JLUPC6aQWWmhMqJN9Xj4cWwxEU/9zxvc+aqlWyJxSaSafPamryQLehwH0ckkPgfxnftxUZ7kCWA6V2gKT7xZokMMNM4TERqL0ynRMh1FXZk5/ea2hSIveJ//d+dNXcI3iW8WdDZ0sb0LGLGEC5K5gk0H8rasLzvd0gQyjgSH5P+2Sezf26qGwHuVjgyAM2QuUkiZbGPL+XYihNK10jGm2aBxDoTVlqJeiXJWsYv5nOWQD6sLH9T2InGu+Oj3vG7Vq/Tefuuf9w/OTt5e/Ku/fbd23eN3ulZb5M9EVPafLZNGfzabX7zu3J6dnh62D89bB6enJyc9FsnJ62jo16rf9rstJrtfrPf7PXO3ra6G+5OdtU8y/60OkflO4QjEr1Tj7ND2ahqpx7n3BydHL87OjrqNjrts3fN427j5Kz1rtU8ap1137Z7b3uNfuuoc9bsH58cd96eHbffvjvsHTdbve5pq99911hz50IhFixZH/7gbE4/S0rX3fSVm/kn8w0+SM2k/yVNNXsPcFwwB2UbmcJu5BnVu/wZM5LJJ85T0uvWyYfPP5/H44SKNFn48sXlitFZnfR7P+Pv5H9rrGF1Nv1JDyvwaBPB7uIz+JRaLZEFzotlNsBmnqrKpUsyZwmIFIjSYHBxkNnRUHQgDsSU3hRRIEGbdUbNk+Bo1On4x83Wcevk9LDVavqnRyPaaq8rNTFPh3ScVhKcINtcV2hoyg6u4E3VsoVvIZsRs0vtAyoLnkigM8MjKdNscVx5AsOgQPVOq9Fq7jfgf1eNxhv5P6/RaPzXuhZBzNPhSFa6eEKC0fSpTGzz9LjxGMSqzN+qsKiNZB4MbMiXAWMiJoPLc9SdKYsipzGWfDA1LcTBxyz2AETuAYZIdbPFl210mkjKPfIbyJWlnkORQabqWfqsGXfCgPPzEHNobZQ8ZtEW+C+RrQAADH3P5+vyXOnKCvx+sB4uaN5M4+KY5H7NO1uqv0mV23eaZD6SxhWLuXqtHSrfeOuAD5ym3BZwnHJJOTQqi3iBNzu/11Z45K3O0fCX3nvwyA9P2uCfZF886/Xv+ipOQkhtI3/ma6dx6lEopgWJHl+YPNrb4ucFZMJbUmfNi3Dy3UH3cs8j8ikV5gGTKVkCvy2hxKEJdv+GxDuIC9liC7+V5ecUGkQlH0l8V5bfBVUL+pcDYlNMyC4MpQ+SAPBzHLhYUSaKO/sP63hvtAXKAgKU86w0g+7R9wBhMkA82e1dym6MsAiQZJuThscForWFBcY1+RXgMl0hFgnkMOluU73ug3ghU2i3zgc5C9nt7cncYZEn8/PgATRYJZlYUHVb11Tju/1Ndq/38+dBnXwwdvJ57EuFLa8qDEj7fFa3bemSncZhyaPsuEytDdNtb7meRuuci708c95Dcjhoi/8dstsHEGSXiNgyUfZUgux+eMCBPo/9R6KZRsNFHKZPSDqNoNpHChz4vAELctL/ADbISmFDngwlQGx7D1aaCViZLCF6PnOjXtXJQMLNPhbkvAetNXgSh3QTSh/D05M+D02x0kwu0LzKtVvh5bQarcZ+43i/eUQah2+anTeHp/9TujqbEvdgt+5e6vJ+3ErKmqf7jRNJWfNNu/Gm1dmcMpX2NLxhyyGNADOZTmcVaNxEOLt6/LI+7CYB64YVD+KnQfeBtPmL5AvbEl3wDC/Htx6DGWFRBF/w8U8ZdcTwufhEZf5kqrwVeBGHIp13Ws0HMoR9nfM4y0+/iydWrrZD9xkOYbYzYEn4pbCZ5u2nAnFHnc7hMX4YxgH7alO0ObEi/Js9gFDYYBhCO8bWXoo59SEuRUZhCTK31WifbLJ0wZKQRsPKdbQekD6iptIVsuR1lXm0pbdkPtidOZ3hOB85ieZTGi9kx1QreOIGu+GNCYoH+jwCYwU8LhP5NkP7U5pQX9Z+yDO503n39u1p77h/9vZd4/Skcdpvtnq97kYaQ4STmEI4eOvK8DxL2wHUh81qswhbU/wG4AVw0xjwR9j5pCA/ULt3IeEQ5BdOLmg8Ib1kOYdikuEoockS+rozAweZhOl0MQIH82DCIxpPDib8YBTx0cGEN71m+0Ak/oEvBzgAxsj/x5vwny4OD4/3Lw47hwVZB3egc7S/oarGIMDzuLzC+Lx6GXnixJQmLPAmER/RyNiEWU/EDWl9Dpc2T9rnwUNoeEqXNq+ScA1YdKmwZ8qnHVz9nNm1dXLx84DGkM4R+6HwueXz1sl57HvSw93Kbr8Yd9ZhwEMosj2tLVNV6s/qdeQJdDb0sQh8Ac5rjt6NSPoBHFF8ud+u9WSVe4ZJ0ZwpiOJhZQK26J+sABJmHotJQYfeEIgwrKsHRzqXJWLL6gUI5s9bnaOksifCREpHkI7IggqUjjiPGI3LCHqr/kTGEXXIwoI3AC2N2YSnoXyHk6W3xcL3mRDQLovGeiIsghzCtxCXGhMWS7sH/r2IYxZ5VcmL2dd0qCGqFQh8vK00uNgRkx/JdbPAIx+xkpA0yAEWi2Oq59Dz7mUXC/UkS7KrbUOIeoU0pjIZigqwRmeAMDhII7EvKQFgDBydfTXuyj94X6fpLPqJRvN4X69xPwzEXuYyyJdWoQQ0cw4iAIjLDg4FqYNVHjS9ykKXMLGYsaDCfmwqcKHIgZmlwOG8spoaDkngCVuiRYHanJRWFjPsG20ZQhVoeyLkLa5tXeRtkaTnQt6uWsmWWLxN5C2SUhV5W6T8ZSJvcZ3fDfIW6XkWjOdjIW/tPfk+kLfPuSuPjbzN7c53grytuEPfNPIWaXwU5O0AgyXVMLYFbC0OSbQ05VnyNBhbnPxPeigqsOMRQbZq4kcD2R6ettvtJh0ddY47bdZqNY5HTdYctTvHo8OjdjNYkx+P9fQqUjqb2/atdAERYFnhJfY+3OmDQbYWvY/yGrsOwfnH2fuIfTDIFonFyE0FStc4/vcfeC1bebp6l0VQ0NYO+ise8fnwiPYW/Oh4xFJefGN4xBIaXvGIK/GIJdz6tvGIJQTZjw1bJqr0/WbreMR7aP5R8IglbPhOn4FsSr87PGKeuO8Hj2hTZqG2vgs84graflw84gqGfJ94xBXEfgt4RHvpr3jEJ8QjOox/xSM+HR7RYfx3jkcsp/XbwiOW0fCKRyzDI5Zx6tvGI5ZRZHtaW6aq1J/V68gT6GzoYxH4DeIRy0j6ARzRbxKPiIve0movlQnmdAXDGeEzeGMzXSh5Ek7CmEbYH7JA0k7Ta+2sSda2YXqXwP0IetAoKJt87NdzyqU4ZN5HYhqJuwnU5Ik5jXV14DKaihStoKe0FY95DzXvxjCf7rUBt6Hwuap0H6YCsJU+M213uurLCcOHJjCsCZ9D+l/IzSAUfhULqJ3OrT59lCTsrwVgBqCtRSxhMTguNquQJ5dCqIPCGy35a8GSJbbiMXw8HI9P6cnpSXN07PtBh/5HBZYqKp6Qp3m2yX+r4qpWW0PVCgK712UsQ8DYiEFUiqR8woBVbpc9HBk7JmnGTmkcRCpaYCaB2qrJPgIbof+p4rXI87U9Gp+2xoed4+PRYTugR/TQZ6et06DBGqx9fHjkslOv9YmZqqetLK/2b7CVoe4JaxpoypYgM0bFIkHPUQqxEUoUYMNyW4z1JZFjZqMxbhwdU9oY0dNGa3RsMW+RRHbh3c+fLu4pvPv504UuqYudSQhWy4ELAlyOecTwPlQ9RcnnTxdCPSviN7XqAX6NEiZbGZIAuj+GccqJ8KcMWsvp1ppzmk7x95zwuHot3cp95e6y1nf6chS97YskypRIza27ZPeNPI+J4LIDqmCCUGACmdGlKv2MuHAoFRMHB2A6AP9Uc7poWTfxAuqSQLDB5TmWk4KxoXgVsx55ya1EIE24brJ8jTWj1K6haNxdOkrzzwCwK7DQPlgrj1aetRBWlMvSr9UC0Z8RyyYvubdR5nFMAlW069D6NloEGhcWMSr/O+CptStXxdFDqAodLYlgKUR3whQxwHXYYOihyb6wZAlTAPqL0Nzvc4PraVWTVjJbQA9enpKRad4blDQoVeAx+eURI7V5PLHqLsEaah58Zs11yVOEz0pAjeEabJzbrdasFDpboZ+X0sSb/L1Xl5QXm4jy2EayYf+ogOzWJn/X6pKcmhqhtleUp3k8cYRonNDJrFoUdiMZ+pg1+MXzSeQbjSTn+qdr67SmfG7zEITh+qdriOLF3O0nqxft7bi0LKKoAh05dfJsHULOx3LFoHtUT7BwBmXNsC/Yki9ko7RMjSytXRcptxFJYUyuF0nkwXjXMpEHrAClhiRlwEWI3sUKsQMtzBONANLgbWlhmCHttuuW/Oj4nauX3rTbhweC0cSf/vOvn/Fz9e+fUj53dkmriW9op3Y+xzMegNEWZFpOijJ00Gexw0HsVFeqDaANKUuVDcHjMOWQBqPuCD6S1kFgrqIRw3bj8Inc04QZw0JuOZUZTiTiEwlzZfBF2Gg+TllM/gR9ZaxvRLrK29o5ZLaEmKZ05mdmWCogmx/yXPRC6441EfO0qGw2EhaQzBV/duRoToWw9NFja66POLzWOXipuW02gZsV5s/JcW6edJqbw9KJyIhablqepBWmtV6TFN75DfqbpevgSbpyHe12Mdzebh86i5IOWIVVbXSNwGUgJ0BhVSwcMYHN7uEvmFRWRgOOSYCWWk6oCnfOP+Wdo+wV7bDnZ/HI+RjNRmMbxZxc//NankTzSk8QM2Ct3cPm9YnEE1D4jYQG6m/VrcnkD9DiMSOC+Qm+NNQzzdYjl66+eY2/xhaF5nE0lCkP0No2ZWTE0lvGMtsVJk1vofaqMP6e3tqst3/1hvR3yfzOleVaZYNLK8507E+TcD5ngYk86Kb81nYVLDVrLN3BnyekNubcBNF9PqsB42v2B44ImPfDb6GjPGyZ7CovVrWVr9ZM3uKGs/XK26uw3xsd64EcfaXaqckLxN4dMKwV3uH6TalpqNZbpE+O5FKmBWpbxF3ZUkvmmSGsrvUl+WsBYeYwE1Y4bdoRyW76rA0uhq7ZV5/NU/kBlD+Wn5JFHLAkdwjwtHqEnEOQAkzoEFICzdD5FcigGiZVw/D4d8DK8zgLgqS6aZicudgVOzsxdYsDRlEWCILo1W1u7bii8tNO0lW8VT4/Fak3W+IISuRBWGqMihTvUvjfObadl99z/TJJq8BHD6OTtFyKxagFFRaajlrJnEJ3eUqLo/GOXLDGqKmIAlwTaULDKHNQS44pNX71SuNVC3jK50NJxnD7cs7GY2hCBNgbPkdBQep32dVFHwrpQgLvTQxxJGwc7SyLoNqs69AbOBHO0cbxgLgSJz0/rxnWbrHl8xkMX/u2db7U96vUfbYT1RS//NyRG4gSbxH+8BmHz2l9zw57CpY4cU/979WBTymFsHId/tQWotvEfwR1x3RXefTJwDOO2BdqnN+U243c8UNsaQbyMaXQvChmUIcoWYLKzMI5cZqETKB5KCeRaoUn0gOM5c/CQGsKHaOlMaEyM1ytCG8AS/PPvJ3KcVV/SqHRtLfdU2+3O1ahUZ4sM9ZK03bG4AGU8HG5FoeoMbnodz8CC7tKaPtmKPu471TVeZp2mSFTgfS7rFQQVDfVxlt3GXBJbohJeeRgR4GyHZFd4XUIrZqmB15eRXSjEUtSchbGImVhvC4T5KF9NmmUsz+3OMpF6Oevxye/+LRoCvzAxLqvoupKfzCPaArxgLWlWVGxxavB3kU12bpLtDLAH3tx+q1RK/Up+NQ+T1SHSeeaAe6j9ocwXczj5QywATgsAa90ZgnhZ8GgTlE4JtfwIy8MrkEG1T+AwGttPMP/P1avnTRyr7Y4KLHEwfdfX1zzgupnaQePKaS405LKdZdYlMLCIp9QoQ6mED2DNcG+RXwSxmXUGY1KpUZdl+aER0y4RD9+YRpYL5EzQR4MUJCG2alEmyhHzs7vtZtwRGM6pMEsjKGBScKkwxtPhjDgGuVevjurRRNmDPMf0jDLqH9m0yxbyA9tnGVs+IHNszwTvlUDLU9HRaHehJLNTbRska9G2kOMtIyPL9hMyxb5IxlqGdU/hKm2zZtcz/FyLuk7GPL4N7he5/d6Obv0vch7111iRRF8xCtVz/96W668LTWLnusi1PM/+x1XXTc94ALU6/sh7raUJhOW/pCuOpL+zH46ruKHdtKRBz+wh+5w4EWaCesSUVGWNyFjhSFRfYWvpsZKU6M6E5/LGKm+wmc3Vx7LIqlO8ndss2hK4ctDOtE5HBZ0hmSfVgDQqDE0jAZ2DyCDAPaHxBs+I5SMEn5rpZKas3g1ZUvMPhBTfksWUGqX3LKRTiSF7RUwFICfDLAaM6MXZqka1Fwd8xIwGP6plCvOlt/L8OOUx674PdGCMtYVBGxAxzQJX2YGjkPP59iSg6EjB3ma3vO/wyiiBx2vQXYV1/8X6X38jDtAPgxIszVsqmTp99SHD/7PHunO5xH7jY3+FaYHR42O1/Saup0EIbv/+vXq/UVd/eYX5t/wPV1j4aDZ8hrkPR+FETtods6a7RNk68FRo+01XeYKb0xnYbSswN1Ntv3DgKjxya7G9iUsmNK0TgI2CmlcJ+OEsZEIAFYaB/xW7BUYqL5ZWPca+XP/zd73LsdtI/t+n6dAKbUla2tESY6dyvWtvVtay0lUiWJdy05yTiolYUjMCDGHoAlS8uTTeY3zeudJTnWjQYIkyOGMpET2cuPa0syQQP8D0Gg0fv1gNtEzpzclYbAEkgU5ZtYRT9yLphZkAi8/Rwb8o21PxkTO1O/8RjSl8l5kiYgfSptNHkxvZUkEvNKa8duukfAseBYc7h8dPd1HJEMZNqkfOAE9cp3aG9eORruU+EtTAtZdHyCFe9Gg7Y/GZyiSXOkpK2ZFkhd9Y5JntzJpUk/c/lnEU3dr7e7oMDhqznwPS6qDd7NmxYPZ2vGLbmKeuB7RTz8c/zjEF4LnrBfEs+oaFfngK/b14dPg6APL+eKJRngkuGERvhe5ze7l2qRlw93JZAHRD8zSEuZPbJ9rrUJTtQ4vGwBEyozKEcgEEpPxN5ZbnCxeQldSZxDGqyqRla7Yj+aaaQDc+7iAy8BZxDiDUjoxcZvzBd4AAQGrAm9pY709ahO+huthQOiHfZnsf4DaeTzVhaFST2nv5qOM1a6O5qtUhs5VFEqERjQSXt6R1SLRKmNPRLAI2H8K8X7KfpaZACy893t4AVTeQGJ+6THjTj3jc0T2bEhCJonIOrVqmmDmIWKuUrBmT2yKN7VKv9X53+tgsp89wx+1uymXPeyZCyfULkAJ2C0tOOU8iiRZFks8tpIrWyVDWHHkfLHANYiafE2GGrjGTdxngWvlVI3QY3/2cWqytG13b44QCvZBi4Njd/SR1GEGd5DbI4zaRI077XXpZS4zccvjWE9ZhsavcSzEikdsxmOoI5DpDbYkDxatQoZOT8DWjNVWeKlWSu05cTA08wPuWF6nhCqHHEBHG/GgihwAt/sZsWzcFDEgO89kiXhop//WD93rACwDtYYGXC7hnq5Z66aJLT1axQSGmBTg4C7Uw96yBjpggieHAObzLLyWuQgBldYwkrfkwjHzoYzu401qLSyOgXWJ9svx/cS5lTVlJ7gdgdF28e7i1R78ge4sj/HBstHqBYv6pTL2DY3bvdqlsKq6KdxhXOlFwbMoMH/DZb2DD7didi3i9GCuLsEAeXwAl9NiES3EjGtxUGPwkkQPd3uu8+Wv/x8bKgmrC6N69re9xiUz1GoJJWOv/QS7TVvf/XXH8rXBoVUYw2Jh72s+kJWAkdQ7sj5ZXQo6VFnlWdaUQ82yeglaBJ+H+3EH4Y3WB21Qxp8uBiPFOhTfnxjueVfUkqrzhV+kOPhozdLlEs5jiGvXevO93TE8whsRLGWeCZQ8zmEHc/4BzTz+IrwRl3jL7dIhTl+GmeC5iH59iRDGZbfu3CphwU8ixGjXMHO8/OmVa0i/tfR7mrAlD19fMFPngD0Njp4GXxGeAkyejanVoq68OX+5QcFXkQAU5UMPEDuLOgF7p/C21HVO1gwOn4o8o+PVUBE8mGcCnFuOaWp4cnqyZ2/0EsR77SZ8TQ7UJsNrlKuAnbp3IVlRPwOhDqhRe2DXlmvV6Gamf3vN80upL2EIyGiPbL3mP8jyOgZr2/rpyW+TWseoo31TO+Pw8HBw/QTEpBMPh5QLJcENZlH3BFPzn2m2gePeiC1lLhf4QyULqwyrKhE19NIUjF8j4ULuz2RyEN4IMNwgXMh/wh//KOX41dHRBmIEw7t8UOOnXaTKmIaLwl5TbTEPnBwdHn0dbGIU0H4isuBGJJHKHpAl96p2TYmWBGZIaLH1ViR8FovhDKlMBLOq5EIfM/NY8dxH8e4FHGVquAnHMrg1ZY6sDoND8LiPDoNDAluAP231/GvBloCjoQFLsAIEZexf4GJqalFBTAY8Nq2F1gA/h7O5+JjGSuZWKEuRZzLU7AnPcx6+ZzeY1GMjQowwsz7KfDVlaSZvZCwWgvA36cg7F5kBLt2bMrlMeZhXrboH2NBG2S4gty6gOoZpilJRkCYqGojQpx1OgMf9sq46Du39SIUFsLzX8lSfB883U7FIbmSmEmiNx49H169cstYpnScrViLCoZWQhqZsGw0hWJXMBHSuH4GKcgFIhI9JO2+JonWKgfoSbAm1S1DQINJIOug1lTpglFhdheLehD5Qwg8bK8ez/B8thr/rsayqrfOTH3862asWe9gay5zDdUZqEko/3wiYU2AqBSgSDFHv/KBuIU3hTESyWO6YyWUHCm7u4IT48qeLC3bzFKbXcvosW0RL0M0AJOjd6QtinNpp68vgkCBjVhizjcQcsIXKRmkfUD1c05FjRfgEACTeArAL0L3kCV+Y2NM3p28u3gavs4Wpw8Ge4BcwebJ3F/szDu57opL9NFPzsgwDqxVMAJRGBZOB1NqCSCsGUQac9yGoyLQI0TjBswXby8H7SlVCZgL/csGXmvEwUxq5Zrcqi6MOE01uogAqcgULdYMxi32ainCOaE8G5nBkmKmSSh7ISt+6Wvd6GDB3oPRwoiC+0N5gMs2qFAYGaylUSyJFAAYWz/AQ2JkCtpNgU4AvoZuQx/1StDKE2gxu+BE+u3Xz159ESQ1eQGwWBxQSFQ6CicQGJGGwfGxUeNa16m5upFJqTHOIV5CPsyAcc/b2hwsGrg248lMo2S9zHle1oKoCT9Si+CjCIgcfj81kwiHeNWUXB2enZ69qcVGZUArwTEX4DMQUE4ifwVCcI2KzpVJhRP99OWZ/tvDJbtkdPBUD6EdFeM9TOMZB0ZA4eM6uoFksPXIVYDPUIuQeCm092pNXb/ZFAqtGVOsCphlaoS3A1BW8eYUFBxCNuna8MhMsVSmcA4moPPfDcx0iBF4O9DV/+vyrq72SvVc3pFSeVzmKDhmuGHF/as9qnIM1Pa2TYkUBrFvzcMHhKAAN2qZQFrvKYx1Q1B1euyLgc2oRfw5jCbFq/HmDU5CxlvdfUsv7371+96das3us072uTjdJ6NOuzU1MuL7jAzPirV/24PW4/41rcH/edbc/u1rbn1d9bafy8GdRU/vfvI62qaP92dfO/vTqZY81sv+0GtljXew/sS72514L+1Otfz3WvO6reU3S+cTrXBMX7g7mgTnx7g2tNJtM1RR3F6Y+xXrWn3cN60+mbvU+9PyCzQQePfMkvFaZ+bhvFpJJef/5X+aZGgn/Dzt7acuR0NoDr1MIuwr9g6MIKPx46wC5RtfGG+nGy0hQkMWZkOGNF4zHsqzcBiXD7MPOgx4C4d8JFGKDeTdi++CpOy+C1s0nWb+XBKfii6p0gaUP+AsAy/cPlawjD9ltPryUC7gsBqMrzwpRb91IhJ40zSocLPSV+XDps5sO1kv9YFoMHtUvigyVYjrz8TdA9KAh97letlBo2+q0t2UQLrj1QgeACuMERdfKCAMn5l1m32UyssMijFURVSPgJXy05/wZpBJxOMPyD4oz+tWkTYW1VzHjuNp38Ci6xAcubZPQCVQkVFlzjNQ4x5cCueQLB8KyHPh8Kff5LIyOnn75rN9ATqEFdnpSphtiw6VEyDy+YMegKXxIxZFrqJYgoD/AlwPL6xpVex/uVbfThyWwSkXs76ZkSEbb9jTAeht9DTVjp7clD69lInCMD+qMXgicF4b2RRM0prRcDpjQ+t8a2muaKZzFBiqOHq+MfGg/UAVLJYP6qD3qbd9OC5EK34usmhdO7GfP8DK/od8B62Mcm3LwOCmY32CEa0BuuTQzc+VP2OXY9Ldfzgkdy2ZJlu9Euf6K+xqduWKxhPJHn7Acgflf8QqtoyuYcTbvDd5y14UNe228OazT7bvD6lKasS/Y29cnr1+w79QtuBdLnsIkq8U/nWY9C/2axb5nPq/mdENCYC0X1t/Kbr8znzyNnCZz5VorLQvwOrNzjWOg8L3XPGndePXSZkhg1pkt5KYDEepgtYwDes5cdYNHYFmEFLHqzQaUp9L5WkvvVk0NBMs2MVMqFjwZKN55JRGI/Dlqb/erdDArZNzusq3RcvXeOfr65Ojw/+wMI+f1BcMe3HQhPyGQoeMdB3206DwTeXg9nBjbi6mHlKxKC3xfzACXIxe6ssPv3e887Va/lz5X3YGqGq0cp7WzavXS2pm1enStzTUlnqooGCjuHok6EkiVCSu1lQtdFTK6t57OVcTenZ60O4L/x+D/vXVVtdjuTEWtKf+OnVn0mo7OGpuUu3doG/Td0YYe/+e//lszAsdpkUQz+N/vvFY4P18ueZoCLJrha+fvOxvzRGvbkqdtKWLtJFySHx/dDm1+4jORxhLOYmob0Ir8NoHDOq7a7bC9SKSxWi1Fcs8dV+12dAy+KeA+3jvLTsMdXVeL5r12XDa7tlu/H3r3fk27tObR8lIteOflF5526cdqqSv32b6lqWp7s3VJfBzqCVMPQZWk3OMNE8c8Xe6LLFPOJun4/Iy9oq9qXOOXFZ4Bhj8gxHB8ftbPs6pZjU9ztX5IriqrIZfBXbTbTObCdefbHWEYb/OeakiVvV1hECvny3RjTRauylqH+fAPj81eMJsescae31pK7JkVMgHh26WEixQiVAlg4mD0/F0iPzKRqvDacrmW0yKLO3nsIOuYLvvlgr3LYrrtZOqTT3F1xVN0MFdLRbRK+FKGldfnSnDSkGCtMnuHgntlBkkSaaZyFaq4UYx3yjB13dRff+FUzPYLyaHKwRDsOnlcSxS0MWWp0lrO4pWJq+5zrcVyFouIvXvzg4/c8gidNgCQhvri2bMvD7TgWXj9zw//oDxq8/mLXKUb8FVD396as9Kn8ovboXwTmYNvNukdTP1Uwft+A3j27MtN6OD5dZOOTSUEw8FPyw4pchPJfChEtmqStOEQwTYIB6+DsoZpbULhPOOLZTVLb0MkOy5bodysFZDK8YTa3sDhCMObQnTFUp2rdC2llkwYW93z3ybTVv3m4zb8gpkAPbaphlJYLHhExdUx0am2nA1QCbVTvdDm2MOf08BS5NfKYaWbyX69OqyaJjfltIdZh9xrwSORaQ+9rU0PIHOAL1U7Ph7MScgTlQDsn+3S8rNUicwVZPd+9/btuWVvY0YQKCMTmYeT9jTUd2Dt4ecNtU1RRam3ILWis3Hhc6iF2QZgi1LoSyeZqHPm71cL2JdpjEFjVh/EmqEymAxgraJtLhPMIpqw9ZHKXtreOSm4PwJx6AfUgAkRGZBu/1pyWSzngoWrMBZQqFWAnw7lmpkKwyKDvLHN+PGMjq7B0T021ulg+MgYqhNLvol4TDoJd+MUKc/4suarO7+2Z6/Gz031Nn7WIY9FdOniBcB/8DXERuYccDIgnwf/11xZkDE/u70SPmbzmOeMIjAWpH+fbpDbeJDxzHGFn1ozhfQKyrfFTNlmugEh2k+6BrB3Ueyg8oLQ8d0zg2r826dsz3cPOpwul3Rx13VGLUa/WMoc0gs71hSvufWu8NuQ2ID7vS/aHPCRu9FnVeY0uBEhSX3u9i4ALQvosYI+NtawAv8oz3wpeCKTBRSj91kGEO1yOYhTSGIu+GI4t5MBzHaz2suoWzMhzdQi48slWLqlEVKs6gtEN399Br81gQ2zvyuNlsKsSCBU89hUQGQ9NqlvQZYlap7xpYAL+o9N1CVhj03YGxNmScoznmgDlDXpErR3EbaSb/J410NIcOQcotjpSTDp5qXZu8bQT5uEplvVT0LVPaFKQFrCLrW9C/Gq2EIqYl4rZUTFNaiJoKOdROWWTNMSfHHNbwRcqko04b0DEOkmjDvZX1sK/nujJbCoMiBPOBeQGEnF28yCvattIbonYhGwXdrA7U7Z7oyH72FNSqLf1Wx3ykQe7m3AR2PUdvHhA5FtCMRFcnUbq0G89krkW0BPliE8gvemaLzxmn1awYTKYJWWtQDscm8k9O2rt+wAvGZ98EJGu3tBj0wmTV6ioga16h+lvkHpNoInAV6JNPe6PWpyG9SFvTzWT1QXYZ0HGZtSxyaTZntaxPPLQYt1j/7PK4jVvD4r7epSJ+z2WuCopoKPLLyGXJxbOO8vksS9yPZw+mHeFv86BQ1T0iwT/H2kbpO7KOklmLW9ZVhmrZVN6zKBzVHfpJ/v+9FIc5nddJfbpOy+FlgMIp6eOFYNkLXKuW6PG0I2E8Al4EMFk6Fspjyr9oSPi09D2trNboMhvtianxZDA5e2HpZcVxQpa3l7DW4mk/Uu6BZ0NJzPLUkRKRy9ZjyuUvi3pAc0/cq2BncZLGQwwC/badie6K+lsZUpspn+KefhZePt/vs5InOTZEuQc8gD8cCXgf5LH61JZlUmAx8zkNRq7jYTULYtdUnQiraKLGZhzLh2os9lH5qKVyYru78L1pn9XZVLQZWSBCajwSockLzZp8MqvWfSS6YvSdVN9sMBotcJ6iFTK9fI6xHH1LzJm/bHgSylKhrM0Z/LUpnRO5Ajl6h6vu/90WRzfweQZEmhuwV+6XpHWAc5ZXEjaJF0snaGcUvrTNZLo0cSsJDUCvXQCofUVK4ROvdY+k1ErCqi2Ks5V1QNjXcerq6htJkHc49Ueinc2lcZThiDahUAWEvTPURKGgCeJqc6qJiHOuf4Fy2J1YGnW/l6ENvSnRGMWmQ6jN/T8wa3PCcmdcX6RsQou2I4K1JVJ/MCK0s5T9Tgb9eSC5b+uryZY6XtdzVUo75lMHgytZ9dtmwZq9qP3Sa2hg/Li211G5Pr0MXQNNtN5rdTR8CpyMD1K1NPRNNTpdACsIM+GUw75RZ34pe0JZpnC9d8/PgDfXLvkTnluDKeLRC2veZSM3ZmypXNJYDWGjAzSJCE2IgWiZYAju5aWjBZowiXr9TjTjZ24z2U/whVi2RYCvj0pHk4R79sRtP9ENUiZlfbXes2VOGMccdV5qIuFeMXr6XCUmBrEG42cqxFtxiKEYSrHlDrst4mXYO4PTbwwaUKECSs6pZ5r9XZdeihiPquXOeMbUAu/UWttKOv6RaZw/f9W1FZUWUjDEEnDZdL/rvKWpTMVrm4Kxln0LLtxyaeqHmtIuZk27OTrSgqy5jhUc8MxnYOM+IVXJMww+OKRGXJAa/lTkPG68R1DP9O0t+S/+TaHuHcy6ThWTUl06DDmR39su0kwkGRuxsJCJ2/tSxewdtbCcH2b3Df+3TYVrXVakOYaYMNma5noMJUt1wYguATrxyjlo9qYSurWr9qBj6LRTHgNnVzV7Nf9r9R2S2HhuAvRmH3X/bfCB7vn55TUh58P+dxrBmcFMJQ4GwhbwS6/AacBujH8FcmlgpwtA3pA0VtIl6PSNRUFe4zFHUkAPDOPZqkbcpJ64fG7qQmvl3ncVIFyXcmWCRyLmPtbEecXt168QckUuqBMU+rJrhaoHirShMSBfJ7keDhEOUxGqVRBGJ3Q8shMU7Wud/+6bAno7outwu1tBscVyjUu9BunR/GlzO5KFSh4xXGf8s2md0jwQql1VJAWBdqm+DdKnZ6PmXcHvcDWhbAv31kGhBH8oCx/1CFxTrl8S136vkypiEfHZWW8VtLl80luAroiyuj9PpogJxkF0Q1KvAOLegLls9Apldg31eBoexqyiKRiiSiyu7mNKsCp4H/ZM5kpcsO2/YOf9JfrQhT/5Swa6ZcZxpwNORy+pInwJ5KMFpi6+Oz0/ObZ8Df6fnNV5VChxNfu83UTX5tl2K2pi8oh9fP17lzy8nLkZcuSxWsmJfumZp/fjaTyDtALKs97J9zradiGqYRiaWCcZyHMEhoCp1l6hZapakUx8GKqXleFrZB5IhbVqRgpXDZx24MY7WA3TcmOmJrdKgIRzVFSSnJLuicL5riqc8iDSWqTC5kwrd3XN4lRKF1iUlzlbgqxdG1uRds50z9IeOYHzwPDtkTeX6tEvF/2cvzd8z8DRgfR08vj7D6ETvjIXzxyx47TtNY/Cxm38v84KvD58FRcPScPfn+u7dnP0zNs9+K8L3as7lrB0dQ3uxMzWQsDo6evzp69jW74HOeyYOvDp8FRzv9NtWdBmTF18j02TT950LN81tYMYxdQb5PsgCzmIlrHs9hFHD0QqcMUnzM3TBrYQcglFAtZ60B0slOj9veXCGaLbR0aOQ4WculG6WtrDhokkTmsyVVnb03zpFdAlocgbE0yYqEc3nAN9q6aSodlVoLnZS6cbtQJaHIEltq0RBRUdwYyx167ZJhN8WOLMw4dH4ZGIe3pE7qhCl9FxF2hqQ7ibqf2HNLzhVDNhjs/NQt7z6J90qWOawb4qoo9BNdhNcsFEmu9JQVsyLJC7i7nkTqVteS/xy1Rjy7lYmPoYbpdC4E67gpu9qx0/bOHbgFqqYlpj1oi+aJwMdD/U77PfJwpn7nN+LujJiMXjusiRVmy6HCM36++FLGq3swtYqxSMwkTzbh6ILIIMPD047omufgFUNbUzbPhJjpyDVCDzPE9b1yc3QYHNWW880VZJXBMZ+H35ZulocFAlO6Tw6eBc+Cw/2jo6f7WJVFhnfhxdC3hiXLjPiYikzWqjx7rh+WXrTDg5+zGqnHVWV6tx9bnDHJ7TkqOkDNq3sWINdZCl46XzU2/O2FpUNq2MYGaTR15fOwnrTpW8+8e45mg97oZfdGvtvGeq9H12RgOCcOWC0Bo8tMO9aHP4/KRsd+Oi2VXcCx3QT7ye0h1keq0ysDkNs60eUoL/S+4Do/2ueTYexYlN9P2NgsCwcE7PnIra5FboOCfn3RS/eprnyV/sUSaFBQWnP+NICKzcVysHgIZ/k+xfMnWzNx8MiN2FK5ke02QNK76fRT2UOjn0KDcu+fKBdhOhlGdQ1W+2FpNl2tmdo7ya77XIRU5x8EHW7LCTgrIU8haxDzz2z9CJZB8QnwpmBnAVgaGGw3OBoUUOIOQgXFG4NJz4iz440+dgw4v1hLSLhKnJ1M2c16lZmCsnG3r15JuiSFRZxmMh9O19Muur6xBzRwEkkBfkiJArrSTC55tmKpyFKRZzxXdGJc4Ru0KEOtAmrGe7EaQF6PjL6llr4Xq8YpMcoLzzKh7IhMyk499IiPoUidIzO//a0h5dQfRaFAcgz+fKZuk7YiWzblkhbWc8L7xNSi7601GwOOA/kPt7YevcWVSVORiIggtWD7AdcPnLcCP1lLoTVf+ClrxHk7DMxLrQ27EwHUSxcNKipiPwkDhWNaqJL1rQnVyejovrH+r+ucRPDM39g1T6L67em+G9RDRXpqgIZUxm6vRX5NJfSNbGH8hrxYXOd48mgSHul8EM0F8GOUb/TGajFp0rjBOHH2vrXAo4UWhywPGwrabKzgKucV4UB70OJGQNVwawcmZ6BD/0CnyNzSJFv12cryyUpXky52eXt/oAHIS+XEq/pM2j8WEXrpso+oAeKo2wn8dwxpmHwpcqwdUNLAXgVws/slnlDDJf5QJQl4drlif9O7rsFUS2maQUQ7X9lWILtG53DyDmeSMoNZEABNq4Nv8aHgsc0QrnE4ZbMix/grBJ5Dca1igKADjwM+1g7U7eqAw4xpmRfc4hI0WgWKyiLEMKCM6OEi6AKHb4nnDylszq3afZRQ6ID770BaoIOCwM7MEzt+9GN42mmPUXtGIJ4fKuBgkqoU2lRotUFQ56UgUypv11shr9WOaD8CsDO6fGbUAQA8Qg3/lVDDI2TbCNk2QraNkG0jZNsI2TZCto2QbSNk2wjZNkK2jZBtDwPZZr9nrCWeEbytDt7moZBN2IjgNiK4PUoEN7c5CCHdbZ0qLzRqG8RC7qBhlz0NNxMkhMYh27zCgwp5HBaxyYd130cQN0jQqu/U05KsO3oIA5eqzSJAW69eL9huNAtSpfNFJvSHOMDiMgA+moslpOCKQGSAPbob8vBa0IoWTDZRtS5mD8L0MZsXGURRmS5m+5G8ka5PCF2yJ3gKVrE3ZbW6OHub8fEA0yg4Pfc3f3aP1Y6R2mK63VZz3vRz7KNmzYzZOXl06cK25d5j2nAwTpp0DXS4ehQ6AgmOQIIjkOAIJDgCCY5AgiOQ4AgkOAIJjkCCI5DgCCQ4AgmOQIIjkOAIJDgCCX6iQILdnYwQgyPE4AgxOEIMjhCDI8TgCDE4QgyOEIMjxOAIMThCDI4QgyPE4AgxOEIMjhCDI8TgCDE4QgyOEIMjxOAIMThCDI4QgyPE4AgxOEIMjhCDDwEx2MJ5cQeBtfUJq/OqihwijRPW4LYWdO1gtY/XLj+JMXaFof+Aer5iWi7TeMUikSjI8KwBet3U0A7B59RFiNkeUDXIbXXOZQxJkVWQU2SQho4JPRRIgFPZHLLvOJa/jIpQRFU/zva2VAH1NmGdKiDcIm2DCORT7piowhp0InpI5zwvyttbFHWE4igAUrQUS5WtWAHITtPq/nB1q63k2Bd0GIxMVDLgt58OdV6Z165sOESzWIU8tpsG4ijwG6HtOkwL+sbXc2ffjF2FadHqGuQGAnVOA11+3Y5zlfM4gKo+QeosIYz1ouzY84ZUZGE15HoJpTRA8wLgYak5RqbhyAoyQ6s9i01mMhhF8A3c02qfu9LJK6LpwFE3MMFjxBKjlqoEE+gJxrNG0CuZs4wnC1FFz+AfGtEhHLYeHR7+LWiqyBjhlloyL7cURYa9ia5aKmokslnVQN1PPVAx0C4NsqDVKw/zwtNtnfPe9o+xBeoAgtURagFKJ1TdtdmuSIAnna87OO/mvZe6kj7oxRIJ5YhAgAEUTscbo+aGKNgWBwaUuez++iJgrxP2g0yKj1BYLVSJljovjw6dNhudpnEBzYbXZJOzYj4XmcbmXl/8Ao1JM9ljzqxLHAgPOpcJnGzc2O/x1Z9NoHJK7yPKXaNnOISl2ZFehMZtudRK6jQOJ31675TrFb3tmDx9U0YIpjj+yxnfmegbc2b3iHCnTT+BvaofOnn22WbnBLpmCu2bRHuJfoiJ9L6n0vZk2hRba0wMUN4ZvlPBE8K0KfEGL3g6ll2XszQTc/nxBdv5FXHiftsZpFIt/3jI6QbUh1bDbmTmzoyuzq65DjykZVoH7e7un743QuMdTXYhcnYh/xB4JZHxJcaO1NxHMmBvp9Ik2EFih33myZvjM+fgxLISNnU9yAJwYsAJM5ZLmdM9fYC1xRenjlM4W2Haq4k9QmYJztLBIBPwTNl+8taYqGGSLVt0u2T4CKmREiCvHsV3qr5P+V6SDVmVNIsME9uJfh3XDkqb5KFj/kDkWfqwDzsb+cmjfQhk7qaZmstYVJsRsITz8staD+ZrmSwqtWEcHWRxfH4WjDimI47piGM64piOOKafCI7p/7J3bb1x41b4Xb+C2D5MvBiPC2weFgaKwt10t0HTbZE4ffVyJM6Mao2kiFIS//vi8CKRFElJ43GuB2tgEc+Y/M6F93O+Y3pBzBOQxxR5TJHHFHlMvy0eUzPueqxi7zvgSOcBjYcki8iFlEhIiYSUSEiJhJRISImElEhIiYSUSEiJhJRISImElEhIiYSUSN8fJdIIzPzT/WkK6vvW9whIfLSA+AjpjZDeCOmNkN4I6Y2Q3gjpjZDeCOmNkN4I6Y2Q3gjpjZDeCOmNkN4I6Y2Q3gjpjZDeCOmNkN4I6Y2Q3gjpjZDeCOmNkN4I6Y2egN5oSGr1DwPvIJg1BM5ThFuFPb980acRK8T2sfKmKCBGri6A6ShvD3lJqP4mnLoKcqDv5eU8p8e+EfLyxSYZiaGrZU8FsyyT5IV+gVL3EwrC2s0/fZxgGrvdym1F6H7fsD1th6/wNXkYnjN2ecNbuUDonXBUSXNoMlw/Mf++HO5hIioOqHlC1fBz09MK9EQWSp5MqLyk5aDxxEWnVP4oCe3N+ZMI+Xsf9KFtpX3FHi1w/K9Zk1eZR1ZaFFV6J89YX5HECrCEL1h0Bk4SHajFWwrnqaDQ/jyDJSIvIih4rCcr8ote4nXPKbReJHxedpx9CxYHzg7F21A8qCiVoLzfkLFnyt1W9aS8gV1uXBF55tXCeLGfIfF4hW+rGpw3vZcJi/oJHe7O2UehGyBpgRAdILfyAtyp+IYFME+z16+qI9FvSIIAxrxg1hbuqTC+kTE54mZXd7oMapGXfphn83sTI/Sm4xmDMBMXo/j0a3N3+hkcfbFH02kH0W51PhhBp6XndddH+KWDxKYK2ne0yS4VGcqIM+g3+JT8q//UQqE/v3r9c8+mAirghJVNnh5YJs44/b1xQsh9vqUlld7LBeQ7mXjQe/Ql0aDuVJsb9f8wF5H19SQygMZjzR1OulUVYZK4lhmN0+lofDstbmoMn9c74VXXDBbWgTMtBDb29lWaI1sGjsdJW/n9VT2PnA+eVpFq2PJN6R5HWttO+cb4tdV6/8E5fLDvfGPCMO2mdWJ+jn43y+96lX0BDpe4XW67MiuER7CatodkuttIl6+q1LpLGSQXyfvATa1yyaA/HQEHQzFv7cFQ09IZB/I3VudvaloikRsSuSGRGxK5IZEbErkhkRsSuSGRGxK5fX1Ebm1DS07NG6OZi7DWvCujdTUWEi4iGhxoDFDiQTYsi9u7fO0aQ3C3VXEIQ/d8KASzUm2v5CurjJdl8G6kg6wKm74h0E5ZtRqmbAl+Id5seU1LLkNuxX3fEsGdSJgTFP9P6YLg6v3JpmEFew8hUvomUq1hK65T4p5B8sdKnadWa7KCZEtwnjL7X7VdrQlr04sFcjijNiSHP/Ulkv5ySgrMbzLcFb6S71V2ochjNf1TKyatapXlyvNyXwzLvdTQb3+/JVeQ4sGvrvNsdRGzbeLKoh/oZ0+HPoUsepkLmclskHdHb3MuqBCw4IFwEt0spXFW7O5mrdcRF4CEP2OaNQy/4r1ZFB9NWZEsbyC4KT3kRSZoaRRzTRLXxPdqom3D6H1WfSgfY6JfwK/VBbxRl0E3PUQ+GMZL4mKfxR7uMrv0lOsCO9cCK1bYgVNHIIPseJkzPFye+K8Mo1JKVp4vUkxFGDTvrKsx0P3J4ozkmbmwRSRClltkuUWWW2S5RZZbZLlFlltkuUWWW2S5RZZbZLlFlltkuUWWW2S5RZZbZLlFlltkuUWWW2S5RZZbZLlFlltkuUWWW2S5RZZbZLlFlltkuUWWW2S5RZZbZLlFlltkuUWWW2S5RZZbZLlFlltkuUWWW2S5/d5YbsfcGOYg0L6eEFvWqmuhnlZCHGmtS9eAqDFZQ/skQsgfAulG9fwH4fmxLh5IxsoKojU/HFh7YI0R69SwumGwsYNtNO9SEbkBV/pmqzuaFxDgOFxysgZCykVwjrpIYGULq6nIW6ybKutSlg39GMfb3gSqt4TMMsH7nH2QKY+JfTXdNXobLIgl7lp2hMM+bNL/C38D5Cv8B7sxkXEVtKd3Sps1oQVsGTGYyjt5xi/6VwEBTqrtWX8m090pwpkzAHeWiRD0ZcwqJ2eFXpNVtt3UFW/3DePvis27jjUPkCGq7blhDSSIrlKaHpjKFDVvTRzvIclIYt5tn0ToG7LrGjGueLe9zPL3uXmFCV2SZ2yz35BBvDVhBeVtnnJGm/RwsUyOJ0hVBL86X45iOCPOG+/mEXrclpuc6JfYh2YiLTEAaZ4tZg1/p5l5BrIT0sA+8u00gyuPA/tIWAmsgtn44kNjs1a+czl7MMtZIBynNxtplB6Io/zK84C8NXOe7vNSTEj6SVuQoAuddttL6G9YBIdocCGNHLViJlqTtCpLlrYXYyEES3Qy5Zk+vwx6ZdAnPXqY0AX8/Hu3gyAzl9zMsM2KD/xcOiPuQV98CgFNT/SQzieuTHoqma2Yz6IZl1V/rnT8oUzH8YpLaCNelpkIXrB3ZdC/iN1mH1nawS0X9HRoqlIUI4ZQKWr9xqf57aTOvTuFSWMUeWlyrMTG6xzV05ZuKRd8vfcbf4/wdHpHdzszin3SE2YTgkPrRLeuR36mYfGWtuxoBxBpXEacwxPpWm2SvDK7PUR7GWnEDIJSvWgKEefPvAAje8g5TjHDQPBzC/PyOKikByyn5lUmtoTWXmp1sSFvZPDREDi3NUpcUC7OERtAuQnL5qyeZ5TNCGHVZAMREQ9tW19fXSkZN2k1lnhNVg3dbvP2+G5lrE+uRA2zAiE/j1QaBNkyWF5kyVKRZxMU+PrnP0dlvnrXsY7dgZCm+FrsI+N8DhXaaeNU9J34tOkbpZMj6um8znz1VyqR4GFNaas6T40UIv2FnJO62xY5B8JeEROasvy9VRTCRE/3j1aFburIE78SPBP+DPlv9mo/2ksGZJ9FkfdLvMHqauyLbHJXg6LJ5Xg1PvJTvSLT6zfI9DpcPo1lDMC6gZDBumAtI2+bYq1ii1MgpFjLTESozgEcx5skMjFpDSaOBmVLiaNE28BRncHZqW6qtkqrPsFBBUeuiZihf4AZml//sEniSgpGeoTCPOKgoCbyGu5QeL4t1NPvJeWcHbcFy8jb1698cIkEe3Wl7h03aXW8fv78pyt58/LXd3+x1pU/tVW9QC5Ja/ZYyYb0ZK+6DeRLdG7F+3oHUxxVbcT12oieP/9pCQ6Lrvs0DcFwCGhHGXKJZsSJ/pFDRLSh7l4CyBzXWoJw19C9zZe7GCS56VtRdyHiPE9JocnPRS04CGiuYUHUqNuqnkSqYcLYCs9/S6YtFfvyCHnBTQBPH0ZjG4UUjGZ9+rSHx3jKJKqd4Q/GEnvkMxo4svZgcYsEhYzb1RBVNrlU0oiwBlyZm2XvwjyBRupRpwQ+y8y29UxJUlpWJeSSqRyl/iHkWJW5vPH8x+3tf7R4iwVp2I41DWs8koynoYmXN1ee16ptdeTJ+QlQB5y8rkrOFnuYbgAuLDp+l9qcPN6ZP24W8C/ZmAx0VPZQokmUm2SGaAO2XV6KQ0RCpi/Notje8uGq5ncAJ/YBMKpzdaEGx5j+JlrDJUW+YyR9SAtGaElY01QNOVBOqlQUQssWyuMZHaHBER4bUzaYPzLm2gQrFGCFAqxQgBUKsEIBVijACgVYoQArFGCFAqxQgBUKnrZCgSvK4tAQnz7GsWWho24EUSzALAQqBCz4jjGJbo7OsD4B1if4YuoTYBTyzCjkbyQIOfkk09GXFYPsacqdf/wC+8BMzDzBURiwBNYJwTohWCcE64RgnRCsE4J1QrBOCNYJwTohWCcE64RgnRCsE4J1QrBOCNYJwTohWCcE64RgnRCsE4J1QrBOCNYJwTohWCcE64RgnRCsE4J1QrBOCNYJwTohWCcE64RgnRCsE4J1QrBOCNYJwTohWCcE64RgnRCsE4J1QrBOCNYJwTohX2mdkHFa3NgQ3rkoNBPpdKSEOKYwW/Q1EM358cbzxG0m/rutWloMKVJCqTk3pZ7DXt4w3hVt4sIZOVcMDdydy3a0aQ0QG0lMZJJIwass/PJSsNCzzPy6D+ORNvem1jyHF4epyCuANrUxx0wIdmOTARkkQcLi4lVGgBtzyPqF2Py4+XGhICfRIo3lHeGRhzVmbj1Pcee0547S/0Vhz4M+Mgt42C/dsVNFC17RB3jHfXPIdy2kRDR5mnjR7fLsE6H7NW94S16WddeSF6ygD1Fc7bb9RLjkLPG3okrvwXVvgbYkhgzmoZbyey881zFGPb5Sf91PS1fyhCd7dD3F51fxfM2JGdOrgVZoYAjjqna9kDwJ9j1OMJ9lpfmW8mLl3dHE16sxAvRIP34GoEf6cYwySVxwkMd755px+SSTNVVdG3u9CTcYoZUuQI8ABPSr2lOsJD1ho7lqjWVRdOGTgni3FFMSnsYdr//ttmZtYKeXdq/e7JXQ9xD0JVHH63+7bR15UA9z5g+/EqYZ5P8/AJC2miY="
 }
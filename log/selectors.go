@@ -29,6 +29,7 @@ const (
 	Onboarding         = "onboarding"
 	Otel               = "otel"
 	Pipelines          = "pipelines"
+	Proguard           = "proguard"
 	Request            = "request"
 	Response           = "response"
 	Server             = "server"
@@ -36,6 +37,11 @@ const (
 	Stacktrace         = "stacktrace"
 	TransactionMetrics = "txmetrics"
 	SpanMetrics        = "spanmetrics"
+	ServiceMetrics     = "servicemetrics"
+	ErrorMetrics       = "errormetrics"
+	ServiceMap         = "servicemap"
+	WebVitals          = "webvitals"
+	BreakdownMetrics   = "breakdownmetrics"
 	Transform          = "transform"
 	Sampling           = "sampling"
 )
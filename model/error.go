@@ -184,7 +184,7 @@ func (e *Error) fields(ctx context.Context, cfg *transform.Config) common.MapStr
 }
 
 func (e *Error) updateCulprit(cfg *transform.Config) {
-	if cfg.RUM.SourcemapStore == nil {
+	if cfg.RUM.SourcemapStore == nil && cfg.ProguardMappingStore == nil {
 		return
 	}
 	var fr *StacktraceFrame
@@ -297,6 +297,16 @@ func (k *groupingKey) String() string {
 	return hex.EncodeToString(k.hash.Sum(nil))
 }
 
+// GroupingKey returns the grouping key for the error, as recorded in
+// error.grouping_key when the error is transformed.
+//
+// Stack frames are not sourcemapped or deobfuscated until the error is
+// transformed, so the grouping key returned for errors with such frames
+// may differ from the one recorded in the error document.
+func (e *Error) GroupingKey() string {
+	return e.calcGroupingKey(flattenExceptionTree(e.Exception))
+}
+
 // calcGroupingKey computes a value for deduplicating errors - events with
 // same grouping key can be collapsed together.
 func (e *Error) calcGroupingKey(chain []Exception) string {
@@ -619,6 +619,7 @@ func TestExplicitGroupingKey(t *testing.T) {
 
 	for idx, e := range []Error{e1, e2, e3, e4, e5} {
 		assert.Equal(t, groupingKey, e.calcGroupingKey(flattenExceptionTree(e.Exception)), "grouping_key mismatch", idx)
+		assert.Equal(t, groupingKey, e.GroupingKey(), "grouping_key mismatch", idx)
 	}
 }
 
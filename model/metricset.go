@@ -19,6 +19,7 @@ package model
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/elastic/beats/v7/libbeat/beat"
@@ -38,6 +39,10 @@ const (
 	metricsetEventKey       = "event"
 	metricsetTransactionKey = "transaction"
 	metricsetSpanKey        = "span"
+	metricsetErrorKey       = "error"
+	metricsetTargetKey      = "service.target"
+	metricsetURLKey         = "url"
+	metricsetExemplarsKey   = "exemplars"
 	AppMetricsDataset       = "apm"
 	InternalMetricsDataset  = "apm.internal"
 )
@@ -50,6 +55,12 @@ var (
 
 // Metricset describes a set of metrics and associated metadata.
 type Metricset struct {
+	// Name holds an optional name for the metricset, identifying the
+	// kind of aggregated metrics it holds, e.g. "service_summary".
+	//
+	// Metricsets with a name are considered internal metrics.
+	Name string
+
 	// Timestamp holds the time at which the metrics were published.
 	Timestamp time.Time
 
@@ -69,6 +80,23 @@ type Metricset struct {
 	// metrics are associated.
 	Span MetricsetSpan
 
+	// Error holds information about the error groups with which the
+	// metrics are associated.
+	Error MetricsetError
+
+	// TargetService holds information about the instrumented service
+	// targeted by the requests with which the metrics are associated,
+	// e.g. the callee of a service map edge.
+	TargetService MetricsetTargetService
+
+	// URL holds information about the pages with which the metrics
+	// are associated, e.g. the URL pattern of RUM page loads.
+	URL MetricsetURL
+
+	// Exemplars holds a sample of the events from which the metrics
+	// were aggregated, providing a path back to example traces.
+	Exemplars []MetricsetExemplar
+
 	// Labels holds arbitrary labels to apply to the metrics.
 	//
 	// These labels override any with the same names in Metadata.Labels.
@@ -81,6 +109,14 @@ type Metricset struct {
 	// instance, such as a hash of the labels used for aggregating the
 	// metrics.
 	TimeseriesInstanceID string
+
+	// RollupInterval holds the interval over which the metrics were
+	// rolled up from metrics aggregated at a finer interval, if any.
+	//
+	// Rollups record their interval in metricset.interval, e.g. "10m",
+	// so they can be queried separately from the metrics from which
+	// they were rolled up.
+	RollupInterval time.Duration
 }
 
 // Sample represents a single named metric.
@@ -150,6 +186,52 @@ type MetricsetSpan struct {
 	DestinationService DestinationService
 }
 
+// MetricsetError provides enough information to connect a metricset to the related kind of errors.
+type MetricsetError struct {
+	// GroupingKey holds the error grouping key.
+	GroupingKey string
+
+	// ExceptionType holds the type of the error's exception, if any.
+	ExceptionType string
+
+	// ExceptionHandled records whether or not the error's exception was
+	// handled. If ExceptionHandled is nil, it will be omitted from the
+	// output event.
+	ExceptionHandled *bool
+}
+
+// MetricsetTargetService identifies an instrumented service targeted by requests.
+type MetricsetTargetService struct {
+	// Name holds the name of the target service.
+	Name string
+
+	// Environment holds the environment of the target service.
+	Environment string
+}
+
+// MetricsetURL holds information about the URLs with which metrics are associated.
+type MetricsetURL struct {
+	// Pattern holds a URL path pattern, with variable path
+	// segments such as identifiers replaced by "*".
+	Pattern string
+}
+
+// MetricsetExemplar identifies an event from which metrics were aggregated.
+type MetricsetExemplar struct {
+	// TraceID holds the ID of the trace to which the event belongs.
+	TraceID string
+
+	// TransactionID holds the ID of the transaction, or of the
+	// transaction to which the span belongs.
+	TransactionID string
+
+	// SpanID holds the ID of the span, if the event is a span.
+	SpanID string
+
+	// Duration holds the duration of the event.
+	Duration time.Duration
+}
+
 func (me *Metricset) Transform(ctx context.Context, cfg *transform.Config) []beat.Event {
 	metricsetTransformations.Inc()
 	if me == nil {
@@ -167,6 +249,10 @@ func (me *Metricset) Transform(ctx context.Context, cfg *transform.Config) []bea
 	me.Metadata.Set(fields, me.Labels)
 
 	var isInternal bool
+	if me.Name != "" {
+		isInternal = true
+		fields.Put("metricset.name", me.Name)
+	}
 	if eventFields := me.Event.fields(); eventFields != nil {
 		isInternal = true
 		utility.DeepUpdate(fields, metricsetEventKey, eventFields)
@@ -179,6 +265,30 @@ func (me *Metricset) Transform(ctx context.Context, cfg *transform.Config) []bea
 		isInternal = true
 		utility.DeepUpdate(fields, metricsetSpanKey, spanFields)
 	}
+	if errorFields := me.Error.fields(); errorFields != nil {
+		isInternal = true
+		utility.DeepUpdate(fields, metricsetErrorKey, errorFields)
+	}
+	if targetFields := me.TargetService.fields(); targetFields != nil {
+		isInternal = true
+		utility.DeepUpdate(fields, metricsetTargetKey, targetFields)
+	}
+	if urlFields := me.URL.fields(); urlFields != nil {
+		isInternal = true
+		utility.DeepUpdate(fields, metricsetURLKey, urlFields)
+	}
+
+	if me.RollupInterval > 0 {
+		fields.Put("metricset.interval", formatRollupInterval(me.RollupInterval))
+	}
+
+	if len(me.Exemplars) > 0 {
+		exemplars := make([]common.MapStr, len(me.Exemplars))
+		for i, exemplar := range me.Exemplars {
+			exemplars[i] = exemplar.fields()
+		}
+		fields[metricsetExemplarsKey] = exemplars
+	}
 
 	if me.TimeseriesInstanceID != "" {
 		fields["timeseries"] = common.MapStr{"instance": me.TimeseriesInstanceID}
@@ -205,6 +315,21 @@ func (me *Metricset) Transform(ctx context.Context, cfg *transform.Config) []bea
 	}}
 }
 
+// formatRollupInterval formats the interval over which metrics were
+// rolled up for metricset.interval, e.g. "10m".
+func formatRollupInterval(interval time.Duration) string {
+	switch {
+	case interval%time.Hour == 0:
+		return fmt.Sprintf("%dh", interval/time.Hour)
+	case interval%time.Minute == 0:
+		return fmt.Sprintf("%dm", interval/time.Minute)
+	case interval%time.Second == 0:
+		return fmt.Sprintf("%ds", interval/time.Second)
+	default:
+		return fmt.Sprintf("%dms", interval/time.Millisecond)
+	}
+}
+
 func (e *MetricsetEventCategorization) fields() common.MapStr {
 	var fields mapStr
 	fields.maybeSetString("outcome", e.Outcome)
@@ -232,6 +357,45 @@ func (s *MetricsetSpan) fields() common.MapStr {
 	return common.MapStr(fields)
 }
 
+func (e *MetricsetError) fields() common.MapStr {
+	var fields, exception mapStr
+	fields.maybeSetString("grouping_key", e.GroupingKey)
+	exception.maybeSetString("type", e.ExceptionType)
+	if e.ExceptionHandled != nil {
+		exception.set("handled", *e.ExceptionHandled)
+	}
+	fields.maybeSetMapStr("exception", common.MapStr(exception))
+	return common.MapStr(fields)
+}
+
+func (t *MetricsetTargetService) fields() common.MapStr {
+	var fields mapStr
+	fields.maybeSetString("name", t.Name)
+	fields.maybeSetString("environment", t.Environment)
+	return common.MapStr(fields)
+}
+
+func (u *MetricsetURL) fields() common.MapStr {
+	var fields mapStr
+	fields.maybeSetString("pattern", u.Pattern)
+	return common.MapStr(fields)
+}
+
+func (e *MetricsetExemplar) fields() common.MapStr {
+	var fields mapStr
+	if e.TraceID != "" {
+		fields.set("trace", common.MapStr{"id": e.TraceID})
+	}
+	if e.TransactionID != "" {
+		fields.set("transaction", common.MapStr{"id": e.TransactionID})
+	}
+	if e.SpanID != "" {
+		fields.set("span", common.MapStr{"id": e.SpanID})
+	}
+	fields.set("duration", common.MapStr{"us": e.Duration.Microseconds()})
+	return common.MapStr(fields)
+}
+
 func (s *Sample) set(fields common.MapStr) error {
 	switch {
 	case len(s.Counts) > 0:
@@ -36,6 +36,7 @@ func TestTransform(t *testing.T) {
 		Service: Service{Name: "myservice"},
 	}
 	resource := "external-service"
+	handled := false
 
 	const (
 		trType   = "request"
@@ -102,6 +103,138 @@ func TestTransform(t *testing.T) {
 			},
 			Msg: "Payload with valid metric.",
 		},
+		{
+			Metricset: &Metricset{
+				Name:      "service_summary",
+				Timestamp: timestamp,
+				Metadata:  metadata,
+				Samples: []Sample{
+					{Name: "transaction.duration.count", Value: 3},
+					{Name: "metricset.period", Value: 10},
+				},
+			},
+			Output: []common.MapStr{
+				{
+					"data_stream.type":    "metrics",
+					"data_stream.dataset": "apm.internal",
+					"processor":           common.MapStr{"event": "metric", "name": "metric"},
+					"service":             common.MapStr{"name": "myservice"},
+					"metricset":           common.MapStr{"name": "service_summary", "period": float64(10)},
+					"transaction":         common.MapStr{"duration": common.MapStr{"count": float64(3)}},
+				},
+			},
+			Msg: "Payload with named metricset.",
+		},
+		{
+			Metricset: &Metricset{
+				Timestamp: timestamp,
+				Metadata:  metadata,
+				Error: MetricsetError{
+					GroupingKey:      "abc123",
+					ExceptionType:    "NullPointerException",
+					ExceptionHandled: &handled,
+				},
+				Samples: []Sample{{Name: "error.count", Value: 2}},
+			},
+			Output: []common.MapStr{
+				{
+					"data_stream.type":    "metrics",
+					"data_stream.dataset": "apm.internal",
+					"processor":           common.MapStr{"event": "metric", "name": "metric"},
+					"service":             common.MapStr{"name": "myservice"},
+					"error": common.MapStr{
+						"count":        float64(2),
+						"grouping_key": "abc123",
+						"exception": common.MapStr{
+							"type":    "NullPointerException",
+							"handled": false,
+						},
+					},
+				},
+			},
+			Msg: "Payload with error group.",
+		},
+		{
+			Metricset: &Metricset{
+				Timestamp:     timestamp,
+				Metadata:      metadata,
+				TargetService: MetricsetTargetService{Name: "callee", Environment: "production"},
+				Samples:       []Sample{{Name: "service_map.edge.count", Value: 1}},
+			},
+			Output: []common.MapStr{
+				{
+					"data_stream.type":    "metrics",
+					"data_stream.dataset": "apm.internal",
+					"processor":           common.MapStr{"event": "metric", "name": "metric"},
+					"service": common.MapStr{
+						"name":   "myservice",
+						"target": common.MapStr{"name": "callee", "environment": "production"},
+					},
+					"service_map": common.MapStr{"edge": common.MapStr{"count": float64(1)}},
+				},
+			},
+			Msg: "Payload with target service.",
+		},
+		{
+			Metricset: &Metricset{
+				Timestamp: timestamp,
+				Metadata:  metadata,
+				URL:       MetricsetURL{Pattern: "/products/*"},
+				Samples:   []Sample{{Name: "web_vitals.count", Value: 1}},
+			},
+			Output: []common.MapStr{
+				{
+					"data_stream.type":    "metrics",
+					"data_stream.dataset": "apm.internal",
+					"processor":           common.MapStr{"event": "metric", "name": "metric"},
+					"service":             common.MapStr{"name": "myservice"},
+					"url":                 common.MapStr{"pattern": "/products/*"},
+					"web_vitals":          common.MapStr{"count": float64(1)},
+				},
+			},
+			Msg: "Payload with URL pattern.",
+		},
+		{
+			Metricset: &Metricset{
+				Timestamp:   timestamp,
+				Metadata:    metadata,
+				Transaction: MetricsetTransaction{Type: trType, Name: trName},
+				Exemplars: []MetricsetExemplar{
+					{TraceID: "trace1", TransactionID: "tx1", Duration: 1500 * time.Microsecond},
+					{TraceID: "trace2", TransactionID: "tx2", SpanID: "span2", Duration: time.Second},
+				},
+				Samples: []Sample{{Name: "transaction.duration.histogram", Counts: []int64{1}, Values: []float64{1500}}},
+			},
+			Output: []common.MapStr{
+				{
+					"data_stream.type":    "metrics",
+					"data_stream.dataset": "apm.internal",
+					"processor":           common.MapStr{"event": "metric", "name": "metric"},
+					"service":             common.MapStr{"name": "myservice"},
+					"transaction": common.MapStr{
+						"type": trType,
+						"name": trName,
+						"duration": common.MapStr{
+							"histogram": common.MapStr{
+								"counts": []int64{1},
+								"values": []float64{1500},
+							},
+						},
+					},
+					"exemplars": []common.MapStr{{
+						"trace":       common.MapStr{"id": "trace1"},
+						"transaction": common.MapStr{"id": "tx1"},
+						"duration":    common.MapStr{"us": int64(1500)},
+					}, {
+						"trace":       common.MapStr{"id": "trace2"},
+						"transaction": common.MapStr{"id": "tx2"},
+						"span":        common.MapStr{"id": "span2"},
+						"duration":    common.MapStr{"us": int64(1000000)},
+					}},
+				},
+			},
+			Msg: "Payload with exemplars.",
+		},
 		{
 			Metricset: &Metricset{
 				Timestamp:   timestamp,
@@ -211,6 +344,41 @@ func TestTransform(t *testing.T) {
 			},
 			Msg: "Payload with destination service.",
 		},
+		{
+			Metricset: &Metricset{
+				Timestamp:      timestamp,
+				Metadata:       metadata,
+				Transaction:    MetricsetTransaction{Type: trType, Name: trName},
+				RollupInterval: 10 * time.Minute,
+				Samples: []Sample{
+					{
+						Name:   "transaction.duration.histogram",
+						Counts: []int64{1},
+						Values: []float64{4.5},
+					},
+				},
+			},
+			Output: []common.MapStr{
+				{
+					"data_stream.type":    "metrics",
+					"data_stream.dataset": "apm.internal",
+					"processor":           common.MapStr{"event": "metric", "name": "metric"},
+					"metricset":           common.MapStr{"interval": "10m"},
+					"service":             common.MapStr{"name": "myservice"},
+					"transaction": common.MapStr{
+						"type": trType,
+						"name": trName,
+						"duration": common.MapStr{
+							"histogram": common.MapStr{
+								"counts": []int64{1},
+								"values": []float64{4.5},
+							},
+						},
+					},
+				},
+			},
+			Msg: "Payload with rolled up transaction duration.",
+		},
 	}
 
 	for idx, test := range tests {
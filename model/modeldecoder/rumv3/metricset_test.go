@@ -85,8 +85,14 @@ func TestDecodeMapToMetricsetModel(t *testing.T) {
 				// tested within transaction tests
 				strings.HasPrefix(key, "Transaction") ||
 				// only set by aggregator
+				key == "Name" ||
+				strings.HasPrefix(key, "Error") ||
+				strings.HasPrefix(key, "TargetService") ||
+				strings.HasPrefix(key, "URL") ||
+				strings.HasPrefix(key, "Exemplars") ||
 				strings.HasPrefix(key, "Event") ||
 				key == "TimeseriesInstanceID" ||
+				key == "RollupInterval" ||
 				strings.HasPrefix(key, "Span.DestinationService") ||
 				// test Samples separately
 				strings.HasPrefix(key, "Samples") {
@@ -84,8 +84,14 @@ func TestDecodeMapToMetricsetModel(t *testing.T) {
 			// metadata are tested separately
 			if strings.HasPrefix(key, "Metadata") ||
 				// only set by aggregator
+				key == "Name" ||
+				strings.HasPrefix(key, "Error") ||
+				strings.HasPrefix(key, "TargetService") ||
+				strings.HasPrefix(key, "URL") ||
+				strings.HasPrefix(key, "Exemplars") ||
 				strings.HasPrefix(key, "Event") ||
 				key == "TimeseriesInstanceID" ||
+				key == "RollupInterval" ||
 				key == "Transaction.Result" ||
 				key == "Transaction.Root" ||
 				strings.HasPrefix(key, "Span.DestinationService") ||
@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package model
+
+import (
+	"context"
+	"time"
+
+	"github.com/elastic/beats/v7/libbeat/beat"
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+
+	logs "github.com/elastic/apm-server/log"
+	"github.com/elastic/apm-server/transform"
+)
+
+const (
+	proguardMappingProcessorName = "proguard_mapping"
+	proguardMappingDocType       = "proguard_mapping"
+)
+
+var (
+	proguardMappingRegistry       = monitoring.Default.NewRegistry("apm-server.processor.proguard_mapping")
+	proguardMappingCounter        = monitoring.NewInt(proguardMappingRegistry, "counter")
+	proguardMappingProcessorEntry = common.MapStr{"name": proguardMappingProcessorName, "event": proguardMappingDocType}
+)
+
+// ProguardMapping holds a ProGuard/R8 mapping file for a service name and version.
+type ProguardMapping struct {
+	ServiceName    string
+	ServiceVersion string
+	Mapping        string
+}
+
+func (pm *ProguardMapping) Transform(ctx context.Context, cfg *transform.Config) []beat.Event {
+	proguardMappingCounter.Inc()
+	if pm == nil {
+		return nil
+	}
+
+	if cfg.ProguardMappingStore == nil {
+		logp.NewLogger(logs.Proguard).Error("ProGuard mapping store is nil, cache cannot be invalidated.")
+	} else {
+		cfg.ProguardMappingStore.Added(ctx, pm.ServiceName, pm.ServiceVersion)
+	}
+
+	ev := beat.Event{
+		Fields: common.MapStr{
+			"processor": proguardMappingProcessorEntry,
+			proguardMappingDocType: common.MapStr{
+				"service": common.MapStr{"name": pm.ServiceName, "version": pm.ServiceVersion},
+				"mapping": pm.Mapping,
+			},
+		},
+		Timestamp: time.Now(),
+	}
+	return []beat.Event{ev}
+}
@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package model_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/elastic/apm-server/elasticsearch/estest"
+	logs "github.com/elastic/apm-server/log"
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/proguard"
+	"github.com/elastic/apm-server/transform"
+)
+
+func TestProguardMappingTransform(t *testing.T) {
+	p := model.ProguardMapping{
+		ServiceName:    "myService",
+		ServiceVersion: "1.0",
+		Mapping:        "com.example.Foo -> a:",
+	}
+
+	events := p.Transform(context.Background(), &transform.Config{})
+	require.Len(t, events, 1)
+	event := events[0]
+
+	assert.WithinDuration(t, time.Now(), event.Timestamp, time.Second)
+	assert.Equal(t, common.MapStr{"name": "proguard_mapping", "event": "proguard_mapping"}, event.Fields["processor"])
+	output := event.Fields["proguard_mapping"].(common.MapStr)
+	assert.Equal(t, "myService", getStr(output, "service.name"))
+	assert.Equal(t, "1.0", getStr(output, "service.version"))
+	assert.Equal(t, "com.example.Foo -> a:", getStr(output, "mapping"))
+}
+
+func TestProguardMappingInvalidateCache(t *testing.T) {
+	event := model.ProguardMapping{ServiceName: "service", ServiceVersion: "1", Mapping: "com.example.Foo -> a:"}
+
+	require.NoError(t, logp.DevelopmentSetup(logp.ToObserverOutput()))
+	client, err := estest.NewElasticsearchClient(estest.NewTransport(t, http.StatusOK, nil))
+	require.NoError(t, err)
+	store, err := proguard.NewStore(client, "foo", time.Minute)
+	require.NoError(t, err)
+
+	event.Transform(context.Background(), &transform.Config{ProguardMappingStore: store})
+	logCollection := logp.ObserverLogs().TakeAll()
+	require.Len(t, logCollection, 2)
+	assert.Equal(t, logs.Proguard, logCollection[1].LoggerName)
+	assert.Equal(t, zapcore.DebugLevel, logCollection[1].Level)
+	assert.Contains(t, logCollection[1].Message, "Removed id service_1. Cache now has 0 entries.")
+}
@@ -51,7 +51,10 @@ func (st *Stacktrace) transform(ctx context.Context, cfg *transform.Config, rum
 	// - abs_path is set to the cleaned abs_path
 	// - sourcmeap.updated is set to true
 
-	if !rum || cfg.RUM.SourcemapStore == nil {
+	if !rum {
+		return st.transformProguard(ctx, cfg, service)
+	}
+	if cfg.RUM.SourcemapStore == nil {
 		return st.transformFrames(cfg, rum, noSourcemapping)
 	}
 	if service == nil || service.Name == "" || service.Version == "" {
@@ -74,6 +77,51 @@ func (st *Stacktrace) transform(ctx context.Context, cfg *transform.Config, rum
 	})
 }
 
+// transformProguard deobfuscates the frames of a non-RUM stack trace which have
+// a classname, if a ProGuard mapping has been uploaded for the service. Deobfuscation
+// follows the conventions of source mapping: the frame's sourcemap.updated and
+// sourcemap.error fields are set, and the obfuscated values are recorded in the
+// frame's original fields. Stack traces without classnames, such as those of
+// non-JVM agents, and stack traces of services without mapping are left untouched.
+func (st *Stacktrace) transformProguard(ctx context.Context, cfg *transform.Config, service *Service) []common.MapStr {
+	if cfg.ProguardMappingStore == nil || service == nil || service.Name == "" || service.Version == "" || !st.hasClassname() {
+		return st.transformFrames(cfg, false, noSourcemapping)
+	}
+	logger := logp.NewLogger(logs.Stacktrace)
+	mapping, err := cfg.ProguardMappingStore.Fetch(ctx, service.Name, service.Version)
+	if err != nil {
+		logger.Debug(err.Error())
+		return st.transformFrames(cfg, false, noSourcemapping)
+	}
+	if mapping == nil {
+		return st.transformFrames(cfg, false, noSourcemapping)
+	}
+
+	var errorSet = map[string]interface{}{}
+	return st.transformFrames(cfg, false, func(frame *StacktraceFrame) {
+		if frame.Classname == nil {
+			return
+		}
+		errMsg := frame.applyProguardMapping(mapping)
+		if errMsg == "" || !logger.IsDebug() {
+			return
+		}
+		if _, ok := errorSet[errMsg]; !ok {
+			logger.Debug(errMsg)
+			errorSet[errMsg] = nil
+		}
+	})
+}
+
+func (st *Stacktrace) hasClassname() bool {
+	for _, frame := range *st {
+		if frame.Classname != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (st *Stacktrace) transformFrames(cfg *transform.Config, rum bool, apply func(*StacktraceFrame)) []common.MapStr {
 	frameCount := len(*st)
 	if frameCount == 0 {
@@ -24,6 +24,7 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/common"
 
+	"github.com/elastic/apm-server/proguard"
 	"github.com/elastic/apm-server/sourcemap"
 	"github.com/elastic/apm-server/transform"
 	"github.com/elastic/apm-server/utility"
@@ -176,6 +177,9 @@ func (s *StacktraceFrame) applySourcemap(ctx context.Context, store *sourcemap.S
 	s.AbsPath = &path
 	s.updateSmap(true)
 	s.Function = &prevFunction
+	// Any context sent with the frame refers to the minified bundle, so
+	// it is replaced even if the sourcemap does not embed the original
+	// source, to avoid showing context that does not match the location.
 	s.ContextLine = &ctxLine
 	s.PreContext = preCtx
 	s.PostContext = postCtx
@@ -188,6 +192,38 @@ func (s *StacktraceFrame) applySourcemap(ctx context.Context, store *sourcemap.S
 	return
 }
 
+func (s *StacktraceFrame) applyProguardMapping(mapping *proguard.Mapping) (errMsg string) {
+	s.setOriginalSourcemapData()
+
+	var function string
+	if s.Original.Function != nil {
+		function = *s.Original.Function
+	}
+	var lineno int
+	if s.Original.Lineno != nil {
+		lineno = *s.Original.Lineno
+	}
+	frame, ok := mapping.Deobfuscate(*s.Original.Classname, function, lineno)
+	if !ok {
+		errMsg = fmt.Sprintf("No ProGuard mapping found for Classname %s", *s.Original.Classname)
+		s.updateError(errMsg)
+		return
+	}
+
+	s.Classname = &frame.Classname
+	if s.Function != nil {
+		s.Function = &frame.Function
+	}
+	if s.Lineno != nil {
+		s.Lineno = &frame.Lineno
+	}
+	if frame.Filename != "" {
+		s.Filename = &frame.Filename
+	}
+	s.updateSmap(true)
+	return
+}
+
 func (s *StacktraceFrame) validForSourcemapping() (bool, string) {
 	if s.Colno == nil {
 		return false, errMsgSourcemapColumnMandatory
@@ -19,7 +19,11 @@ package model
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"regexp"
 	"testing"
 	"time"
@@ -244,6 +248,31 @@ func TestSourcemap_Apply(t *testing.T) {
 	})
 }
 
+func TestApplySourcemapWithoutSourcesContent(t *testing.T) {
+	var smap map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(test.ValidSourcemap), &smap))
+	delete(smap, "sourcesContent")
+	content, err := json.Marshal(smap)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "myService", "myVersion", "a"), 0755))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "myService", "myVersion", "a", "path.map"), content, 0644))
+	store, err := sourcemap.NewFileStore(dir, time.Minute)
+	require.NoError(t, err)
+
+	col, line, path, contextLine := 23, 1, "/a/path", "context"
+	frame := &StacktraceFrame{Colno: &col, Lineno: &line, AbsPath: &path, ContextLine: &contextLine, PreContext: []string{"pre"}}
+	service := &Service{Name: "myService", Version: "myVersion"}
+	_, msg := frame.applySourcemap(context.Background(), store, service, "xyz")
+	require.Empty(t, msg)
+	assert.Equal(t, 5, *frame.Lineno)
+	// The minified bundle's context does not match the mapped location.
+	assert.Equal(t, "", *frame.ContextLine)
+	assert.Nil(t, frame.PreContext)
+	assert.Nil(t, frame.PostContext)
+}
+
 func TestIsLibraryFrame(t *testing.T) {
 	assert.False(t, (&StacktraceFrame{}).IsLibraryFrame())
 	assert.False(t, (&StacktraceFrame{LibraryFrame: new(bool)}).IsLibraryFrame())
@@ -20,12 +20,17 @@ package model
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/beats/v7/libbeat/common"
 
+	"github.com/elastic/apm-server/elasticsearch/estest"
+	"github.com/elastic/apm-server/proguard"
 	"github.com/elastic/apm-server/sourcemap/test"
 	"github.com/elastic/apm-server/transform"
 )
@@ -265,3 +270,106 @@ func TestStacktraceTransformWithSourcemapping(t *testing.T) {
 		})
 	}
 }
+
+func TestStacktraceTransformWithProguardMapping(t *testing.T) {
+	const mapping = `com.example.Repository -> a.a:
+# {"id":"sourceFile","fileName":"Repository.kt"}
+    1:3:java.lang.String load(int):22:24 -> a
+`
+	service := Service{Name: "service1", Version: "1.0"}
+	newStacktrace := func() Stacktrace {
+		classname, otherClassname, function, filename, lineno := "a.a", "java.lang.Thread", "a", "SourceFile", 2
+		return Stacktrace{
+			&StacktraceFrame{Classname: &classname, Function: &function, Filename: &filename, Lineno: &lineno},
+			&StacktraceFrame{Classname: &otherClassname, Function: &function},
+			&StacktraceFrame{Function: &function},
+		}
+	}
+
+	t.Run("applied", func(t *testing.T) {
+		cfg := &transform.Config{ProguardMappingStore: testProguardMappingStore(t, mapping)}
+		st := newStacktrace()
+		// run `Stacktrace.transform` twice to ensure method is idempotent
+		st.transform(context.Background(), cfg, false, &service)
+		output := st.transform(context.Background(), cfg, false, &service)
+		assert.Equal(t, []common.MapStr{
+			{
+				"classname":             "com.example.Repository",
+				"filename":              "Repository.kt",
+				"function":              "load",
+				"line":                  common.MapStr{"number": 23},
+				"exclude_from_grouping": false,
+				"sourcemap":             common.MapStr{"updated": true},
+				"original": common.MapStr{
+					"classname": "a.a",
+					"filename":  "SourceFile",
+					"function":  "a",
+					"lineno":    2,
+				},
+			},
+			{
+				"classname":             "java.lang.Thread",
+				"function":              "a",
+				"exclude_from_grouping": false,
+				"sourcemap":             common.MapStr{"updated": false, "error": "No ProGuard mapping found for Classname java.lang.Thread"},
+			},
+			{
+				"function":              "a",
+				"exclude_from_grouping": false,
+			},
+		}, output)
+	})
+
+	t.Run("no mapping", func(t *testing.T) {
+		cfg := &transform.Config{ProguardMappingStore: testProguardMappingStore(t, "")}
+		st := newStacktrace()
+		output := st.transform(context.Background(), cfg, false, &service)
+		require.Len(t, output, 3)
+		for _, frame := range output {
+			assert.NotContains(t, frame, "sourcemap")
+			assert.NotContains(t, frame, "original")
+		}
+	})
+
+	t.Run("no classnames", func(t *testing.T) {
+		// stack traces of non-JVM agents are left untouched
+		cfg := &transform.Config{ProguardMappingStore: testProguardMappingStore(t, mapping)}
+		function, filename, lineno := "main", "main.go", 2
+		st := Stacktrace{&StacktraceFrame{Function: &function, Filename: &filename, Lineno: &lineno}}
+		output := st.transform(context.Background(), cfg, false, &service)
+		assert.Equal(t, []common.MapStr{{
+			"function":              "main",
+			"filename":              "main.go",
+			"line":                  common.MapStr{"number": 2},
+			"exclude_from_grouping": false,
+		}}, output)
+	})
+
+	t.Run("rum", func(t *testing.T) {
+		cfg := &transform.Config{ProguardMappingStore: testProguardMappingStore(t, mapping)}
+		st := newStacktrace()
+		output := st.transform(context.Background(), cfg, true, &service)
+		require.Len(t, output, 3)
+		assert.Equal(t, "a.a", output[0]["classname"])
+		assert.NotContains(t, output[0], "sourcemap")
+	})
+}
+
+func testProguardMappingStore(t *testing.T, mapping string) *proguard.Store {
+	transport := estest.NewTransport(t, http.StatusNotFound, nil)
+	if mapping != "" {
+		transport = estest.NewTransport(t, http.StatusOK, map[string]interface{}{
+			"hits": map[string]interface{}{
+				"total": map[string]interface{}{"value": 1},
+				"hits": []map[string]interface{}{
+					{"_source": map[string]interface{}{"proguard_mapping": map[string]interface{}{"mapping": mapping}}},
+				},
+			},
+		})
+	}
+	client, err := estest.NewElasticsearchClient(transport)
+	require.NoError(t, err)
+	store, err := proguard.NewStore(client, "apm-*-proguard_mapping*", time.Minute)
+	require.NoError(t, err)
+	return store
+}
@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package proguard
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/elastic/apm-server/assetstore"
+	"github.com/elastic/apm-server/elasticsearch"
+)
+
+const (
+	emptyResult        = ""
+	errMsgParseMapping = "Could not parse ProGuard mapping."
+)
+
+var (
+	errMappingWrongFormat = errors.New("ProGuard mapping ES Result not in expected format")
+)
+
+type esStore struct {
+	client elasticsearch.Client
+	index  string
+	logger *logp.Logger
+}
+
+func (s *esStore) fetch(ctx context.Context, name, version string) (string, error) {
+	var source struct {
+		ProguardMapping struct {
+			Mapping string
+		} `json:"proguard_mapping"`
+	}
+	hits, err := assetstore.SearchFirst(ctx, s.client, s.index, query(name, version), &source, errMsgParseMapping)
+	if err != nil || hits == 0 {
+		return emptyResult, err
+	}
+	if hits > 1 {
+		s.logger.Warnf("%d ProGuard mappings found for service %s version %s, using the most recent one",
+			hits, name, version)
+	}
+	if source.ProguardMapping.Mapping == emptyResult {
+		return emptyResult, errMappingWrongFormat
+	}
+	return source.ProguardMapping.Mapping, nil
+}
+
+func query(name, version string) map[string]interface{} {
+	return map[string]interface{}{
+		"query": map[string]interface{}{
+			"bool": map[string]interface{}{
+				"must": []map[string]interface{}{
+					term("processor.name", "proguard_mapping"),
+					term("proguard_mapping.service.name", name),
+					term("proguard_mapping.service.version", version),
+				},
+			},
+		},
+		"size":    1,
+		"sort":    []map[string]interface{}{{"@timestamp": map[string]interface{}{"order": "desc"}}},
+		"_source": "proguard_mapping.mapping",
+	}
+}
+
+func term(k, v string) map[string]interface{} {
+	return map[string]interface{}{"term": map[string]interface{}{k: v}}
+}
@@ -0,0 +1,251 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package proguard
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const maxLineLength = 1024 * 1024
+
+// Mapping holds a parsed ProGuard/R8 mapping file, as produced when
+// obfuscating Java and Android applications.
+type Mapping struct {
+	classes map[string]*classMapping
+}
+
+type classMapping struct {
+	name       string
+	sourceFile string
+	// methods holds the method mappings keyed by obfuscated name,
+	// in the order they appear in the mapping file.
+	methods map[string][]methodMapping
+}
+
+type methodMapping struct {
+	// class is set when the method was inlined from another class.
+	class string
+	name  string
+
+	// start and end hold the obfuscated line range, and are zero if unknown.
+	start, end int
+	// origStart and origEnd hold the original line range, and are zero if unknown.
+	origStart, origEnd int
+}
+
+// Frame holds the deobfuscated information of a stack trace frame.
+type Frame struct {
+	Classname string
+	Function  string
+	Lineno    int
+	// Filename is empty if the mapping holds no source file information.
+	Filename string
+}
+
+// Parse parses a ProGuard/R8 mapping file.
+//
+// Field mappings and unrecognised lines are ignored.
+func Parse(r io.Reader) (*Mapping, error) {
+	m := &Mapping{classes: make(map[string]*classMapping)}
+	var class *classMapping
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, maxLineLength)
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+		if trimmed == "" {
+			continue
+		}
+		if strings.HasPrefix(trimmed, "#") {
+			if class != nil {
+				parseClassComment(class, trimmed)
+			}
+			continue
+		}
+		if line[0] != ' ' && line[0] != '\t' {
+			class = parseClassLine(trimmed)
+			if class != nil {
+				obfuscated := class.name
+				class.name = strings.TrimSpace(strings.SplitN(trimmed, "->", 2)[0])
+				m.classes[obfuscated] = class
+			}
+			continue
+		}
+		if class == nil {
+			continue
+		}
+		if obfuscated, method, ok := parseMethodLine(trimmed); ok {
+			class.methods[obfuscated] = append(class.methods[obfuscated], method)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// Len returns the number of class mappings.
+func (m *Mapping) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.classes)
+}
+
+// Deobfuscate returns the original class name, function name and line number for the given
+// obfuscated class name, function name and line number. The line number is ignored if zero.
+//
+// Deobfuscate returns false if the mapping holds no information about the class.
+// If the function is unknown, only the class name is deobfuscated.
+//
+// When code has been inlined, the innermost original location is returned.
+func (m *Mapping) Deobfuscate(classname, function string, lineno int) (Frame, bool) {
+	if m == nil {
+		return Frame{}, false
+	}
+	class, ok := m.classes[classname]
+	if !ok {
+		return Frame{}, false
+	}
+	frame := Frame{Classname: class.name, Function: function, Lineno: lineno, Filename: class.sourceFile}
+
+	method, ok := class.findMethod(function, lineno)
+	if !ok {
+		return frame, true
+	}
+	frame.Function = method.name
+	if method.class != "" && method.class != class.name {
+		frame.Classname = method.class
+		frame.Filename = ""
+	}
+	if lineno > 0 {
+		frame.Lineno = method.originalLine(lineno)
+	}
+	return frame, true
+}
+
+func (c *classMapping) findMethod(function string, lineno int) (methodMapping, bool) {
+	candidates := c.methods[function]
+	if len(candidates) == 0 {
+		return methodMapping{}, false
+	}
+	if lineno > 0 {
+		for _, method := range candidates {
+			if method.start > 0 && method.start <= lineno && lineno <= method.end {
+				return method, true
+			}
+		}
+		for _, method := range candidates {
+			if method.start == 0 {
+				return method, true
+			}
+		}
+	}
+	return candidates[0], true
+}
+
+func (m methodMapping) originalLine(lineno int) int {
+	switch {
+	case m.origStart == 0:
+		// Without an original range, the obfuscated range is the original range.
+		return lineno
+	case m.origEnd == 0 || m.start == 0:
+		return m.origStart
+	}
+	return m.origStart + lineno - m.start
+}
+
+// parseClassLine parses a line of the form "original.Name -> obfuscated.Name:".
+// The returned classMapping's name holds the obfuscated name.
+func parseClassLine(line string) *classMapping {
+	if !strings.HasSuffix(line, ":") {
+		return nil
+	}
+	parts := strings.SplitN(strings.TrimSuffix(line, ":"), "->", 2)
+	if len(parts) != 2 {
+		return nil
+	}
+	obfuscated := strings.TrimSpace(parts[1])
+	if obfuscated == "" || strings.TrimSpace(parts[0]) == "" {
+		return nil
+	}
+	return &classMapping{name: obfuscated, methods: make(map[string][]methodMapping)}
+}
+
+// parseClassComment records the source file of a class from
+// R8 metadata of the form `# {"id":"sourceFile","fileName":"Foo.kt"}`.
+func parseClassComment(class *classMapping, line string) {
+	var metadata struct {
+		ID       string `json:"id"`
+		FileName string `json:"fileName"`
+	}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "#"))), &metadata); err != nil {
+		return
+	}
+	if metadata.ID == "sourceFile" {
+		class.sourceFile = metadata.FileName
+	}
+}
+
+// parseMethodLine parses a line of the form
+// "[start:end:]returnType [class.]name(args)[:origStart[:origEnd]] -> obfuscated".
+func parseMethodLine(line string) (string, methodMapping, bool) {
+	parts := strings.SplitN(line, "->", 2)
+	if len(parts) != 2 {
+		return "", methodMapping{}, false
+	}
+	obfuscated := strings.TrimSpace(parts[1])
+	original := strings.TrimSpace(parts[0])
+
+	open := strings.IndexByte(original, '(')
+	close := strings.LastIndexByte(original, ')')
+	if open < 0 || close < open || obfuscated == "" {
+		// field mapping
+		return "", methodMapping{}, false
+	}
+
+	var method methodMapping
+	signature := original[:open]
+	if fields := strings.SplitN(signature, ":", 3); len(fields) == 3 {
+		method.start, _ = strconv.Atoi(fields[0])
+		method.end, _ = strconv.Atoi(fields[1])
+		signature = fields[2]
+	}
+	if sep := strings.LastIndexByte(signature, ' '); sep >= 0 {
+		signature = signature[sep+1:]
+	}
+	if sep := strings.LastIndexByte(signature, '.'); sep >= 0 {
+		method.class = signature[:sep]
+		signature = signature[sep+1:]
+	}
+	method.name = signature
+
+	if suffix := original[close+1:]; strings.HasPrefix(suffix, ":") {
+		fields := strings.SplitN(suffix[1:], ":", 2)
+		method.origStart, _ = strconv.Atoi(fields[0])
+		if len(fields) == 2 {
+			method.origEnd, _ = strconv.Atoi(fields[1])
+		}
+	}
+	return obfuscated, method, true
+}
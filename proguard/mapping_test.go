@@ -0,0 +1,101 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package proguard
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	m := testMapping(t)
+	assert.Equal(t, 3, m.Len())
+
+	m, err := Parse(strings.NewReader("not a mapping file"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestDeobfuscate(t *testing.T) {
+	m := testMapping(t)
+
+	for name, tc := range map[string]struct {
+		classname, function string
+		lineno              int
+		expected            Frame
+		ok                  bool
+	}{
+		"unknown class": {
+			classname: "x.y", function: "a", lineno: 1,
+		},
+		"unobfuscated class": {
+			classname: "com.example.app.MainActivity", function: "onCreate", lineno: 2,
+			expected: Frame{Classname: "com.example.app.MainActivity", Function: "onCreate", Lineno: 16, Filename: "MainActivity.kt"},
+			ok:       true,
+		},
+		"line range": {
+			classname: "a.a", function: "a", lineno: 3,
+			expected: Frame{Classname: "com.example.app.Repository", Function: "load", Lineno: 24, Filename: "Repository.kt"},
+			ok:       true,
+		},
+		"inlined": {
+			classname: "a.a", function: "a", lineno: 4,
+			expected: Frame{Classname: "com.example.app.Cache", Function: "put", Lineno: 40},
+			ok:       true,
+		},
+		"overloaded obfuscated name": {
+			classname: "a.a", function: "a", lineno: 6,
+			expected: Frame{Classname: "com.example.app.Repository", Function: "save", Lineno: 32, Filename: "Repository.kt"},
+			ok:       true,
+		},
+		"without line information": {
+			classname: "a.a", function: "b", lineno: 12,
+			expected: Frame{Classname: "com.example.app.Repository", Function: "clear", Lineno: 12, Filename: "Repository.kt"},
+			ok:       true,
+		},
+		"without lineno": {
+			classname: "a.b", function: "a",
+			expected: Frame{Classname: "com.example.app.Cache", Function: "get"},
+			ok:       true,
+		},
+		"unknown function": {
+			classname: "a.b", function: "z", lineno: 3,
+			expected: Frame{Classname: "com.example.app.Cache", Function: "z", Lineno: 3},
+			ok:       true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			frame, ok := m.Deobfuscate(tc.classname, tc.function, tc.lineno)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expected, frame)
+		})
+	}
+}
+
+func testMapping(t *testing.T) *Mapping {
+	f, err := os.Open("testdata/mapping.txt")
+	require.NoError(t, err)
+	defer f.Close()
+	m, err := Parse(f)
+	require.NoError(t, err)
+	return m
+}
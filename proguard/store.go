@@ -0,0 +1,80 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package proguard
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/elastic/apm-server/assetstore"
+	"github.com/elastic/apm-server/elasticsearch"
+	logs "github.com/elastic/apm-server/log"
+)
+
+// Store holds information necessary to fetch a ProGuard mapping, either from
+// Elasticsearch or an internal cache.
+type Store struct {
+	cache   *assetstore.Cache
+	esStore *esStore
+	logger  *logp.Logger
+}
+
+// NewStore creates a new instance for fetching ProGuard mappings. The client and index parameters are needed to be able to
+// fetch mappings from Elasticsearch. The expiration time is used for the internal cache.
+func NewStore(client elasticsearch.Client, index string, expiration time.Duration) (*Store, error) {
+	logger := logp.NewLogger(logs.Proguard)
+	cache, err := assetstore.NewCache(expiration, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &Store{
+		cache:   cache,
+		esStore: &esStore{client: client, index: index, logger: logger},
+		logger:  logger,
+	}, nil
+}
+
+// Fetch a ProGuard mapping from the store.
+func (s *Store) Fetch(ctx context.Context, name string, version string) (*Mapping, error) {
+	val, err := s.cache.Fetch(assetstore.Key(name, version), func() (interface{}, error) {
+		mappingStr, err := s.esStore.fetch(ctx, name, version)
+		if err != nil || mappingStr == emptyResult {
+			return nil, err
+		}
+		mapping, err := Parse(strings.NewReader(mappingStr))
+		if err != nil {
+			return nil, errors.Wrap(err, errMsgParseMapping)
+		}
+		return mapping, nil
+	})
+	mapping, _ := val.(*Mapping)
+	return mapping, err
+}
+
+// Added ensures the internal cache is cleared for the given parameters. This should be called when a mapping is uploaded.
+func (s *Store) Added(ctx context.Context, name string, version string) {
+	if mapping, err := s.Fetch(ctx, name, version); err == nil && mapping != nil {
+		s.logger.Warnf("Overriding ProGuard mapping for service %s version %s", name, version)
+	}
+	s.cache.Remove(assetstore.Key(name, version))
+}
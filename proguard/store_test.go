@@ -0,0 +1,121 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package proguard
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/assetstore"
+	"github.com/elastic/apm-server/elasticsearch/estest"
+)
+
+func TestNewStore(t *testing.T) {
+	_, err := NewStore(nil, "", -1)
+	require.Error(t, err)
+
+	store, err := NewStore(nil, "", 100)
+	require.NoError(t, err)
+	assert.NotNil(t, store.cache)
+}
+
+func TestStore_Fetch(t *testing.T) {
+	for name, tc := range map[string]struct {
+		statusCode int
+		esBody     map[string]interface{}
+		mapping    bool
+		cached     bool
+		errMsg     string
+	}{
+		"found": {
+			statusCode: http.StatusOK,
+			esBody:     mappingResponse("com.example.Foo -> a:\n    void bar() -> a\n"),
+			mapping:    true,
+			cached:     true,
+		},
+		"not found": {
+			statusCode: http.StatusOK,
+			esBody:     map[string]interface{}{"hits": map[string]interface{}{"total": map[string]interface{}{"value": 0}}},
+			cached:     true,
+		},
+		"index missing": {
+			statusCode: http.StatusNotFound,
+			cached:     true,
+		},
+		"wrong format": {
+			statusCode: http.StatusOK,
+			esBody:     mappingResponse(""),
+			cached:     true,
+			errMsg:     errMappingWrongFormat.Error(),
+		},
+		"es failure": {
+			statusCode: -1,
+			errMsg:     assetstore.ErrMsgESFailure,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			client, err := estest.NewElasticsearchClient(estest.NewTransport(t, tc.statusCode, tc.esBody))
+			require.NoError(t, err)
+			store, err := NewStore(client, "apm-*-proguard_mapping*", time.Minute)
+			require.NoError(t, err)
+
+			mapping, err := store.Fetch(context.Background(), "service", "1.0")
+			if tc.errMsg != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tc.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tc.mapping, mapping != nil)
+
+			_, cached := store.cache.Get(assetstore.Key("service", "1.0"))
+			assert.Equal(t, tc.cached, cached)
+		})
+	}
+}
+
+func TestStore_Added(t *testing.T) {
+	client, err := estest.NewElasticsearchClient(estest.NewTransport(t, http.StatusOK,
+		mappingResponse("com.example.Foo -> a:\n")))
+	require.NoError(t, err)
+	store, err := NewStore(client, "apm-*-proguard_mapping*", time.Minute)
+	require.NoError(t, err)
+
+	_, err = store.Fetch(context.Background(), "service", "1.0")
+	require.NoError(t, err)
+	assert.Equal(t, 1, store.cache.ItemCount())
+
+	store.Added(context.Background(), "service", "1.0")
+	assert.Equal(t, 0, store.cache.ItemCount())
+}
+
+func mappingResponse(mapping string) map[string]interface{} {
+	return map[string]interface{}{
+		"hits": map[string]interface{}{
+			"total": map[string]interface{}{"value": 1},
+			"hits": []map[string]interface{}{
+				{"_source": map[string]interface{}{"proguard_mapping": map[string]interface{}{"mapping": mapping}}},
+			},
+		},
+	}
+}
@@ -26,10 +26,13 @@ import (
 	"io/ioutil"
 	"net/http"
 
+	"github.com/elastic/go-elasticsearch/v7/esapi"
+	"github.com/elastic/go-elasticsearch/v7/esutil"
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/logp"
 
+	"github.com/elastic/apm-server/assetstore"
 	"github.com/elastic/apm-server/elasticsearch"
 	"github.com/elastic/apm-server/utility"
 )
@@ -37,10 +40,13 @@ import (
 const (
 	emptyResult          = ""
 	errMsgParseSourcemap = "Could not parse Sourcemap."
+
+	// maxListSize corresponds to the default index.max_result_window setting of Elasticsearch.
+	maxListSize = 10000
 )
 
 var (
-	errMsgESFailure         = "failure querying ES"
+	errMsgESFailure         = assetstore.ErrMsgESFailure
 	errSourcemapWrongFormat = errors.New("Sourcemapping ES Result not in expected format")
 )
 
@@ -50,74 +56,117 @@ type esStore struct {
 	logger *logp.Logger
 }
 
-type esSourcemapResponse struct {
+type esSourcemapListResponse struct {
 	Hits struct {
-		Total struct {
-			Value int
-		}
 		Hits []struct {
 			Source struct {
 				Sourcemap struct {
-					Sourcemap string
+					BundleFilepath string `json:"bundle_filepath"`
+					Service        struct {
+						Name    string
+						Version string
+					}
 				}
 			} `json:"_source"`
 		}
 	} `json:"hits"`
 }
 
+type esDeleteByQueryResponse struct {
+	Deleted int `json:"deleted"`
+}
+
 func (s *esStore) fetch(ctx context.Context, name, version, path string) (string, error) {
-	statusCode, body, err := s.runSearchQuery(ctx, name, version, path)
+	var source struct {
+		Sourcemap struct {
+			Sourcemap string
+		}
+	}
+	hits, err := assetstore.SearchFirst(ctx, s.client, s.index, query(name, version, path), &source, errMsgParseSourcemap)
+	if err != nil || hits == 0 {
+		return emptyResult, err
+	}
+	if hits > 1 {
+		s.logger.Warnf("%d sourcemaps found for service %s version %s and file %s, using the most recent one",
+			hits, name, version, path)
+	}
+	// until https://github.com/golang/go/issues/19858 is resolved
+	if source.Sourcemap.Sourcemap == emptyResult {
+		return emptyResult, errSourcemapWrongFormat
+	}
+	return source.Sourcemap.Sourcemap, nil
+}
+
+func (s *esStore) list(ctx context.Context, name, version string) ([]Metadata, error) {
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(listQuery(name, version)); err != nil {
+		return nil, err
+	}
+	statusCode, body, err := s.client.SearchQuery(ctx, s.index, &buf)
 	if err != nil {
-		return "", errors.Wrap(err, errMsgESFailure)
+		return nil, errors.Wrap(err, errMsgESFailure)
 	}
 	defer body.Close()
-	// handle error response
 	if statusCode >= http.StatusMultipleChoices {
 		if statusCode == http.StatusNotFound {
-			return "", nil
-		}
-		b, err := ioutil.ReadAll(body)
-		if err != nil {
-			return "", errors.Wrap(err, errMsgParseSourcemap)
+			return nil, nil
 		}
-		return "", errors.New(fmt.Sprintf("%s %s", errMsgParseSourcemap, b))
+		return nil, readErrorResponse(statusCode, body)
 	}
 
-	// parse response
-	return parse(body, name, version, path, s.logger)
-}
-
-func (s *esStore) runSearchQuery(ctx context.Context, name, version, path string) (int, io.ReadCloser, error) {
-	// build and encode the query
-	var buf bytes.Buffer
-	if err := json.NewEncoder(&buf).Encode(query(name, version, path)); err != nil {
-		return 0, nil, err
+	var resp esSourcemapListResponse
+	if err := json.NewDecoder(body).Decode(&resp); err != nil {
+		return nil, err
+	}
+	// Sourcemaps may have been uploaded several times, only report the most recent one.
+	seen := make(map[string]bool)
+	var result []Metadata
+	for _, hit := range resp.Hits.Hits {
+		smap := hit.Source.Sourcemap
+		if seen[smap.BundleFilepath] {
+			continue
+		}
+		seen[smap.BundleFilepath] = true
+		result = append(result, Metadata{
+			ServiceName:    smap.Service.Name,
+			ServiceVersion: smap.Service.Version,
+			BundleFilepath: smap.BundleFilepath,
+		})
 	}
-	// Perform the runSearchQuery request.
-	return s.client.SearchQuery(ctx, s.index, &buf)
+	return result, nil
 }
 
-func parse(body io.ReadCloser, name, version, path string, logger *logp.Logger) (string, error) {
-	var esSourcemapResponse esSourcemapResponse
-	if err := json.NewDecoder(body).Decode(&esSourcemapResponse); err != nil {
-		return "", err
+func (s *esStore) delete(ctx context.Context, name, version, path string) (int, error) {
+	refresh := true
+	req := esapi.DeleteByQueryRequest{
+		Index:   []string{s.index},
+		Body:    esutil.NewJSONReader(deleteQuery(name, version, path)),
+		Refresh: &refresh,
 	}
-	hits := esSourcemapResponse.Hits.Total.Value
-	if hits == 0 {
-		return emptyResult, nil
+	resp, err := req.Do(ctx, s.client)
+	if err != nil {
+		return 0, errors.Wrap(err, errMsgESFailure)
 	}
-
-	var esSourcemap string
-	if hits > 1 {
-		logger.Warnf("%d sourcemaps found for service %s version %s and file %s, using the most recent one",
-			hits, name, version, path)
+	defer resp.Body.Close()
+	if resp.IsError() {
+		if resp.StatusCode == http.StatusNotFound {
+			return 0, nil
+		}
+		return 0, readErrorResponse(resp.StatusCode, resp.Body)
 	}
-	esSourcemap = esSourcemapResponse.Hits.Hits[0].Source.Sourcemap.Sourcemap
-	// until https://github.com/golang/go/issues/19858 is resolved
-	if esSourcemap == emptyResult {
-		return emptyResult, errSourcemapWrongFormat
+	var result esDeleteByQueryResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+	return result.Deleted, nil
+}
+
+func readErrorResponse(statusCode int, body io.Reader) error {
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return errors.Wrap(err, errMsgESFailure)
 	}
-	return esSourcemap, nil
+	return errors.New(fmt.Sprintf("%s (%d) %s", errMsgESFailure, statusCode, b))
 }
 
 func query(name, version, path string) map[string]interface{} {
@@ -143,6 +192,40 @@ func query(name, version, path string) map[string]interface{} {
 	)
 }
 
+func listQuery(name, version string) map[string]interface{} {
+	return map[string]interface{}{
+		"query": sourcemapsQuery(name, version),
+		"size":  maxListSize,
+		"sort":  []map[string]interface{}{desc("@timestamp")},
+		"_source": []string{
+			"sourcemap.service.name",
+			"sourcemap.service.version",
+			"sourcemap.bundle_filepath",
+		},
+	}
+}
+
+// deleteQuery matches the sourcemaps stored for the given full URL or its
+// URL path, consistent with the sourcemaps which may be fetched for it.
+func deleteQuery(name, version, path string) map[string]interface{} {
+	return map[string]interface{}{
+		"query": sourcemapsQuery(name, version, boolean(should(
+			term("sourcemap.bundle_filepath", path),
+			term("sourcemap.bundle_filepath", utility.UrlPath(path)),
+		))),
+	}
+}
+
+func sourcemapsQuery(name, version string, clauses ...map[string]interface{}) map[string]interface{} {
+	return boolean(
+		must(append([]map[string]interface{}{
+			term("processor.name", "sourcemap"),
+			term("sourcemap.service.name", name),
+			term("sourcemap.service.version", version),
+		}, clauses...)...),
+	)
+}
+
 func wrap(k string, v map[string]interface{}) map[string]interface{} {
 	return map[string]interface{}{k: v}
 }
@@ -100,6 +100,66 @@ func Test_esFetcher_fetch(t *testing.T) {
 	}
 }
 
+func Test_esStore_list(t *testing.T) {
+	hit := func(path string) map[string]interface{} {
+		return map[string]interface{}{"_source": map[string]interface{}{
+			"sourcemap": map[string]interface{}{
+				"bundle_filepath": path,
+				"service":         map[string]interface{}{"name": "abc", "version": "1.0"},
+			}}}
+	}
+	client, err := estest.NewElasticsearchClient(estest.NewTransport(t, http.StatusOK, map[string]interface{}{
+		"hits": map[string]interface{}{
+			"hits": []map[string]interface{}{hit("/a.js"), hit("/b.js"), hit("/a.js")},
+		},
+	}))
+	require.NoError(t, err)
+	result, err := testESStore(client).list(context.Background(), "abc", "1.0")
+	require.NoError(t, err)
+	assert.Equal(t, []Metadata{
+		{ServiceName: "abc", ServiceVersion: "1.0", BundleFilepath: "/a.js"},
+		{ServiceName: "abc", ServiceVersion: "1.0", BundleFilepath: "/b.js"},
+	}, result)
+
+	client, err = estest.NewElasticsearchClient(estest.NewTransport(t, -1, nil))
+	require.NoError(t, err)
+	_, err = testESStore(client).list(context.Background(), "abc", "1.0")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), errMsgESFailure)
+}
+
+func Test_esStore_delete(t *testing.T) {
+	client, err := estest.NewElasticsearchClient(estest.NewTransport(t, http.StatusOK, map[string]interface{}{
+		"deleted": 2,
+	}))
+	require.NoError(t, err)
+	deleted, err := testESStore(client).delete(context.Background(), "abc", "1.0", "/tmp")
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+
+	client, err = estest.NewElasticsearchClient(estest.NewTransport(t, http.StatusNotFound, nil))
+	require.NoError(t, err)
+	deleted, err = testESStore(client).delete(context.Background(), "abc", "1.0", "/tmp")
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+
+	client, err = estest.NewElasticsearchClient(estest.NewTransport(t, http.StatusBadRequest, nil))
+	require.NoError(t, err)
+	_, err = testESStore(client).delete(context.Background(), "abc", "1.0", "/tmp")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), errMsgESFailure)
+}
+
+func Test_deleteQuery(t *testing.T) {
+	// Sourcemaps are removed by URL path, as with fsStore,
+	// also matching any stored with the full URL.
+	q := deleteQuery("abc", "1.0", "http://localhost:8000/js/bundle.js")
+	assert.Equal(t, sourcemapsQuery("abc", "1.0", boolean(should(
+		term("sourcemap.bundle_filepath", "http://localhost:8000/js/bundle.js"),
+		term("sourcemap.bundle_filepath", "/js/bundle.js"),
+	))), q["query"])
+}
+
 func testESStore(client elasticsearch.Client) *esStore {
 	return &esStore{client: client, index: "apm-sourcemap", logger: logp.NewLogger(logs.Sourcemap)}
 }
@@ -0,0 +1,136 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sourcemap
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/elastic/apm-server/assetstore"
+	"github.com/elastic/apm-server/utility"
+)
+
+const sourcemapFileExtension = ".map"
+
+var errMsgFSFailure = "failure reading sourcemap file"
+
+// fsStore fetches sourcemaps from a local directory tree, laid out as
+// <dir>/<service name>/<service version>/<bundle filepath>.map.
+type fsStore struct {
+	dir    string
+	logger *logp.Logger
+}
+
+func (s *fsStore) fetch(ctx context.Context, name, version, bundleFilepath string) (string, error) {
+	filename, ok := s.filename(name, version, bundleFilepath)
+	if !ok {
+		s.logger.Debugf("Invalid sourcemap location for service %s version %s and file %s",
+			name, version, bundleFilepath)
+		return emptyResult, nil
+	}
+	content, err := ioutil.ReadFile(filename)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return emptyResult, nil
+		}
+		return "", assetstore.Temporary(errors.Wrap(err, errMsgFSFailure))
+	}
+	if len(content) == 0 {
+		return "", errSourcemapWrongFormat
+	}
+	return string(content), nil
+}
+
+func (s *fsStore) list(ctx context.Context, name, version string) ([]Metadata, error) {
+	dir, ok := s.serviceDir(name, version)
+	if !ok {
+		return nil, nil
+	}
+	var result []Metadata
+	err := filepath.Walk(dir, func(filename string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(filename, sourcemapFileExtension) {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, filename)
+		if err != nil {
+			return err
+		}
+		result = append(result, Metadata{
+			ServiceName:    name,
+			ServiceVersion: version,
+			BundleFilepath: "/" + strings.TrimSuffix(filepath.ToSlash(rel), sourcemapFileExtension),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, errMsgFSFailure)
+	}
+	return result, nil
+}
+
+func (s *fsStore) delete(ctx context.Context, name, version, bundleFilepath string) (int, error) {
+	filename, ok := s.filename(name, version, bundleFilepath)
+	if !ok {
+		return 0, nil
+	}
+	if err := os.Remove(filename); err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+		return 0, errors.Wrap(err, errMsgFSFailure)
+	}
+	return 1, nil
+}
+
+// filename returns the location of the sourcemap for the given parameters,
+// ensuring the result cannot escape the store's directory.
+func (s *fsStore) filename(name, version, bundleFilepath string) (string, bool) {
+	dir, ok := s.serviceDir(name, version)
+	if !ok {
+		return "", false
+	}
+	urlPath := path.Clean("/" + utility.UrlPath(bundleFilepath))
+	if urlPath == "/" {
+		return "", false
+	}
+	return filepath.Join(dir, filepath.FromSlash(urlPath)+sourcemapFileExtension), true
+}
+
+// serviceDir returns the directory holding the sourcemaps for the given service name and version.
+func (s *fsStore) serviceDir(name, version string) (string, bool) {
+	for _, elem := range []string{name, version} {
+		if elem == "" || elem == "." || elem == ".." || strings.ContainsAny(elem, `/\`) {
+			return "", false
+		}
+	}
+	return filepath.Join(s.dir, name, version), true
+}
@@ -0,0 +1,155 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sourcemap
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	"github.com/elastic/apm-server/assetstore"
+	logs "github.com/elastic/apm-server/log"
+)
+
+func Test_fsStore_fetch(t *testing.T) {
+	dir := testSourcemapDir(t)
+	store := &fsStore{dir: dir, logger: logp.NewLogger(logs.Sourcemap)}
+
+	for name, tc := range map[string]struct {
+		name, version, path string
+		found               bool
+	}{
+		"url":            {name: "foo", version: "1.0.1", path: "http://localhost:8000/js/bundle.js", found: true},
+		"urlPath":        {name: "foo", version: "1.0.1", path: "/js/bundle.js", found: true},
+		"unknownVersion": {name: "foo", version: "1.0.2", path: "/js/bundle.js"},
+		"unknownPath":    {name: "foo", version: "1.0.1", path: "/js/other.js"},
+		"pathTraversal":  {name: "foo", version: "other", path: "/../1.0.1/js/bundle.js"},
+		"nameTraversal":  {name: "..", version: "foo", path: "/1.0.1/js/bundle.js"},
+		"nameSeparator":  {name: "foo/1.0.1", version: "js", path: "/bundle.js"},
+		"emptyPath":      {name: "foo", version: "1.0.1", path: ""},
+	} {
+		t.Run(name, func(t *testing.T) {
+			sourcemapStr, err := store.fetch(context.Background(), tc.name, tc.version, tc.path)
+			require.NoError(t, err)
+			if tc.found {
+				assert.Contains(t, sourcemapStr, `"file": "bundle.js"`)
+			} else {
+				assert.Equal(t, emptyResult, sourcemapStr)
+			}
+		})
+	}
+}
+
+func Test_fsStore_fetchError(t *testing.T) {
+	dir := testSourcemapDir(t)
+	store := &fsStore{dir: dir, logger: logp.NewLogger(logs.Sourcemap)}
+
+	t.Run("empty", func(t *testing.T) {
+		writeSourcemapFile(t, filepath.Join(dir, "foo", "1.0.1", "js", "empty.js.map"), nil)
+		_, err := store.fetch(context.Background(), "foo", "1.0.1", "/js/empty.js")
+		assert.Equal(t, errSourcemapWrongFormat, err)
+	})
+
+	t.Run("directory", func(t *testing.T) {
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "foo", "1.0.1", "js", "dir.js.map"), 0755))
+		_, err := store.fetch(context.Background(), "foo", "1.0.1", "/js/dir.js")
+		require.Error(t, err)
+		assert.True(t, assetstore.IsTemporary(err))
+	})
+}
+
+func Test_fsStore_listDelete(t *testing.T) {
+	dir := testSourcemapDir(t)
+	store := &fsStore{dir: dir, logger: logp.NewLogger(logs.Sourcemap)}
+	writeSourcemapFile(t, filepath.Join(dir, "foo", "1.0.1", "other.js.map"), []byte("{}"))
+	writeSourcemapFile(t, filepath.Join(dir, "foo", "1.0.1", "README"), []byte("ignored"))
+
+	result, err := store.list(context.Background(), "foo", "1.0.1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []Metadata{
+		{ServiceName: "foo", ServiceVersion: "1.0.1", BundleFilepath: "/js/bundle.js"},
+		{ServiceName: "foo", ServiceVersion: "1.0.1", BundleFilepath: "/other.js"},
+	}, result)
+
+	result, err = store.list(context.Background(), "foo", "1.0.2")
+	require.NoError(t, err)
+	assert.Empty(t, result)
+
+	deleted, err := store.delete(context.Background(), "foo", "1.0.1", "http://localhost:8000/js/bundle.js")
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	deleted, err = store.delete(context.Background(), "foo", "1.0.1", "/js/bundle.js")
+	require.NoError(t, err)
+	assert.Equal(t, 0, deleted)
+
+	result, err = store.list(context.Background(), "foo", "1.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, []Metadata{{ServiceName: "foo", ServiceVersion: "1.0.1", BundleFilepath: "/other.js"}}, result)
+}
+
+func TestFileStore_Fetch(t *testing.T) {
+	_, err := NewFileStore("", -1)
+	require.Error(t, err)
+
+	store, err := NewFileStore(testSourcemapDir(t), time.Minute)
+	require.NoError(t, err)
+
+	mapper, err := store.Fetch(context.Background(), "foo", "1.0.1", "http://localhost:8000/js/bundle.js")
+	require.NoError(t, err)
+	require.NotNil(t, mapper)
+	assert.Equal(t, "bundle.js", mapper.File())
+
+	// ensure sourcemap is added to cache
+	cached, found := store.cache.Get("foo_1.0.1_http://localhost:8000/js/bundle.js")
+	require.True(t, found)
+	assert.Equal(t, mapper, cached)
+
+	// ensure missing sourcemaps are cached as nil
+	mapper, err = store.Fetch(context.Background(), "foo", "1.0.1", "/js/other.js")
+	require.NoError(t, err)
+	assert.Nil(t, mapper)
+	cached, found = store.cache.Get("foo_1.0.1_/js/other.js")
+	require.True(t, found)
+	assert.Nil(t, cached)
+}
+
+// testSourcemapDir returns a temporary directory holding
+// a valid sourcemap for service foo, version 1.0.1 and file /js/bundle.js.
+func testSourcemapDir(t *testing.T) string {
+	content, err := ioutil.ReadFile("../testdata/sourcemap/bundle.js.map")
+	require.NoError(t, err)
+
+	dir, err := ioutil.TempDir("", "apm-server-sourcemaps")
+	require.NoError(t, err)
+	t.Cleanup(func() { os.RemoveAll(dir) })
+	writeSourcemapFile(t, filepath.Join(dir, "foo", "1.0.1", "js", "bundle.js.map"), content)
+	return dir
+}
+
+func writeSourcemapFile(t *testing.T, filename string, content []byte) {
+	require.NoError(t, os.MkdirAll(filepath.Dir(filename), 0755))
+	require.NoError(t, ioutil.WriteFile(filename, content, 0644))
+}
@@ -0,0 +1,254 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sourcemap
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/go-sourcemap/sourcemap"
+	"github.com/pkg/errors"
+)
+
+const vlqBase64 = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+
+var (
+	errIndexMapURL       = errors.New("index map sections referencing a url are not supported")
+	errIndexMapNested    = errors.New("nested index map sections are not supported")
+	errInvalidVLQ        = errors.New("invalid VLQ in mappings")
+	errInvalidVLQSegment = errors.New("invalid segment in mappings")
+)
+
+type indexMap struct {
+	Version  int    `json:"version"`
+	File     string `json:"file,omitempty"`
+	Sections []struct {
+		Offset struct {
+			Line   int `json:"line"`
+			Column int `json:"column"`
+		} `json:"offset"`
+		URL string          `json:"url"`
+		Map json.RawMessage `json:"map"`
+	} `json:"sections"`
+}
+
+type regularMap struct {
+	Version        int               `json:"version"`
+	File           string            `json:"file,omitempty"`
+	SourceRoot     string            `json:"sourceRoot,omitempty"`
+	Sources        []string          `json:"sources"`
+	SourcesContent []*string         `json:"sourcesContent,omitempty"`
+	Names          []json.RawMessage `json:"names"`
+	Mappings       string            `json:"mappings"`
+	Sections       json.RawMessage   `json:"sections,omitempty"`
+}
+
+// mappingSegment holds the absolute values of a decoded mapping segment: generated column,
+// and optionally source index, source line, source column and name index.
+type mappingSegment []int
+
+// parseSourcemap parses a regular or an index source map.
+//
+// Index maps are flattened into a regular source map first, as the underlying
+// consumer does not take column offsets of sections into account.
+func parseSourcemap(b []byte) (*sourcemap.Consumer, error) {
+	var probe struct {
+		Sections json.RawMessage `json:"sections"`
+	}
+	if err := json.Unmarshal(b, &probe); err != nil {
+		return nil, err
+	}
+	if len(probe.Sections) > 0 && string(probe.Sections) != "null" {
+		flattened, err := flattenIndexMap(b)
+		if err != nil {
+			return nil, err
+		}
+		b = flattened
+	}
+	return sourcemap.Parse("", b)
+}
+
+// flattenIndexMap converts an index map into an equivalent regular source map.
+func flattenIndexMap(b []byte) ([]byte, error) {
+	var m indexMap
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, err
+	}
+	out := regularMap{Version: m.Version, File: m.File, Sources: []string{}, Names: []json.RawMessage{}}
+	var lines [][]mappingSegment
+	var hasContent bool
+	for _, section := range m.Sections {
+		if section.URL != "" {
+			return nil, errIndexMapURL
+		}
+		var sm regularMap
+		if err := json.Unmarshal(section.Map, &sm); err != nil {
+			return nil, err
+		}
+		if len(sm.Sections) > 0 && string(sm.Sections) != "null" {
+			return nil, errIndexMapNested
+		}
+		sectionLines, err := decodeMappings(sm.Mappings)
+		if err != nil {
+			return nil, err
+		}
+
+		sourcesOffset, namesOffset := len(out.Sources), len(out.Names)
+		for i, source := range sm.Sources {
+			out.Sources = append(out.Sources, resolveSource(sm.SourceRoot, source))
+			var content *string
+			if i < len(sm.SourcesContent) {
+				content = sm.SourcesContent[i]
+			}
+			hasContent = hasContent || content != nil
+			out.SourcesContent = append(out.SourcesContent, content)
+		}
+		out.Names = append(out.Names, sm.Names...)
+
+		for i, segments := range sectionLines {
+			line := section.Offset.Line + i
+			for len(lines) <= line {
+				lines = append(lines, nil)
+			}
+			for _, segment := range segments {
+				shifted := make(mappingSegment, len(segment))
+				copy(shifted, segment)
+				if i == 0 {
+					shifted[0] += section.Offset.Column
+				}
+				if len(shifted) >= 4 {
+					shifted[1] += sourcesOffset
+				}
+				if len(shifted) == 5 {
+					shifted[4] += namesOffset
+				}
+				lines[line] = append(lines[line], shifted)
+			}
+		}
+	}
+	if !hasContent {
+		out.SourcesContent = nil
+	}
+	out.Mappings = encodeMappings(lines)
+	return json.Marshal(out)
+}
+
+func resolveSource(sourceRoot, source string) string {
+	if sourceRoot == "" || strings.HasPrefix(source, "/") || strings.Contains(source, "://") {
+		return source
+	}
+	return strings.TrimSuffix(sourceRoot, "/") + "/" + source
+}
+
+// decodeMappings decodes the VLQ encoded mappings into segments with absolute values, per generated line.
+func decodeMappings(mappings string) ([][]mappingSegment, error) {
+	var lines [][]mappingSegment
+	var state [5]int
+	for _, line := range strings.Split(mappings, ";") {
+		state[0] = 0 // generated columns are relative to the start of each line
+		var segments []mappingSegment
+		for _, field := range strings.Split(line, ",") {
+			if field == "" {
+				continue
+			}
+			values, err := decodeVLQ(field)
+			if err != nil {
+				return nil, err
+			}
+			if n := len(values); n != 1 && n != 4 && n != 5 {
+				return nil, errInvalidVLQSegment
+			}
+			segment := make(mappingSegment, len(values))
+			for i, v := range values {
+				state[i] += v
+				segment[i] = state[i]
+			}
+			segments = append(segments, segment)
+		}
+		lines = append(lines, segments)
+	}
+	return lines, nil
+}
+
+// encodeMappings encodes segments with absolute values into VLQ encoded mappings.
+func encodeMappings(lines [][]mappingSegment) string {
+	var b []byte
+	var state [5]int
+	for i, segments := range lines {
+		if i > 0 {
+			b = append(b, ';')
+		}
+		state[0] = 0
+		for j, segment := range segments {
+			if j > 0 {
+				b = append(b, ',')
+			}
+			for k, v := range segment {
+				b = appendVLQ(b, v-state[k])
+				state[k] = v
+			}
+		}
+	}
+	return string(b)
+}
+
+func decodeVLQ(s string) ([]int, error) {
+	var values []int
+	var value, shift int
+	for i := 0; i < len(s); i++ {
+		digit := strings.IndexByte(vlqBase64, s[i])
+		if digit < 0 {
+			return nil, errInvalidVLQ
+		}
+		value += (digit & 31) << shift
+		if digit&32 != 0 {
+			shift += 5
+			continue
+		}
+		if value&1 != 0 {
+			values = append(values, -(value >> 1))
+		} else {
+			values = append(values, value>>1)
+		}
+		value, shift = 0, 0
+	}
+	if shift != 0 {
+		return nil, errInvalidVLQ
+	}
+	return values, nil
+}
+
+func appendVLQ(b []byte, v int) []byte {
+	var vlq int
+	if v < 0 {
+		vlq = (-v << 1) | 1
+	} else {
+		vlq = v << 1
+	}
+	for {
+		digit := vlq & 31
+		vlq >>= 5
+		if vlq > 0 {
+			digit |= 32
+		}
+		b = append(b, vlqBase64[digit])
+		if vlq == 0 {
+			return b
+		}
+	}
+}
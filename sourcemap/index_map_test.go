@@ -0,0 +1,122 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sourcemap
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/sourcemap/test"
+)
+
+func TestVLQRoundtrip(t *testing.T) {
+	for _, v := range []int{0, 1, -1, 15, -16, 16, 1000, -1000, 123456789} {
+		values, err := decodeVLQ(string(appendVLQ(nil, v)))
+		require.NoError(t, err)
+		assert.Equal(t, []int{v}, values)
+	}
+	_, err := decodeVLQ("g") // continuation bit without following digit
+	assert.Equal(t, errInvalidVLQ, err)
+	_, err = decodeVLQ("!")
+	assert.Equal(t, errInvalidVLQ, err)
+}
+
+func TestMappingsRoundtrip(t *testing.T) {
+	var m regularMap
+	require.NoError(t, json.Unmarshal([]byte(test.ValidSourcemap), &m))
+	lines, err := decodeMappings(m.Mappings)
+	require.NoError(t, err)
+	assert.Equal(t, m.Mappings, encodeMappings(lines))
+}
+
+func TestParseSourcemapIndexMap(t *testing.T) {
+	regular, err := parseSourcemap([]byte(test.ValidSourcemap))
+	require.NoError(t, err)
+
+	// The same sourcemap is used for three sections: at the start of the generated file,
+	// further along the first line, and at the start of the fifth line.
+	indexMap := fmt.Sprintf(`{
+		"version": 3,
+		"file": "bundle.min.js",
+		"sections": [
+			{"offset": {"line": 0, "column": 0}, "map": %[1]s},
+			{"offset": {"line": 0, "column": 1000}, "map": %[1]s},
+			{"offset": {"line": 4, "column": 0}, "map": %[1]s}
+		]
+	}`, test.ValidSourcemap)
+	consumer, err := parseSourcemap([]byte(indexMap))
+	require.NoError(t, err)
+	assert.Equal(t, "bundle.min.js", consumer.File())
+
+	for _, tc := range []struct {
+		line, col     int
+		sectionLine   int
+		sectionColumn int
+	}{
+		{line: 1, col: 7},
+		{line: 1, col: 67},
+		{line: 1, col: 1007, sectionColumn: 1000},
+		{line: 1, col: 1067, sectionColumn: 1000},
+		{line: 5, col: 23, sectionLine: 4},
+	} {
+		t.Run(fmt.Sprintf("%d:%d", tc.line, tc.col), func(t *testing.T) {
+			file, fct, line, col, ctxLine, preCtx, postCtx, ok := Map(consumer, tc.line, tc.col)
+			require.True(t, ok)
+			expectedFile, expectedFct, expectedLine, expectedCol, expectedCtxLine, expectedPreCtx, expectedPostCtx, ok :=
+				Map(regular, tc.line-tc.sectionLine, tc.col-tc.sectionColumn)
+			require.True(t, ok)
+			assert.Equal(t, expectedFile, file)
+			assert.Equal(t, expectedFct, fct)
+			assert.Equal(t, expectedLine, line)
+			assert.Equal(t, expectedCol, col)
+			assert.Equal(t, expectedCtxLine, ctxLine)
+			assert.NotEmpty(t, ctxLine)
+			assert.Equal(t, expectedPreCtx, preCtx)
+			assert.Equal(t, expectedPostCtx, postCtx)
+		})
+	}
+}
+
+func TestParseSourcemapIndexMapInvalid(t *testing.T) {
+	for name, tc := range map[string]struct {
+		sourcemap string
+		err       error
+	}{
+		"url": {
+			sourcemap: `{"version": 3, "sections": [{"offset": {"line": 0, "column": 0}, "url": "http://localhost/a.js.map"}]}`,
+			err:       errIndexMapURL,
+		},
+		"nested": {
+			sourcemap: `{"version": 3, "sections": [{"offset": {"line": 0, "column": 0}, "map": {"version": 3, "sections": []}}]}`,
+			err:       errIndexMapNested,
+		},
+		"mappings": {
+			sourcemap: `{"version": 3, "sections": [{"offset": {"line": 0, "column": 0}, "map": {"version": 3, "sources": ["a.js"], "names": [], "mappings": "AA"}}]}`,
+			err:       errInvalidVLQSegment,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := parseSourcemap([]byte(tc.sourcemap))
+			assert.Equal(t, tc.err, err)
+		})
+	}
+}
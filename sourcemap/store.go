@@ -19,81 +19,82 @@ package sourcemap
 
 import (
 	"context"
-	"math"
-	"strings"
 	"time"
 
-	"github.com/elastic/apm-server/elasticsearch"
-
 	"github.com/go-sourcemap/sourcemap"
-	gocache "github.com/patrickmn/go-cache"
 	"github.com/pkg/errors"
 
 	"github.com/elastic/beats/v7/libbeat/logp"
 
+	"github.com/elastic/apm-server/assetstore"
+	"github.com/elastic/apm-server/elasticsearch"
 	logs "github.com/elastic/apm-server/log"
+	"github.com/elastic/apm-server/utility"
 )
 
-const (
-	minCleanupIntervalSeconds float64 = 60
-)
-
-var (
-	errInit = errors.New("Cache cannot be initialized. Expiration and CleanupInterval need to be >= 0")
-)
-
-// Store holds information necessary to fetch a sourcemap, either from an Elasticsearch instance or an internal cache.
+// Store holds information necessary to fetch a sourcemap, either from a backend
+// (an Elasticsearch instance or a local directory) or an internal cache.
 type Store struct {
-	cache   *gocache.Cache
-	esStore *esStore
+	cache   *assetstore.Cache
+	backend backend
 	logger  *logp.Logger
 }
 
+// Metadata identifies a stored sourcemap.
+type Metadata struct {
+	ServiceName    string `json:"service_name"`
+	ServiceVersion string `json:"service_version"`
+	BundleFilepath string `json:"bundle_filepath"`
+}
+
+// backend provides access to the sourcemaps stored for a service name, service version and bundle filepath.
+//
+// If no sourcemap exists for the given parameters, fetch returns emptyResult and no error.
+// Failures which may resolve themselves are reported as temporary, see assetstore.Temporary.
+type backend interface {
+	fetch(ctx context.Context, name, version, path string) (string, error)
+	list(ctx context.Context, name, version string) ([]Metadata, error)
+	delete(ctx context.Context, name, version, path string) (int, error)
+}
+
 // NewStore creates a new instance for fetching sourcemaps. The client and index parameters are needed to be able to
 // fetch sourcemaps from Elasticsearch. The expiration time is used for the internal cache.
 func NewStore(client elasticsearch.Client, index string, expiration time.Duration) (*Store, error) {
-	if expiration < 0 {
-		return nil, errInit
-	}
 	logger := logp.NewLogger(logs.Sourcemap)
-	return &Store{
-		cache:   gocache.New(expiration, cleanupInterval(expiration)),
-		esStore: &esStore{client: client, index: index, logger: logger},
-		logger:  logger,
-	}, nil
+	return newStore(&esStore{client: client, index: index, logger: logger}, logger, expiration)
 }
 
-// Fetch a sourcemap from the store.
-func (s *Store) Fetch(ctx context.Context, name string, version string, path string) (*sourcemap.Consumer, error) {
-	key := key([]string{name, version, path})
-
-	// fetch from cache
-	if val, found := s.cache.Get(key); found {
-		consumer, _ := val.(*sourcemap.Consumer)
-		return consumer, nil
-	}
+// NewFileStore creates a new instance for fetching sourcemaps from the directory tree rooted at dir.
+// Sourcemaps are expected at <dir>/<service name>/<service version>/<bundle filepath>.map, where
+// bundle filepath is the URL path of the minified bundle. The expiration time is used for the internal cache.
+func NewFileStore(dir string, expiration time.Duration) (*Store, error) {
+	logger := logp.NewLogger(logs.Sourcemap)
+	return newStore(&fsStore{dir: dir, logger: logger}, logger, expiration)
+}
 
-	// fetch from Elasticsearch and ensure caching for all non-temporary results
-	sourcemapStr, err := s.esStore.fetch(ctx, name, version, path)
+func newStore(b backend, logger *logp.Logger, expiration time.Duration) (*Store, error) {
+	cache, err := assetstore.NewCache(expiration, logger)
 	if err != nil {
-		if !strings.Contains(err.Error(), errMsgESFailure) {
-			s.add(key, nil)
-		}
 		return nil, err
 	}
+	return &Store{cache: cache, backend: b, logger: logger}, nil
+}
 
-	if sourcemapStr == emptyResult {
-		s.add(key, nil)
-		return nil, nil
-	}
-
-	consumer, err := sourcemap.Parse("", []byte(sourcemapStr))
-	if err != nil {
-		s.add(key, nil)
-		return nil, errors.Wrap(err, errMsgParseSourcemap)
-	}
-	s.add(key, consumer)
-	return consumer, nil
+// Fetch a sourcemap from the store.
+func (s *Store) Fetch(ctx context.Context, name string, version string, path string) (*sourcemap.Consumer, error) {
+	val, err := s.cache.Fetch(assetstore.Key(name, version, path), func() (interface{}, error) {
+		sourcemapStr, err := s.backend.fetch(ctx, name, version, path)
+		if err != nil || sourcemapStr == emptyResult {
+			return nil, err
+		}
+		consumer, err := parseSourcemap([]byte(sourcemapStr))
+		if err != nil {
+			return nil, errors.Wrap(err, errMsgParseSourcemap)
+		}
+		return consumer, nil
+	})
+	consumer, _ := val.(*sourcemap.Consumer)
+	return consumer, err
 }
 
 // Added ensures the internal cache is cleared for the given parameters. This should be called when a sourcemap is uploaded.
@@ -102,26 +103,27 @@ func (s *Store) Added(ctx context.Context, name string, version string, path str
 		s.logger.Warnf("Overriding sourcemap for service %s version %s and file %s",
 			name, version, path)
 	}
-	key := key([]string{name, version, path})
-	s.cache.Delete(key)
-	if !s.logger.IsDebug() {
-		return
-	}
-	s.logger.Debugf("Removed id %v. Cache now has %v entries.", key, s.cache.ItemCount())
+	s.cache.Remove(assetstore.Key(name, version, path))
 }
 
-func (s *Store) add(key string, consumer *sourcemap.Consumer) {
-	s.cache.SetDefault(key, consumer)
-	if !s.logger.IsDebug() {
-		return
-	}
-	s.logger.Debugf("Added id %v. Cache now has %v entries.", key, s.cache.ItemCount())
+// List returns the sourcemaps stored for the given service name and version.
+func (s *Store) List(ctx context.Context, name string, version string) ([]Metadata, error) {
+	return s.backend.list(ctx, name, version)
 }
 
-func key(s []string) string {
-	return strings.Join(s, "_")
-}
-
-func cleanupInterval(ttl time.Duration) time.Duration {
-	return time.Duration(math.Max(ttl.Seconds(), minCleanupIntervalSeconds)) * time.Second
+// Delete removes the sourcemaps stored for the given parameters, and ensures the internal cache
+// is cleared for them. The path may be a full URL or a URL path; either way, sourcemaps are
+// removed by URL path. Delete returns the number of removed sourcemaps.
+func (s *Store) Delete(ctx context.Context, name string, version string, path string) (int, error) {
+	deleted, err := s.backend.delete(ctx, name, version, path)
+	if err != nil {
+		return 0, err
+	}
+	// Fetch caches sourcemaps by the path of the frame being mapped, which may
+	// be a full URL, so remove all entries with the same URL path.
+	urlPath := utility.UrlPath(path)
+	s.cache.RemoveMatching([]string{name, version}, func(cachedPath string) bool {
+		return utility.UrlPath(cachedPath) == urlPath
+	})
+	return deleted, nil
 }
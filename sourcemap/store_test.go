@@ -19,16 +19,17 @@ package sourcemap
 
 import (
 	"context"
-	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
 	"github.com/go-sourcemap/sourcemap"
-	gocache "github.com/patrickmn/go-cache"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/elastic/apm-server/assetstore"
 	"github.com/elastic/apm-server/elasticsearch"
+	"github.com/elastic/apm-server/elasticsearch/estest"
 
 	"github.com/elastic/apm-server/sourcemap/test"
 )
@@ -50,7 +51,7 @@ func TestStore_Fetch(t *testing.T) {
 		t.Run("nil", func(t *testing.T) {
 			var nilConsumer *sourcemap.Consumer
 			store := testStore(t, test.ESClientWithValidSourcemap(t)) //if ES was queried, it would return a valid sourcemap
-			store.add(key, nilConsumer)
+			store.cache.Put(key, nilConsumer)
 
 			mapper, err := store.Fetch(context.Background(), serviceName, serviceVersion, path)
 			assert.Nil(t, mapper)
@@ -60,7 +61,7 @@ func TestStore_Fetch(t *testing.T) {
 		t.Run("sourcemapConsumer", func(t *testing.T) {
 			consumer := &sourcemap.Consumer{}
 			store := testStore(t, test.ESClientUnavailable(t)) //if ES was queried, it would return a server error
-			store.add(key, consumer)
+			store.cache.Put(key, consumer)
 
 			mapper, err := store.Fetch(context.Background(), serviceName, serviceVersion, path)
 			require.NoError(t, err)
@@ -149,7 +150,7 @@ func TestStore_Added(t *testing.T) {
 	// setup
 	// remove empty sourcemap from cache, and valid one with File() == "bundle.js" from Elasticsearch
 	store := testStore(t, test.ESClientWithValidSourcemap(t))
-	store.add(key, &sourcemap.Consumer{})
+	store.cache.Put(key, &sourcemap.Consumer{})
 
 	mapper, err := store.Fetch(context.Background(), name, version, path)
 	require.NoError(t, err)
@@ -164,10 +165,78 @@ func TestStore_Added(t *testing.T) {
 	assert.Equal(t, "bundle.js", mapper.File())
 }
 
+func TestStore_Delete(t *testing.T) {
+	name, version, path := "foo", "1.0.1", "/tmp"
+	key := "foo_1.0.1_/tmp"
+
+	client, err := estest.NewElasticsearchClient(estest.NewTransport(t, http.StatusOK, map[string]interface{}{"deleted": 1}))
+	require.NoError(t, err)
+	store := testStore(t, client)
+	store.cache.Put(key, &sourcemap.Consumer{})
+
+	deleted, err := store.Delete(context.Background(), name, version, path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+	_, found := store.cache.Get(key)
+	assert.False(t, found)
+}
+
+func TestStore_DeleteFullURL(t *testing.T) {
+	client, err := estest.NewElasticsearchClient(estest.NewTransport(t, http.StatusOK, map[string]interface{}{"deleted": 1}))
+	require.NoError(t, err)
+	store := testStore(t, client)
+
+	// Sourcemaps are cached by the path of the frame being mapped,
+	// which may be a full URL.
+	for _, key := range []string{
+		"foo_1.0.1_/js/bundle.js",
+		"foo_1.0.1_http://localhost:8000/js/bundle.js",
+		"foo_1.0.1_https://example.com/js/bundle.js",
+		"foo_1.0.1_/js/other.js",
+		"foo_1.0.2_/js/bundle.js",
+	} {
+		store.cache.Put(key, &sourcemap.Consumer{})
+	}
+
+	deleted, err := store.Delete(context.Background(), "foo", "1.0.1", "/js/bundle.js")
+	require.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	var keys []string
+	for key := range store.cache.Items() {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{"foo_1.0.1_/js/other.js", "foo_1.0.2_/js/bundle.js"}, keys)
+}
+
+func TestStore_DeleteUnderscores(t *testing.T) {
+	client, err := estest.NewElasticsearchClient(estest.NewTransport(t, http.StatusOK, map[string]interface{}{"deleted": 1}))
+	require.NoError(t, err)
+	store := testStore(t, client)
+
+	// Components containing the key separator must not collide.
+	otherKey := assetstore.Key("a_b", "c", "/js/my_bundle.js")
+	store.cache.Put(otherKey, &sourcemap.Consumer{})
+	store.cache.Put(assetstore.Key("a", "b_c", "/js/my_bundle.js"), &sourcemap.Consumer{})
+	store.cache.Put(assetstore.Key("a", "b_c", "http://localhost/js/my_bundle.js"), &sourcemap.Consumer{})
+	assert.Equal(t, 3, store.cache.ItemCount())
+
+	_, err = store.Delete(context.Background(), "a", "b_c", "/js/my_bundle.js")
+	require.NoError(t, err)
+
+	var keys []string
+	for key := range store.cache.Items() {
+		keys = append(keys, key)
+	}
+	assert.Equal(t, []string{otherKey}, keys)
+}
+
 func TestExpiration(t *testing.T) {
 	store := testStore(t, test.ESClientUnavailable(t)) //if ES was queried it would return an error
-	store.cache = gocache.New(25*time.Millisecond, 100)
-	store.add("foo_1.0.1_/tmp", &sourcemap.Consumer{})
+	cache, err := assetstore.NewCache(25*time.Millisecond, store.logger)
+	require.NoError(t, err)
+	store.cache = cache
+	store.cache.Put("foo_1.0.1_/tmp", &sourcemap.Consumer{})
 	name, version, path := "foo", "1.0.1", "/tmp"
 
 	// sourcemap is cached
@@ -182,25 +251,6 @@ func TestExpiration(t *testing.T) {
 	assert.Nil(t, mapper)
 }
 
-func TestCleanupInterval(t *testing.T) {
-	tests := []struct {
-		ttl      time.Duration
-		expected float64
-	}{
-		{expected: 1},
-		{ttl: 30 * time.Second, expected: 1},
-		{ttl: 30 * time.Second, expected: 1},
-		{ttl: 60 * time.Second, expected: 1},
-		{ttl: 61 * time.Second, expected: 61.0 / 60},
-		{ttl: 5 * time.Minute, expected: 5},
-	}
-	for idx, test := range tests {
-		out := cleanupInterval(test.ttl)
-		assert.Equal(t, test.expected, out.Minutes(),
-			fmt.Sprintf("(%v) expected %v minutes, received %v minutes", idx, test.expected, out.Minutes()))
-	}
-}
-
 func testStore(t *testing.T, client elasticsearch.Client) *Store {
 	store, err := NewStore(client, "apm-*sourcemap*", time.Minute)
 	require.NoError(t, err)
@@ -102,7 +102,9 @@ func TestTransactionAggregationShutdown(t *testing.T) {
 	result := systemtest.Elasticsearch.ExpectDocs(t, "apm-*",
 		estest.ExistsQuery{Field: "transaction.duration.histogram"},
 	)
-	systemtest.ApproveEvents(t, t.Name(), result.Hits.Hits, "@timestamp")
+	// The metrics are flushed before the end of the interval, so
+	// metricset.period records the time elapsed until shutdown.
+	systemtest.ApproveEvents(t, t.Name(), result.Hits.Hits, "@timestamp", "metricset.period")
 }
 
 func TestServiceDestinationAggregation(t *testing.T) {
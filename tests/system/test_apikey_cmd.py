@@ -166,9 +166,10 @@ class APIKeyCommandTest(APIKeyCommandBaseTest):
     def test_verify_all(self):
         apikey = self.create()
         result = self.subcommand_output("verify", "--credentials={}".format(apikey["credentials"]))
-        assert result == {'event:write': True, 'config_agent:read': True, 'sourcemap:write': True}, result
+        assert result == {'event:write': True, 'config_agent:read': True, 'sourcemap:write': True,
+                          'sampling:read': True}, result
 
-        for privilege in ["ingest", "sourcemap", "agent-config"]:
+        for privilege in ["ingest", "sourcemap", "agent-config", "sampling"]:
             result = self.subcommand_output(
                 "verify", "--credentials={}".format(apikey["credentials"]), "--" + privilege)
             assert len(result) == 1, result
@@ -177,12 +178,20 @@ class APIKeyCommandTest(APIKeyCommandBaseTest):
     def test_verify_each(self):
         apikey = self.create("--ingest")
         result = self.subcommand_output("verify", "--credentials={}".format(apikey["credentials"]))
-        assert result == {'event:write': True, 'config_agent:read': False, 'sourcemap:write': False}, result
+        assert result == {'event:write': True, 'config_agent:read': False, 'sourcemap:write': False,
+                          'sampling:read': False}, result
 
         apikey = self.create("--sourcemap")
         result = self.subcommand_output("verify", "--credentials={}".format(apikey["credentials"]))
-        assert result == {'event:write': False, 'config_agent:read': False, 'sourcemap:write': True}, result
+        assert result == {'event:write': False, 'config_agent:read': False, 'sourcemap:write': True,
+                          'sampling:read': False}, result
 
         apikey = self.create("--agent-config")
         result = self.subcommand_output("verify", "--credentials={}".format(apikey["credentials"]))
-        assert result == {'event:write': False, 'config_agent:read': True, 'sourcemap:write': False}, result
+        assert result == {'event:write': False, 'config_agent:read': True, 'sourcemap:write': False,
+                          'sampling:read': False}, result
+
+        apikey = self.create("--sampling")
+        result = self.subcommand_output("verify", "--credentials={}".format(apikey["credentials"]))
+        assert result == {'event:write': False, 'config_agent:read': False, 'sourcemap:write': False,
+                          'sampling:read': True}, result
@@ -72,10 +72,12 @@ class APIKeyBaseTest(ElasticTest):
         self.privilege_agent_config = "config_agent:read"
         self.privilege_event = "event:write"
         self.privilege_sourcemap = "sourcemap:write"
+        self.privilege_sampling = "sampling:read"
         self.privileges = {
             "agentConfig": self.privilege_agent_config,
             "event": self.privilege_event,
-            "sourcemap": self.privilege_sourcemap
+            "sourcemap": self.privilege_sourcemap,
+            "sampling": self.privilege_sampling
         }
         self.privileges_all = list(self.privileges.values())
         self.privilege_any = "*"
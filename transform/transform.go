@@ -23,6 +23,7 @@ import (
 
 	"github.com/elastic/beats/v7/libbeat/beat"
 
+	"github.com/elastic/apm-server/proguard"
 	"github.com/elastic/apm-server/sourcemap"
 )
 
@@ -36,6 +37,10 @@ type Config struct {
 	// If true, then data_stream fields should be added to all events.
 	DataStreams bool
 
+	// ProguardMappingStore, if non-nil, is used for deobfuscating
+	// stack traces of non-RUM events.
+	ProguardMappingStore *proguard.Store
+
 	RUM RUMConfig
 }
 
@@ -0,0 +1,497 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package breakdownmetrics
+
+import (
+	"context"
+	"math"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	logs "github.com/elastic/apm-server/log"
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/processor/otel"
+	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+const (
+	// transactionSelfTimeSpanType is the span type under which the self
+	// time of transactions is recorded, matching the Elastic APM agents.
+	transactionSelfTimeSpanType = "app"
+
+	// tooManyGroupsLoggerRateLimit is the maximum frequency at which
+	// "too many groups" log messages are logged.
+	tooManyGroupsLoggerRateLimit = time.Minute
+
+	// overflowBucketName is the service name, transaction name, and span
+	// type recorded for self time aggregated into the overflow group.
+	overflowBucketName = "_other"
+
+	// traceWindowFraction is the fraction of TraceWindow between checks
+	// for idle traces, bounding how long traces are buffered beyond it.
+	traceWindowFraction = 4
+)
+
+// AggregatorConfig holds configuration for creating an Aggregator.
+type AggregatorConfig struct {
+	// Report is a publish.Reporter for reporting metrics documents.
+	Report publish.Reporter
+
+	// MaxGroups is the maximum number of distinct breakdown group metrics
+	// to store within an aggregation period. Once this number of groups
+	// is reached, self time for new aggregation keys is aggregated into
+	// an additional overflow group, with the service name, transaction
+	// name, and span type "_other".
+	MaxGroups int
+
+	// Interval is the interval between publishing of aggregated metrics.
+	Interval time.Duration
+
+	// TraceWindow is the amount of time to wait for more events of a trace,
+	// after its most recent event is received, before calculating the self
+	// time of its transactions and spans. The events of a trace are often
+	// received in separate batches, and the self time of a transaction or
+	// span cannot be calculated until all of its child spans are received.
+	TraceWindow time.Duration
+
+	// MaxTraces is the maximum number of traces for which to buffer events
+	// within TraceWindow. Once this number of traces is reached, events of
+	// new traces are not recorded.
+	MaxTraces int
+
+	// Logger is the logger for logging metrics aggregation/publishing.
+	//
+	// If Logger is nil, a new logger will be constructed.
+	Logger *logp.Logger
+}
+
+// Validate validates the aggregator config.
+func (config AggregatorConfig) Validate() error {
+	if config.Report == nil {
+		return errors.New("Report unspecified")
+	}
+	if config.MaxGroups <= 0 {
+		return errors.New("MaxGroups unspecified or negative")
+	}
+	if config.Interval <= 0 {
+		return errors.New("Interval unspecified or negative")
+	}
+	if config.TraceWindow <= 0 {
+		return errors.New("TraceWindow unspecified or negative")
+	}
+	if config.MaxTraces <= 0 {
+		return errors.New("MaxTraces unspecified or negative")
+	}
+	return nil
+}
+
+// Aggregator calculates the self time of transactions and spans received
+// from Jaeger, which do not send breakdown metrics, and periodically
+// publishes them as span.self_time breakdown metrics by transaction group
+// and span type, in the same form as those sent by Elastic APM agents.
+//
+// Self time is calculated from the transactions and spans of each trace
+// once no more of its events have been received within TraceWindow. Spans
+// whose transaction is not received within the window are not recorded,
+// and the self time of a transaction or span whose child spans are received
+// after the window will be overestimated.
+type Aggregator struct {
+	stopMu   sync.Mutex
+	stopping chan struct{}
+	stopped  chan struct{}
+
+	config              AggregatorConfig
+	metrics             aggregatorMetrics
+	tooManyGroupsLogger *logp.Logger
+	tooManyTracesLogger *logp.Logger
+
+	tracesMu sync.Mutex
+	traces   map[string]*traceTree
+
+	mu               sync.RWMutex
+	active, inactive *metricsBuffer
+}
+
+type aggregatorMetrics struct {
+	overflowed    int64
+	droppedTraces int64
+}
+
+// NewAggregator returns a new Aggregator with the given config.
+func NewAggregator(config AggregatorConfig) (*Aggregator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid aggregator config")
+	}
+	if config.Logger == nil {
+		config.Logger = logp.NewLogger(logs.BreakdownMetrics)
+	}
+	return &Aggregator{
+		stopping:            make(chan struct{}),
+		stopped:             make(chan struct{}),
+		config:              config,
+		tooManyGroupsLogger: config.Logger.WithOptions(logs.WithRateLimit(tooManyGroupsLoggerRateLimit)),
+		tooManyTracesLogger: config.Logger.WithOptions(logs.WithRateLimit(tooManyGroupsLoggerRateLimit)),
+		traces:              make(map[string]*traceTree),
+		active:              newMetricsBuffer(config.MaxGroups),
+		inactive:            newMetricsBuffer(config.MaxGroups),
+	}, nil
+}
+
+// Run runs the Aggregator, periodically publishing and clearing aggregated
+// metrics. Run returns when either a fatal error occurs, or the Aggregator's
+// Stop method is invoked.
+//
+// When the Aggregator is stopped, the self time of all buffered traces is
+// calculated and published along with the final metrics.
+func (a *Aggregator) Run() error {
+	ticker := time.NewTicker(a.config.Interval)
+	defer ticker.Stop()
+	traceTicker := time.NewTicker(a.config.TraceWindow / traceWindowFraction)
+	defer traceTicker.Stop()
+	defer func() {
+		a.stopMu.Lock()
+		defer a.stopMu.Unlock()
+		select {
+		case <-a.stopped:
+		default:
+			close(a.stopped)
+		}
+	}()
+	var stop bool
+	for !stop {
+		select {
+		case <-a.stopping:
+			stop = true
+			a.aggregateIdleTraces(time.Now())
+		case <-ticker.C:
+		case now := <-traceTicker.C:
+			a.aggregateIdleTraces(now.Add(-a.config.TraceWindow))
+			continue
+		}
+		if err := a.publish(context.Background()); err != nil {
+			a.config.Logger.With(logp.Error(err)).Warnf(
+				"publishing breakdown metrics failed: %s", err,
+			)
+		}
+	}
+	return nil
+}
+
+// Stop stops the Aggregator if it is running, waiting for it to flush any
+// aggregated metrics and return, or for the context to be cancelled.
+//
+// After Stop has been called the aggregator cannot be reused, as the Run
+// method will always return immediately.
+func (a *Aggregator) Stop(ctx context.Context) error {
+	a.stopMu.Lock()
+	select {
+	case <-a.stopped:
+	case <-a.stopping:
+		// Already stopping/stopped.
+	default:
+		close(a.stopping)
+	}
+	a.stopMu.Unlock()
+
+	select {
+	case <-a.stopped:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+// CollectMonitoring may be called to collect monitoring metrics from the
+// aggregation. It is intended to be used with libbeat/monitoring.NewFunc.
+//
+// The metrics should be added to the "apm-server.aggregation.breakdownmetrics" registry.
+func (a *Aggregator) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor) {
+	V.OnRegistryStart()
+	defer V.OnRegistryFinished()
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	m := a.active
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	monitoring.ReportInt(V, "active_groups", int64(len(m.m)))
+	monitoring.ReportInt(V, "overflowed", atomic.LoadInt64(&a.metrics.overflowed))
+
+	a.tracesMu.Lock()
+	defer a.tracesMu.Unlock()
+	monitoring.ReportInt(V, "buffered_traces", int64(len(a.traces)))
+	monitoring.ReportInt(V, "dropped_traces", atomic.LoadInt64(&a.metrics.droppedTraces))
+}
+
+func (a *Aggregator) publish(ctx context.Context) error {
+	// We hold a.mu only long enough to swap the metrics. This will
+	// be blocked by metrics updates, which is OK, as we prefer not
+	// to block metrics updaters. After the lock is released nothing
+	// will be accessing a.inactive.
+	a.mu.Lock()
+	a.active, a.inactive = a.inactive, a.active
+	a.mu.Unlock()
+
+	size := len(a.inactive.m)
+	if size == 0 {
+		a.config.Logger.Debugf("no breakdown metrics to publish")
+		return nil
+	}
+
+	now := time.Now()
+	metricsets := make([]transform.Transformable, 0, size)
+	for key, metrics := range a.inactive.m {
+		metricset := makeMetricset(now, key, metrics)
+		metricsets = append(metricsets, &metricset)
+		delete(a.inactive.m, key)
+	}
+	a.config.Logger.Debugf("publishing %d metricsets", len(metricsets))
+	return a.config.Report(ctx, publish.PendingReq{
+		Transformables: metricsets,
+		Trace:          true,
+	})
+}
+
+// ProcessTransformables buffers the Jaeger transactions and spans contained
+// in "in" by trace, for calculating their self time once each trace is idle,
+// returning the input unmodified.
+//
+// This method is expected to be used immediately prior to publishing
+// the events.
+func (a *Aggregator) ProcessTransformables(ctx context.Context, in []transform.Transformable) ([]transform.Transformable, error) {
+	now := time.Now()
+	a.tracesMu.Lock()
+	defer a.tracesMu.Unlock()
+	for _, tf := range in {
+		var traceID, id string
+		var n *node
+		switch event := tf.(type) {
+		case *model.Transaction:
+			if !isJaegerAgent(event.Metadata.Service.Agent.Name) {
+				continue
+			}
+			traceID, id, n = event.TraceID, event.ID, newTransactionNode(event)
+		case *model.Span:
+			if !isJaegerAgent(event.Metadata.Service.Agent.Name) {
+				continue
+			}
+			traceID, id, n = event.TraceID, event.ID, newSpanNode(event)
+		default:
+			continue
+		}
+		if traceID == "" || id == "" {
+			continue
+		}
+		tree, ok := a.traces[traceID]
+		if !ok {
+			if len(a.traces) >= a.config.MaxTraces {
+				a.tooManyTracesLogger.Warn(`
+Breakdown trace limit reached, events of new traces will not be recorded.
+Consider increasing max_traces, or decreasing trace_window.`[1:],
+				)
+				atomic.AddInt64(&a.metrics.droppedTraces, 1)
+				continue
+			}
+			tree = newTraceTree()
+			a.traces[traceID] = tree
+		}
+		tree.nodes[id] = n
+		tree.updated = now
+	}
+	return in, nil
+}
+
+// aggregateIdleTraces calculates and records the self time of the
+// transactions and spans of each trace not updated after cutoff,
+// and removes the traces from the buffer.
+func (a *Aggregator) aggregateIdleTraces(cutoff time.Time) {
+	var idle []*traceTree
+	a.tracesMu.Lock()
+	for traceID, tree := range a.traces {
+		if !tree.updated.After(cutoff) {
+			idle = append(idle, tree)
+			delete(a.traces, traceID)
+		}
+	}
+	a.tracesMu.Unlock()
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, tree := range idle {
+		tree.link()
+		for _, n := range tree.nodes {
+			group := tree.transactionGroup(n)
+			if group == nil {
+				continue
+			}
+			key := group.key
+			if n.group != nil {
+				// Record the transaction-level breakdown metrics,
+				// in addition to the transaction's self time.
+				a.storeOrUpdate(key, group.count, n.duration)
+				key.spanType = transactionSelfTimeSpanType
+			} else {
+				key.spanType = n.spanType
+				key.spanSubtype = n.spanSubtype
+			}
+			a.storeOrUpdate(key, group.count, n.selfTime())
+		}
+	}
+}
+
+// storeOrUpdate records count and duration in the metrics for key,
+// logging if they were instead recorded in the overflow group.
+func (a *Aggregator) storeOrUpdate(key aggregationKey, count float64, duration time.Duration) {
+	if a.active.storeOrUpdate(key, count, duration) {
+		return
+	}
+	a.tooManyGroupsLogger.Warn(`
+Breakdown group limit reached, aggregating excess breakdown groups into "_other".
+This is typically caused by ineffective transaction grouping, e.g. by creating many
+unique transaction names.`[1:],
+	)
+	atomic.AddInt64(&a.metrics.overflowed, 1)
+}
+
+// isJaegerAgent reports whether agentName identifies a Jaeger client,
+// as recorded by processor/otel, e.g. "Jaeger" or "Jaeger/go".
+func isJaegerAgent(agentName string) bool {
+	return agentName == otel.AgentNameJaeger || strings.HasPrefix(agentName, otel.AgentNameJaeger+"/")
+}
+
+// transactionCount returns the number of transactions represented by tx.
+//
+// Unlike throughput metrics, breakdown metrics are typically viewed as
+// proportions, so transactions with an unknown sampling rate are counted
+// once rather than being excluded.
+func transactionCount(tx *model.Transaction) float64 {
+	if tx.RepresentativeCount > 0 {
+		return tx.RepresentativeCount
+	}
+	return 1
+}
+
+type metricsBuffer struct {
+	maxSize int
+
+	mu sync.RWMutex
+	m  map[aggregationKey]selfTimeMetrics
+}
+
+func newMetricsBuffer(maxSize int) *metricsBuffer {
+	return &metricsBuffer{
+		maxSize: maxSize,
+		m:       make(map[aggregationKey]selfTimeMetrics),
+	}
+}
+
+// storeOrUpdate records count and duration in the metrics for key,
+// returning false if they were instead recorded in an overflow group
+// due to the group limit being reached.
+//
+// For transaction-level keys, which have no span type, duration is the
+// transaction duration; otherwise it is self time.
+func (mb *metricsBuffer) storeOrUpdate(key aggregationKey, count float64, duration time.Duration) bool {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	old, ok := mb.m[key]
+	var overflowed bool
+	if !ok && len(mb.m) >= mb.maxSize {
+		// The overflow groups, for transaction-level metrics and
+		// for self time, may exceed maxSize by two.
+		overflowKey := aggregationKey{
+			serviceName:     overflowBucketName,
+			transactionName: overflowBucketName,
+		}
+		if key.spanType != "" {
+			overflowKey.spanType = overflowBucketName
+		}
+		key = overflowKey
+		old = mb.m[key]
+		overflowed = true
+	}
+	mb.m[key] = selfTimeMetrics{
+		count: old.count + count,
+		sum:   old.sum + float64(duration.Microseconds())*count,
+	}
+	return !overflowed
+}
+
+type aggregationKey struct {
+	serviceName        string
+	serviceEnvironment string
+	serviceVersion     string
+	agentName          string
+	transactionName    string
+	transactionType    string
+	spanType           string
+	spanSubtype        string
+}
+
+type selfTimeMetrics struct {
+	count float64
+	sum   float64
+}
+
+func makeMetricset(timestamp time.Time, key aggregationKey, metrics selfTimeMetrics) model.Metricset {
+	out := model.Metricset{
+		Timestamp: timestamp,
+		Metadata: model.Metadata{
+			Service: model.Service{
+				Name:        key.serviceName,
+				Environment: key.serviceEnvironment,
+				Version:     key.serviceVersion,
+				Agent:       model.Agent{Name: key.agentName},
+			},
+		},
+		Transaction: model.MetricsetTransaction{
+			Name: key.transactionName,
+			Type: key.transactionType,
+		},
+	}
+	if key.spanType == "" {
+		// Transaction-level breakdown metrics, as sent by the agents
+		// alongside the self time metrics of each transaction group.
+		out.Samples = []model.Sample{
+			{
+				Name:  "transaction.duration.count",
+				Value: math.Round(metrics.count),
+			},
+			{
+				Name:  "transaction.duration.sum.us",
+				Value: math.Round(metrics.sum),
+			},
+			{
+				Name:  "transaction.breakdown.count",
+				Value: math.Round(metrics.count),
+			},
+		}
+		return out
+	}
+	out.Span = model.MetricsetSpan{
+		Type:    key.spanType,
+		Subtype: key.spanSubtype,
+	}
+	out.Samples = []model.Sample{
+		{
+			Name:  "span.self_time.count",
+			Value: math.Round(metrics.count),
+		},
+		{
+			Name:  "span.self_time.sum.us",
+			Value: math.Round(metrics.sum),
+		},
+	}
+	return out
+}
@@ -0,0 +1,365 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package breakdownmetrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+func TestNewAggregatorConfigInvalid(t *testing.T) {
+	report := makeErrReporter(nil)
+
+	type test struct {
+		config AggregatorConfig
+		err    string
+	}
+
+	for _, test := range []test{{
+		config: AggregatorConfig{},
+		err:    "Report unspecified",
+	}, {
+		config: AggregatorConfig{
+			Report: report,
+		},
+		err: "MaxGroups unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+		},
+		err: "Interval unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+			Interval:  time.Second,
+		},
+		err: "TraceWindow unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:      report,
+			MaxGroups:   1,
+			Interval:    time.Second,
+			TraceWindow: time.Second,
+		},
+		err: "MaxTraces unspecified or negative",
+	}} {
+		agg, err := NewAggregator(test.config)
+		require.Error(t, err)
+		require.Nil(t, agg)
+		assert.EqualError(t, err, "invalid aggregator config: "+test.err)
+	}
+}
+
+func TestAggregatorRun(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:      makeChanReporter(reqs),
+		Interval:    10 * time.Millisecond,
+		MaxGroups:   1000,
+		TraceWindow: time.Millisecond,
+		MaxTraces:   1000,
+	})
+	require.NoError(t, err)
+
+	start := time.Unix(0, 0)
+	tx := makeTransaction("Jaeger/go", "trace", "tx", "", "GET /", start, 100)
+	tx.RepresentativeCount = 2
+	batches := [][]transform.Transformable{{
+		tx,
+		// db spans overlap, so their union (10-40ms) is subtracted from
+		// the transaction's self time.
+		makeSpan("Jaeger/go", "trace", "db1", "tx", "db", "mysql", start.Add(10*time.Millisecond), 20),
+		makeSpan("Jaeger/go", "trace", "db2", "tx", "db", "mysql", start.Add(20*time.Millisecond), 20),
+		// Spans without their transaction in the trace are ignored.
+		makeSpan("Jaeger/go", "trace", "orphan", "missing", "db", "mysql", start, 10),
+		// Events from Elastic APM agents are ignored, as the
+		// agents send their own breakdown metrics.
+		makeTransaction("go", "trace2", "tx2", "", "GET /", start, 100),
+	}, {
+		// Spans received in a later batch are attributed to the transaction.
+		// The external span's child is attributed to the transaction.
+		makeSpan("Jaeger/go", "trace", "ext", "tx", "external", "http", start.Add(50*time.Millisecond), 30),
+		makeSpan("Jaeger/go", "trace", "tmpl", "ext", "template", "", start.Add(60*time.Millisecond), 10),
+	}}
+	for _, input := range batches {
+		out, err := agg.ProcessTransformables(context.Background(), input)
+		require.NoError(t, err)
+		assert.Equal(t, input, out)
+	}
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	req := expectPublish(t, reqs)
+	metricsets := make([]*model.Metricset, len(req.Transformables))
+	for i, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		require.NotZero(t, ms.Timestamp)
+		ms.Timestamp = time.Time{}
+		metricsets[i] = ms
+	}
+
+	makeMetricset := func(spanType, spanSubtype string, count, sum float64) *model.Metricset {
+		return &model.Metricset{
+			Metadata: model.Metadata{
+				Service: model.Service{
+					Name:  "service",
+					Agent: model.Agent{Name: "Jaeger/go"},
+				},
+			},
+			Transaction: model.MetricsetTransaction{Name: "GET /", Type: "request"},
+			Span:        model.MetricsetSpan{Type: spanType, Subtype: spanSubtype},
+			Samples: []model.Sample{
+				{Name: "span.self_time.count", Value: count},
+				{Name: "span.self_time.sum.us", Value: sum},
+			},
+		}
+	}
+	assert.ElementsMatch(t, []*model.Metricset{{
+		Metadata: model.Metadata{
+			Service: model.Service{
+				Name:  "service",
+				Agent: model.Agent{Name: "Jaeger/go"},
+			},
+		},
+		Transaction: model.MetricsetTransaction{Name: "GET /", Type: "request"},
+		Samples: []model.Sample{
+			{Name: "transaction.duration.count", Value: 2},
+			{Name: "transaction.duration.sum.us", Value: 2 * 100000},
+			{Name: "transaction.breakdown.count", Value: 2},
+		},
+	},
+		makeMetricset("app", "", 2, 2*40000),
+		makeMetricset("db", "mysql", 4, 2*(20000+20000)),
+		makeMetricset("external", "http", 2, 2*20000),
+		makeMetricset("template", "", 2, 2*10000),
+	}, metricsets)
+}
+
+func TestAggregatorOverflow(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:      makeChanReporter(reqs),
+		Interval:    10 * time.Millisecond,
+		MaxGroups:   4,
+		TraceWindow: time.Minute,
+		MaxTraces:   1000,
+	})
+	require.NoError(t, err)
+
+	start := time.Unix(0, 0)
+	input := []transform.Transformable{
+		makeTransaction("Jaeger", "trace1", "tx1", "", "a", start, 10),
+		makeTransaction("Jaeger", "trace2", "tx2", "", "b", start, 10),
+		// Subsequent groups exceed the group limit, and
+		// are aggregated into the overflow groups.
+		makeTransaction("Jaeger", "trace3", "tx3", "", "c", start, 10),
+		makeTransaction("Jaeger", "trace4", "tx4", "", "d", start, 10),
+	}
+	// Process the transactions separately, so the order in
+	// which groups are created is deterministic.
+	for _, tf := range input {
+		_, err = agg.ProcessTransformables(context.Background(), []transform.Transformable{tf})
+		require.NoError(t, err)
+		agg.aggregateIdleTraces(time.Now())
+	}
+
+	// Each transaction records a transaction-level group and an "app"
+	// self time group, so there are two overflow groups.
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["breakdownmetrics.active_groups"] = 6
+	expectedMonitoring.Ints["breakdownmetrics.overflowed"] = 4
+	expectedMonitoring.Ints["breakdownmetrics.buffered_traces"] = 0
+	expectedMonitoring.Ints["breakdownmetrics.dropped_traces"] = 0
+
+	registry := monitoring.NewRegistry()
+	monitoring.NewFunc(registry, "breakdownmetrics", agg.CollectMonitoring)
+	assert.Equal(t, expectedMonitoring, monitoring.CollectFlatSnapshot(
+		registry,
+		monitoring.Full,
+		false, // expvar
+	))
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	type group struct {
+		serviceName     string
+		transactionName string
+		spanType        string
+		count           float64
+	}
+	var groups []group
+	req := expectPublish(t, reqs)
+	for _, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		groups = append(groups, group{
+			serviceName:     ms.Metadata.Service.Name,
+			transactionName: ms.Transaction.Name,
+			spanType:        ms.Span.Type,
+			count:           ms.Samples[0].Value,
+		})
+	}
+	assert.ElementsMatch(t, []group{
+		{serviceName: "service", transactionName: "a", count: 1},
+		{serviceName: "service", transactionName: "a", spanType: "app", count: 1},
+		{serviceName: "service", transactionName: "b", count: 1},
+		{serviceName: "service", transactionName: "b", spanType: "app", count: 1},
+		{serviceName: "_other", transactionName: "_other", count: 2},
+		{serviceName: "_other", transactionName: "_other", spanType: "_other", count: 2},
+	}, groups)
+}
+
+func TestAggregatorMaxTraces(t *testing.T) {
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:      makeErrReporter(nil),
+		Interval:    time.Minute,
+		MaxGroups:   1000,
+		TraceWindow: time.Minute,
+		MaxTraces:   1,
+	})
+	require.NoError(t, err)
+
+	// Events of buffered traces are recorded, but
+	// events of new traces are dropped once full.
+	start := time.Unix(0, 0)
+	_, err = agg.ProcessTransformables(context.Background(), []transform.Transformable{
+		makeTransaction("Jaeger", "trace1", "tx1", "", "a", start, 10),
+		makeSpan("Jaeger", "trace1", "span1", "tx1", "db", "", start, 5),
+		makeTransaction("Jaeger", "trace2", "tx2", "", "b", start, 10),
+	})
+	require.NoError(t, err)
+
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["breakdownmetrics.active_groups"] = 0
+	expectedMonitoring.Ints["breakdownmetrics.overflowed"] = 0
+	expectedMonitoring.Ints["breakdownmetrics.buffered_traces"] = 1
+	expectedMonitoring.Ints["breakdownmetrics.dropped_traces"] = 1
+
+	registry := monitoring.NewRegistry()
+	monitoring.NewFunc(registry, "breakdownmetrics", agg.CollectMonitoring)
+	assert.Equal(t, expectedMonitoring, monitoring.CollectFlatSnapshot(
+		registry,
+		monitoring.Full,
+		false, // expvar
+	))
+
+	// Traces updated within the window are not yet aggregated.
+	agg.aggregateIdleTraces(time.Now().Add(-time.Minute))
+	assert.Len(t, agg.traces, 1)
+	agg.aggregateIdleTraces(time.Now())
+	assert.Len(t, agg.traces, 0)
+	assert.Len(t, agg.active.m, 3)
+}
+
+func TestSelfTime(t *testing.T) {
+	start := time.Unix(0, 0)
+	child := func(offset, duration int) interval {
+		return interval{
+			start: start.Add(time.Duration(offset) * time.Millisecond),
+			end:   start.Add(time.Duration(offset+duration) * time.Millisecond),
+		}
+	}
+	for name, test := range map[string]struct {
+		children []interval
+		expected time.Duration
+	}{
+		"no_children":  {expected: 100 * time.Millisecond},
+		"disjoint":     {children: []interval{child(60, 10), child(0, 10)}, expected: 80 * time.Millisecond},
+		"overlapping":  {children: []interval{child(0, 20), child(10, 20)}, expected: 70 * time.Millisecond},
+		"nested":       {children: []interval{child(0, 50), child(10, 10)}, expected: 50 * time.Millisecond},
+		"adjacent":     {children: []interval{child(0, 10), child(10, 10)}, expected: 80 * time.Millisecond},
+		"clipped":      {children: []interval{child(-10, 20), child(90, 20)}, expected: 80 * time.Millisecond},
+		"outside":      {children: []interval{child(-20, 10), child(100, 10)}, expected: 100 * time.Millisecond},
+		"fully_hidden": {children: []interval{child(-10, 120)}, expected: 0},
+	} {
+		n := &node{start: start, duration: 100 * time.Millisecond, children: test.children}
+		assert.Equal(t, test.expected, n.selfTime(), name)
+	}
+}
+
+func TestIsJaegerAgent(t *testing.T) {
+	assert.True(t, isJaegerAgent("Jaeger"))
+	assert.True(t, isJaegerAgent("Jaeger/go"))
+	assert.False(t, isJaegerAgent("Jaegerish"))
+	assert.False(t, isJaegerAgent("go"))
+	assert.False(t, isJaegerAgent(""))
+}
+
+func makeTransaction(agentName, traceID, id, parentID, name string, start time.Time, durationMillis float64) *model.Transaction {
+	return &model.Transaction{
+		Metadata: model.Metadata{
+			Service: model.Service{
+				Name:  "service",
+				Agent: model.Agent{Name: agentName},
+			},
+		},
+		TraceID:   traceID,
+		ID:        id,
+		ParentID:  parentID,
+		Name:      name,
+		Type:      "request",
+		Timestamp: start,
+		Duration:  durationMillis,
+	}
+}
+
+func makeSpan(agentName, traceID, id, parentID, spanType, spanSubtype string, start time.Time, durationMillis float64) *model.Span {
+	span := &model.Span{
+		Metadata: model.Metadata{
+			Service: model.Service{
+				Name:  "service",
+				Agent: model.Agent{Name: agentName},
+			},
+		},
+		TraceID:   traceID,
+		ID:        id,
+		ParentID:  parentID,
+		Type:      spanType,
+		Timestamp: start,
+		Duration:  durationMillis,
+	}
+	if spanSubtype != "" {
+		span.Subtype = &spanSubtype
+	}
+	return span
+}
+
+func makeErrReporter(err error) publish.Reporter {
+	return func(context.Context, publish.PendingReq) error { return err }
+}
+
+func makeChanReporter(ch chan<- publish.PendingReq) publish.Reporter {
+	return func(ctx context.Context, req publish.PendingReq) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- req:
+			return nil
+		}
+	}
+}
+
+func expectPublish(t *testing.T, ch <-chan publish.PendingReq) publish.PendingReq {
+	t.Helper()
+	select {
+	case req := <-ch:
+		return req
+	case <-time.After(time.Second * 5):
+		t.Fatal("expected publish")
+	}
+	panic("unreachable")
+}
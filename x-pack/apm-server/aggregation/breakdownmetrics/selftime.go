@@ -0,0 +1,170 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package breakdownmetrics
+
+import (
+	"sort"
+	"time"
+
+	"github.com/elastic/apm-server/model"
+)
+
+// traceTree holds the transactions and spans of a trace received within
+// the trace window, for calculating the self time of each, and attributing
+// spans to the transactions in which they occurred.
+type traceTree struct {
+	nodes map[string]*node
+
+	// updated holds the time at which an event of the trace
+	// was most recently received.
+	updated time.Time
+}
+
+type node struct {
+	parentID string
+	start    time.Time
+	duration time.Duration
+
+	// group is set for transactions, identifying the transaction group
+	// in which the self time of the transaction and its spans is recorded.
+	group *transactionGroup
+
+	// spanType and spanSubtype are set for spans.
+	spanType    string
+	spanSubtype string
+
+	// children holds the intervals of the node's child spans.
+	// Child transactions are excluded, as they belong to other
+	// services, and are not included in self time calculations.
+	children []interval
+}
+
+// transactionGroup holds the aggregation key and representative count of
+// a transaction. The event's fields are copied, as events are buffered
+// after they have been published.
+type transactionGroup struct {
+	key   aggregationKey
+	count float64
+}
+
+type interval struct {
+	start, end time.Time
+}
+
+func newTraceTree() *traceTree {
+	return &traceTree{nodes: make(map[string]*node)}
+}
+
+func newTransactionNode(tx *model.Transaction) *node {
+	return &node{
+		parentID: tx.ParentID,
+		start:    tx.Timestamp,
+		duration: millisDuration(tx.Duration),
+		group: &transactionGroup{
+			key: aggregationKey{
+				serviceName:        tx.Metadata.Service.Name,
+				serviceEnvironment: tx.Metadata.Service.Environment,
+				serviceVersion:     tx.Metadata.Service.Version,
+				agentName:          tx.Metadata.Service.Agent.Name,
+				transactionName:    tx.Name,
+				transactionType:    tx.Type,
+			},
+			count: transactionCount(tx),
+		},
+	}
+}
+
+func newSpanNode(span *model.Span) *node {
+	n := &node{
+		parentID: span.ParentID,
+		start:    span.Timestamp,
+		duration: millisDuration(span.Duration),
+		spanType: span.Type,
+	}
+	if span.Subtype != nil {
+		n.spanSubtype = *span.Subtype
+	}
+	return n
+}
+
+// link records each span as a child of its parent, if the parent is
+// present. link must be called once, after all nodes have been added.
+func (t *traceTree) link() {
+	for _, n := range t.nodes {
+		if n.group != nil || n.parentID == "" {
+			continue
+		}
+		if parent, ok := t.nodes[n.parentID]; ok {
+			parent.children = append(parent.children, interval{
+				start: n.start,
+				end:   n.start.Add(n.duration),
+			})
+		}
+	}
+}
+
+// transactionGroup returns the group of the transaction in which n occurred,
+// by following parent IDs until a transaction is found. If an ancestor is
+// missing from the tree, transactionGroup returns nil.
+func (t *traceTree) transactionGroup(n *node) *transactionGroup {
+	// Bound the number of steps, in case of cycles in malformed data.
+	for i := 0; i <= len(t.nodes); i++ {
+		if n.group != nil {
+			return n.group
+		}
+		parent, ok := t.nodes[n.parentID]
+		if !ok {
+			return nil
+		}
+		n = parent
+	}
+	return nil
+}
+
+// selfTime returns the duration of n, excluding any time
+// during which at least one of its child spans was active.
+func (n *node) selfTime() time.Duration {
+	if len(n.children) == 0 {
+		return n.duration
+	}
+	end := n.start.Add(n.duration)
+	sort.Slice(n.children, func(i, j int) bool {
+		return n.children[i].start.Before(n.children[j].start)
+	})
+
+	var childTime time.Duration
+	var current interval
+	var merging bool
+	for _, child := range n.children {
+		// Clip child intervals to the parent's interval.
+		if child.start.Before(n.start) {
+			child.start = n.start
+		}
+		if child.end.After(end) {
+			child.end = end
+		}
+		if !child.end.After(child.start) {
+			continue
+		}
+		if merging && !child.start.After(current.end) {
+			if child.end.After(current.end) {
+				current.end = child.end
+			}
+			continue
+		}
+		childTime += current.end.Sub(current.start)
+		current, merging = child, true
+	}
+	childTime += current.end.Sub(current.start)
+
+	if childTime >= n.duration {
+		return 0
+	}
+	return n.duration - childTime
+}
+
+func millisDuration(ms float64) time.Duration {
+	return time.Duration(ms * float64(time.Millisecond))
+}
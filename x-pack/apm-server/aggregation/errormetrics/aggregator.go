@@ -0,0 +1,339 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package errormetrics
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	logs "github.com/elastic/apm-server/log"
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+const (
+	// metricsetName is the name of the error metricsets.
+	metricsetName = "error_summary"
+
+	// tooManyGroupsLoggerRateLimit is the maximum frequency at which
+	// "too many groups" log messages are logged.
+	tooManyGroupsLoggerRateLimit = time.Minute
+
+	// overflowBucketName is the service name recorded for errors
+	// which are aggregated into the overflow group.
+	overflowBucketName = "_other"
+)
+
+// AggregatorConfig holds configuration for creating an Aggregator.
+type AggregatorConfig struct {
+	// Report is a publish.Reporter for reporting metrics documents.
+	Report publish.Reporter
+
+	// MaxGroups is the maximum number of distinct error group metrics
+	// to store within an aggregation period. Once this number of groups
+	// is reached, errors with new aggregation keys are counted in an
+	// additional overflow group, with the service name "_other".
+	MaxGroups int
+
+	// Interval is the interval between publishing of aggregated metrics.
+	Interval time.Duration
+
+	// Logger is the logger for logging metrics aggregation/publishing.
+	//
+	// If Logger is nil, a new logger will be constructed.
+	Logger *logp.Logger
+}
+
+// Validate validates the aggregator config.
+func (config AggregatorConfig) Validate() error {
+	if config.Report == nil {
+		return errors.New("Report unspecified")
+	}
+	if config.MaxGroups <= 0 {
+		return errors.New("MaxGroups unspecified or negative")
+	}
+	if config.Interval <= 0 {
+		return errors.New("Interval unspecified or negative")
+	}
+	return nil
+}
+
+// Aggregator counts errors by service and error group, periodically
+// publishing error count metrics.
+type Aggregator struct {
+	stopMu   sync.Mutex
+	stopping chan struct{}
+	stopped  chan struct{}
+
+	config              AggregatorConfig
+	metrics             aggregatorMetrics
+	tooManyGroupsLogger *logp.Logger
+
+	mu               sync.RWMutex
+	active, inactive *metricsBuffer
+}
+
+type aggregatorMetrics struct {
+	overflowed int64
+}
+
+// NewAggregator returns a new Aggregator with the given config.
+func NewAggregator(config AggregatorConfig) (*Aggregator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid aggregator config")
+	}
+	if config.Logger == nil {
+		config.Logger = logp.NewLogger(logs.ErrorMetrics)
+	}
+	return &Aggregator{
+		stopping:            make(chan struct{}),
+		stopped:             make(chan struct{}),
+		config:              config,
+		tooManyGroupsLogger: config.Logger.WithOptions(logs.WithRateLimit(tooManyGroupsLoggerRateLimit)),
+		active:              newMetricsBuffer(config.MaxGroups),
+		inactive:            newMetricsBuffer(config.MaxGroups),
+	}, nil
+}
+
+// Run runs the Aggregator, periodically publishing and clearing aggregated
+// metrics. Run returns when either a fatal error occurs, or the Aggregator's
+// Stop method is invoked.
+func (a *Aggregator) Run() error {
+	ticker := time.NewTicker(a.config.Interval)
+	defer ticker.Stop()
+	defer func() {
+		a.stopMu.Lock()
+		defer a.stopMu.Unlock()
+		select {
+		case <-a.stopped:
+		default:
+			close(a.stopped)
+		}
+	}()
+	var stop bool
+	for !stop {
+		select {
+		case <-a.stopping:
+			stop = true
+		case <-ticker.C:
+		}
+		if err := a.publish(context.Background()); err != nil {
+			a.config.Logger.With(logp.Error(err)).Warnf(
+				"publishing error metrics failed: %s", err,
+			)
+		}
+	}
+	return nil
+}
+
+// Stop stops the Aggregator if it is running, waiting for it to flush any
+// aggregated metrics and return, or for the context to be cancelled.
+//
+// After Stop has been called the aggregator cannot be reused, as the Run
+// method will always return immediately.
+func (a *Aggregator) Stop(ctx context.Context) error {
+	a.stopMu.Lock()
+	select {
+	case <-a.stopped:
+	case <-a.stopping:
+		// Already stopping/stopped.
+	default:
+		close(a.stopping)
+	}
+	a.stopMu.Unlock()
+
+	select {
+	case <-a.stopped:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+// CollectMonitoring may be called to collect monitoring metrics from the
+// aggregation. It is intended to be used with libbeat/monitoring.NewFunc.
+//
+// The metrics should be added to the "apm-server.aggregation.errormetrics" registry.
+func (a *Aggregator) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor) {
+	V.OnRegistryStart()
+	defer V.OnRegistryFinished()
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	m := a.active
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	monitoring.ReportInt(V, "active_groups", int64(len(m.m)))
+	monitoring.ReportInt(V, "overflowed", atomic.LoadInt64(&a.metrics.overflowed))
+}
+
+func (a *Aggregator) publish(ctx context.Context) error {
+	// We hold a.mu only long enough to swap the metrics. This will
+	// be blocked by metrics updates, which is OK, as we prefer not
+	// to block metrics updaters. After the lock is released nothing
+	// will be accessing a.inactive.
+	a.mu.Lock()
+	a.active, a.inactive = a.inactive, a.active
+	a.mu.Unlock()
+
+	size := len(a.inactive.m)
+	if size == 0 {
+		a.config.Logger.Debugf("no error metrics to publish")
+		return nil
+	}
+
+	now := time.Now()
+	metricsets := make([]transform.Transformable, 0, size)
+	for key, count := range a.inactive.m {
+		metricset := makeMetricset(now, key, count, a.config.Interval.Milliseconds())
+		metricsets = append(metricsets, &metricset)
+		delete(a.inactive.m, key)
+	}
+	a.config.Logger.Debugf("publishing %d metricsets", len(metricsets))
+	return a.config.Report(ctx, publish.PendingReq{
+		Transformables: metricsets,
+		Trace:          true,
+	})
+}
+
+// ProcessTransformables aggregates all errors contained in "in",
+// returning the input unmodified.
+//
+// This method is expected to be used immediately prior to publishing
+// the events.
+func (a *Aggregator) ProcessTransformables(ctx context.Context, in []transform.Transformable) ([]transform.Transformable, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, tf := range in {
+		if event, ok := tf.(*model.Error); ok {
+			a.processError(event)
+		}
+	}
+	return in, nil
+}
+
+func (a *Aggregator) processError(event *model.Error) {
+	if a.active.storeOrUpdate(a.makeAggregationKey(event)) {
+		return
+	}
+	a.tooManyGroupsLogger.Warn(`
+Error group limit reached, counting excess error groups in "_other".
+This is typically caused by high cardinality exception types, or by
+stack traces which differ for each occurrence of an error.`[1:],
+	)
+	atomic.AddInt64(&a.metrics.overflowed, 1)
+}
+
+// makeAggregationKey returns the aggregation key for event.
+//
+// The grouping key is computed from the error's stack frames as received,
+// before they are source mapped or deobfuscated when the error is stored.
+// Grouping keys are therefore stable for a given build of a service, but
+// may differ from the error.grouping_key of the stored error documents.
+func (a *Aggregator) makeAggregationKey(event *model.Error) aggregationKey {
+	key := aggregationKey{
+		serviceName:        event.Metadata.Service.Name,
+		serviceEnvironment: event.Metadata.Service.Environment,
+		groupingKey:        event.GroupingKey(),
+	}
+	if exception := event.Exception; exception != nil {
+		if exception.Type != nil {
+			key.exceptionType = *exception.Type
+		}
+		if exception.Handled != nil {
+			key.exceptionHandledKnown = true
+			key.exceptionHandled = *exception.Handled
+		}
+	}
+	return key
+}
+
+type metricsBuffer struct {
+	maxSize int
+
+	mu sync.RWMutex
+	m  map[aggregationKey]int64
+}
+
+func newMetricsBuffer(maxSize int) *metricsBuffer {
+	return &metricsBuffer{
+		maxSize: maxSize,
+		m:       make(map[aggregationKey]int64),
+	}
+}
+
+// storeOrUpdate increments the count for key, returning false if the
+// count of an overflow group was instead incremented due to the group
+// limit being reached.
+func (mb *metricsBuffer) storeOrUpdate(key aggregationKey) bool {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	var overflowed bool
+	if _, ok := mb.m[key]; !ok && len(mb.m) >= mb.maxSize {
+		// The overflow group may exceed maxSize by one.
+		key = aggregationKey{serviceName: overflowBucketName}
+		overflowed = true
+	}
+	mb.m[key]++
+	return !overflowed
+}
+
+type aggregationKey struct {
+	serviceName        string
+	serviceEnvironment string
+	groupingKey        string
+	exceptionType      string
+
+	// exceptionHandledKnown records whether the exception's handled
+	// flag was specified, in which case it is held in exceptionHandled.
+	exceptionHandledKnown bool
+	exceptionHandled      bool
+}
+
+func makeMetricset(timestamp time.Time, key aggregationKey, count int64, interval int64) model.Metricset {
+	out := model.Metricset{
+		Name:      metricsetName,
+		Timestamp: timestamp,
+		Metadata: model.Metadata{
+			Service: model.Service{
+				Name:        key.serviceName,
+				Environment: key.serviceEnvironment,
+			},
+		},
+		Error: model.MetricsetError{
+			GroupingKey:   key.groupingKey,
+			ExceptionType: key.exceptionType,
+		},
+		Samples: []model.Sample{{
+			Name:  "error.count",
+			Value: float64(count),
+		}},
+	}
+	if key.exceptionHandledKnown {
+		handled := key.exceptionHandled
+		out.Error.ExceptionHandled = &handled
+	}
+	if interval > 0 {
+		// Only set metricset.period for a positive interval.
+		//
+		// An interval of zero means the metricset is computed
+		// from an instantaneous value, meaning there is no
+		// aggregation period.
+		out.Samples = append(out.Samples, model.Sample{
+			Name:  "metricset.period",
+			Value: float64(interval),
+		})
+	}
+	return out
+}
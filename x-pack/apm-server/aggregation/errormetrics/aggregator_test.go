@@ -0,0 +1,264 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package errormetrics
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+func TestNewAggregatorConfigInvalid(t *testing.T) {
+	report := makeErrReporter(nil)
+
+	type test struct {
+		config AggregatorConfig
+		err    string
+	}
+
+	for _, test := range []test{{
+		config: AggregatorConfig{},
+		err:    "Report unspecified",
+	}, {
+		config: AggregatorConfig{
+			Report: report,
+		},
+		err: "MaxGroups unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+		},
+		err: "Interval unspecified or negative",
+	}} {
+		agg, err := NewAggregator(test.config)
+		require.Error(t, err)
+		require.Nil(t, agg)
+		assert.EqualError(t, err, "invalid aggregator config: "+test.err)
+	}
+}
+
+func TestAggregatorRun(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:    makeChanReporter(reqs),
+		Interval:  10 * time.Millisecond,
+		MaxGroups: 1000,
+	})
+	require.NoError(t, err)
+
+	inputs := []*model.Error{
+		makeError("service-A", "prod", "IOException", newBool(true)),
+		makeError("service-A", "prod", "IOException", newBool(true)),
+		makeError("service-A", "prod", "IOException", newBool(false)),
+		makeError("service-A", "prod", "IOException", nil),
+		makeError("service-A", "staging", "IOException", newBool(true)),
+		makeError("service-A", "prod", "NullPointerException", newBool(false)),
+		makeError("service-B", "prod", "", nil),
+	}
+
+	var wg sync.WaitGroup
+	for _, in := range inputs {
+		wg.Add(1)
+		go func(in *model.Error) {
+			defer wg.Done()
+			transformables := []transform.Transformable{in}
+			for i := 0; i < 100; i++ {
+				out, err := agg.ProcessTransformables(context.Background(), transformables)
+				require.NoError(t, err)
+				assert.Equal(t, transformables, out)
+			}
+		}(in)
+	}
+	wg.Wait()
+
+	// Start the aggregator after processing to ensure metrics are aggregated deterministically.
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	req := expectPublish(t, reqs)
+	metricsets := make([]*model.Metricset, len(req.Transformables))
+	for i, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		require.NotZero(t, ms.Timestamp)
+		ms.Timestamp = time.Time{}
+		metricsets[i] = ms
+	}
+
+	makeExpected := func(in *model.Error, count float64) *model.Metricset {
+		ms := &model.Metricset{
+			Name:     "error_summary",
+			Metadata: model.Metadata{Service: model.Service{Name: in.Metadata.Service.Name, Environment: in.Metadata.Service.Environment}},
+			Error:    model.MetricsetError{GroupingKey: in.GroupingKey()},
+			Samples: []model.Sample{
+				{Name: "error.count", Value: count},
+				{Name: "metricset.period", Value: 10},
+			},
+		}
+		if in.Exception != nil {
+			ms.Error.ExceptionType = *in.Exception.Type
+			ms.Error.ExceptionHandled = in.Exception.Handled
+		}
+		return ms
+	}
+	assert.ElementsMatch(t, []*model.Metricset{
+		makeExpected(inputs[0], 200),
+		makeExpected(inputs[2], 100),
+		makeExpected(inputs[3], 100),
+		makeExpected(inputs[4], 100),
+		makeExpected(inputs[5], 100),
+		makeExpected(inputs[6], 100),
+	}, metricsets)
+
+	select {
+	case <-reqs:
+		t.Fatal("unexpected publish")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestAggregatorOverflow(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:    makeChanReporter(reqs),
+		Interval:  10 * time.Millisecond,
+		MaxGroups: 2,
+	})
+	require.NoError(t, err)
+
+	// The first two error groups will be counted individually, as we
+	// have configured the aggregator with a maximum of two groups.
+	// Subsequent groups are counted in the overflow group.
+	var input []transform.Transformable
+	for i := 0; i < 10; i++ {
+		input = append(input, makeError("service", "", "Exception1", nil))
+		input = append(input, makeError("service", "", "Exception2", nil))
+	}
+	for i := 0; i < 2; i++ {
+		input = append(input, makeError("service", "", "Exception3", newBool(true)))
+		input = append(input, makeError("other", "", "Exception1", nil))
+	}
+	output, err := agg.ProcessTransformables(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, input, output)
+
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["errormetrics.active_groups"] = 3
+	expectedMonitoring.Ints["errormetrics.overflowed"] = 4
+
+	registry := monitoring.NewRegistry()
+	monitoring.NewFunc(registry, "errormetrics", agg.CollectMonitoring)
+	assert.Equal(t, expectedMonitoring, monitoring.CollectFlatSnapshot(
+		registry,
+		monitoring.Full,
+		false, // expvar
+	))
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	type group struct {
+		serviceName   string
+		exceptionType string
+		count         float64
+	}
+	var groups []group
+	req := expectPublish(t, reqs)
+	for _, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		groups = append(groups, group{
+			serviceName:   ms.Metadata.Service.Name,
+			exceptionType: ms.Error.ExceptionType,
+			count:         ms.Samples[0].Value,
+		})
+	}
+	assert.ElementsMatch(t, []group{
+		{serviceName: "service", exceptionType: "Exception1", count: 10},
+		{serviceName: "service", exceptionType: "Exception2", count: 10},
+		{serviceName: "_other", count: 4},
+	}, groups)
+}
+
+func TestAggregatorGroupingKeyTransformedFrames(t *testing.T) {
+	withStacktrace := func(e *model.Error, rum bool, filename string) *model.Error {
+		e.RUM = rum
+		e.Exception.Stacktrace = model.Stacktrace{{Filename: &filename}}
+		return e
+	}
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:    makeErrReporter(nil),
+		Interval:  time.Minute,
+		MaxGroups: 1,
+	})
+	require.NoError(t, err)
+	groupingKey := func(event *model.Error) string {
+		return agg.makeAggregationKey(event).groupingKey
+	}
+
+	// Errors whose stack frames may be source mapped or deobfuscated are
+	// keyed on the grouping key of the frames as received, so errors with
+	// different frames are counted separately.
+	for _, rum := range []bool{false, true} {
+		bundleError := withStacktrace(makeError("service", "", "TypeError", nil), rum, "bundle.js")
+		otherError := withStacktrace(makeError("service", "", "TypeError", nil), rum, "other.js")
+		assert.NotEmpty(t, groupingKey(bundleError))
+		assert.Equal(t, bundleError.GroupingKey(), groupingKey(bundleError))
+		assert.NotEqual(t, groupingKey(bundleError), groupingKey(otherError))
+	}
+}
+
+func makeError(serviceName, environment, exceptionType string, handled *bool) *model.Error {
+	event := &model.Error{
+		Metadata: model.Metadata{Service: model.Service{
+			Name:        serviceName,
+			Environment: environment,
+		}},
+	}
+	if exceptionType != "" {
+		event.Exception = &model.Exception{Type: &exceptionType, Handled: handled}
+	} else {
+		event.Log = &model.Log{Message: "log message"}
+	}
+	return event
+}
+
+func makeErrReporter(err error) publish.Reporter {
+	return func(context.Context, publish.PendingReq) error { return err }
+}
+
+func makeChanReporter(ch chan<- publish.PendingReq) publish.Reporter {
+	return func(ctx context.Context, req publish.PendingReq) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- req:
+			return nil
+		}
+	}
+}
+
+func expectPublish(t *testing.T, ch <-chan publish.PendingReq) publish.PendingReq {
+	t.Helper()
+	select {
+	case req := <-ch:
+		return req
+	case <-time.After(time.Second * 5):
+		t.Fatal("expected publish")
+	}
+	panic("unreachable")
+}
+
+func newBool(v bool) *bool {
+	return &v
+}
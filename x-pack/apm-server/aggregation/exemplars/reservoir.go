@@ -0,0 +1,102 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Package exemplars provides a reservoir for sampling exemplar events
+// from which metrics are aggregated, for attaching to metricsets.
+package exemplars
+
+import (
+	"math/bits"
+	"math/rand"
+	"sort"
+	"time"
+
+	"github.com/elastic/apm-server/model"
+)
+
+// randFloat64 returns a pseudo-random number in [0.0,1.0).
+// It is a variable for testing.
+var randFloat64 = rand.Float64
+
+// Reservoir holds a small sample of exemplars for an aggregation group,
+// keeping at most one exemplar for each range of durations. The ranges
+// are powers of two in microseconds, so a group with durations ranging
+// from 1ms to 1s keeps at most 11 exemplars.
+//
+// Exemplars are chosen by weighted reservoir sampling, so each event in
+// a range is equally likely to be its exemplar, in proportion to the
+// number of events it represents.
+//
+// Reservoir is not safe for concurrent use.
+type Reservoir struct {
+	// slots holds the exemplars, ordered by range.
+	slots []slot
+}
+
+type slot struct {
+	bucket   int
+	weight   float64
+	exemplar model.MetricsetExemplar
+}
+
+// Offer offers an exemplar representing weight events to the reservoir.
+func (r *Reservoir) Offer(exemplar model.MetricsetExemplar, weight float64) {
+	r.offer(slot{
+		bucket:   durationBucket(exemplar.Duration),
+		weight:   weight,
+		exemplar: exemplar,
+	})
+}
+
+// Merge merges the exemplars of other into r.
+func (r *Reservoir) Merge(other *Reservoir) {
+	for _, s := range other.slots {
+		r.offer(s)
+	}
+}
+
+func (r *Reservoir) offer(s slot) {
+	if s.weight <= 0 {
+		return
+	}
+	i := sort.Search(len(r.slots), func(i int) bool {
+		return r.slots[i].bucket >= s.bucket
+	})
+	if i == len(r.slots) || r.slots[i].bucket != s.bucket {
+		r.slots = append(r.slots, slot{})
+		copy(r.slots[i+1:], r.slots[i:])
+		r.slots[i] = s
+		return
+	}
+	existing := &r.slots[i]
+	existing.weight += s.weight
+	if randFloat64() < s.weight/existing.weight {
+		existing.exemplar = s.exemplar
+	}
+}
+
+// Exemplars returns the exemplars in the reservoir, ordered by duration
+// range. If the reservoir is empty, Exemplars returns nil.
+func (r *Reservoir) Exemplars() []model.MetricsetExemplar {
+	if len(r.slots) == 0 {
+		return nil
+	}
+	out := make([]model.MetricsetExemplar, len(r.slots))
+	for i, s := range r.slots {
+		out[i] = s.exemplar
+	}
+	return out
+}
+
+// Reset removes all exemplars from the reservoir, retaining its storage.
+func (r *Reservoir) Reset() {
+	r.slots = r.slots[:0]
+}
+
+func durationBucket(d time.Duration) int {
+	if d <= 0 {
+		return 0
+	}
+	return bits.Len64(uint64(d.Microseconds()))
+}
@@ -0,0 +1,70 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package exemplars
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/elastic/apm-server/model"
+)
+
+func TestReservoirRanges(t *testing.T) {
+	var r Reservoir
+	assert.Nil(t, r.Exemplars())
+
+	r.Offer(exemplar("a", time.Second), 1)
+	r.Offer(exemplar("b", time.Millisecond), 1)
+	r.Offer(exemplar("c", 0), 1)
+	r.Offer(exemplar("d", 100*time.Millisecond), 1)
+	// Non-positive weights are ignored.
+	r.Offer(exemplar("e", time.Hour), 0)
+
+	assert.Equal(t, []model.MetricsetExemplar{
+		exemplar("c", 0),
+		exemplar("b", time.Millisecond),
+		exemplar("d", 100*time.Millisecond),
+		exemplar("a", time.Second),
+	}, r.Exemplars())
+
+	r.Reset()
+	assert.Nil(t, r.Exemplars())
+}
+
+func TestReservoirSampling(t *testing.T) {
+	defer func(f func() float64) { randFloat64 = f }(randFloat64)
+
+	// "b" is in the same range as "a", and replaces it with probability
+	// 3/4, in proportion to the weight of the events it represents.
+	for random, expected := range map[float64]string{0.74: "b", 0.75: "a"} {
+		randFloat64 = func() float64 { return random }
+		var r Reservoir
+		r.Offer(exemplar("a", 1000*time.Microsecond), 1)
+		r.Offer(exemplar("b", 1010*time.Microsecond), 3)
+		exemplars := r.Exemplars()
+		assert.Len(t, exemplars, 1)
+		assert.Equal(t, expected, exemplars[0].TraceID)
+	}
+
+	// Merged reservoirs are sampled in proportion to the weights of all
+	// events offered to them: "c" replaces "a" with probability 4/(4+4).
+	var r, other Reservoir
+	r.Offer(exemplar("a", 1000*time.Microsecond), 4)
+	other.Offer(exemplar("c", 1020*time.Microsecond), 4)
+	other.Offer(exemplar("d", time.Second), 1)
+	randFloat64 = func() float64 { return 0.49 }
+	r.Merge(&other)
+	assert.Equal(t, []model.MetricsetExemplar{
+		exemplar("c", 1020*time.Microsecond),
+		exemplar("d", time.Second),
+	}, r.Exemplars())
+	assert.Equal(t, float64(8), r.slots[0].weight)
+}
+
+func exemplar(traceID string, duration time.Duration) model.MetricsetExemplar {
+	return model.MetricsetExemplar{TraceID: traceID, TransactionID: traceID, Duration: duration}
+}
@@ -0,0 +1,525 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package servicemap
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	logs "github.com/elastic/apm-server/log"
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+const (
+	// metricsetName is the name of the service map edge metricsets.
+	metricsetName = "service_map_edge"
+
+	outcomeFailure = "failure"
+
+	// lookupFullLoggerRateLimit is the maximum frequency at which
+	// "lookup full" log messages are logged.
+	lookupFullLoggerRateLimit = time.Minute
+
+	// lookupExpiryFraction is the fraction of LookupTTL between removals
+	// of expired lookup entries, bounding how long entries outlive it.
+	lookupExpiryFraction = 4
+
+	// tooManyGroupsLoggerRateLimit is the maximum frequency at which
+	// "too many groups" log messages are logged.
+	tooManyGroupsLoggerRateLimit = time.Minute
+
+	// overflowBucketName is the source service name recorded for
+	// edges which are aggregated into the overflow group.
+	overflowBucketName = "_other"
+)
+
+// AggregatorConfig holds configuration for creating an Aggregator.
+type AggregatorConfig struct {
+	// Report is a publish.Reporter for reporting metrics documents.
+	Report publish.Reporter
+
+	// MaxGroups is the maximum number of distinct edges to store within
+	// an aggregation period. Once this number of edges is reached, new
+	// edges are aggregated into an additional overflow edge, with the
+	// source service name "_other".
+	MaxGroups int
+
+	// Interval is the interval between publishing of aggregated metrics.
+	Interval time.Duration
+
+	// LookupTTL is the amount of time for which the services of exit spans
+	// and transactions are remembered, for resolving the callers of
+	// transactions from their parent IDs. Transactions whose parent has not
+	// been observed within LookupTTL do not contribute to any service-to-service
+	// edge.
+	LookupTTL time.Duration
+
+	// MaxLookupEntries is the maximum number of exit spans, transactions,
+	// and transactions awaiting their parent, to hold in the lookup. Once
+	// the lookup is full, new entries are dropped until existing entries
+	// expire, and the edges depending on them are not recorded.
+	MaxLookupEntries int
+
+	// Logger is the logger for logging metrics aggregation/publishing.
+	//
+	// If Logger is nil, a new logger will be constructed.
+	Logger *logp.Logger
+}
+
+// Validate validates the aggregator config.
+func (config AggregatorConfig) Validate() error {
+	if config.Report == nil {
+		return errors.New("Report unspecified")
+	}
+	if config.MaxGroups <= 0 {
+		return errors.New("MaxGroups unspecified or negative")
+	}
+	if config.Interval <= 0 {
+		return errors.New("Interval unspecified or negative")
+	}
+	if config.LookupTTL <= 0 {
+		return errors.New("LookupTTL unspecified or negative")
+	}
+	if config.MaxLookupEntries <= 0 {
+		return errors.New("MaxLookupEntries unspecified or negative")
+	}
+	return nil
+}
+
+// Aggregator aggregates service map edges, periodically publishing edge
+// metrics.
+//
+// Two kinds of edges are aggregated. Service edges lead from a service to
+// another instrumented service, and are derived from transactions whose parent
+// is a span or transaction of another service; they record the transactions'
+// durations and outcomes, and the destination resource of the parent span, if
+// any. Exit edges lead from a service to the destination resource of its exit
+// spans, and record the spans' durations and outcomes.
+//
+// Each call is recorded in one edge only: exit spans are recorded in an exit
+// edge only if no service edge is resolved from them within LookupTTL, and so
+// exit edges are published up to LookupTTL after the spans are received.
+type Aggregator struct {
+	stopMu   sync.Mutex
+	stopping chan struct{}
+	stopped  chan struct{}
+
+	config              AggregatorConfig
+	metrics             aggregatorMetrics
+	lookup              *traceLookup
+	lookupFullLogger    *logp.Logger
+	tooManyGroupsLogger *logp.Logger
+
+	mu               sync.RWMutex
+	active, inactive *metricsBuffer
+}
+
+type aggregatorMetrics struct {
+	overflowed    int64
+	lookupDropped int64
+}
+
+// NewAggregator returns a new Aggregator with the given config.
+func NewAggregator(config AggregatorConfig) (*Aggregator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid aggregator config")
+	}
+	if config.Logger == nil {
+		config.Logger = logp.NewLogger(logs.ServiceMap)
+	}
+	return &Aggregator{
+		stopping:            make(chan struct{}),
+		stopped:             make(chan struct{}),
+		config:              config,
+		lookup:              newTraceLookup(config.LookupTTL, config.MaxLookupEntries),
+		lookupFullLogger:    config.Logger.WithOptions(logs.WithRateLimit(lookupFullLoggerRateLimit)),
+		tooManyGroupsLogger: config.Logger.WithOptions(logs.WithRateLimit(tooManyGroupsLoggerRateLimit)),
+		active:              newMetricsBuffer(config.MaxGroups),
+		inactive:            newMetricsBuffer(config.MaxGroups),
+	}, nil
+}
+
+// Run runs the Aggregator, periodically publishing and clearing aggregated
+// metrics. Run returns when either a fatal error occurs, or the Aggregator's
+// Stop method is invoked.
+func (a *Aggregator) Run() error {
+	ticker := time.NewTicker(a.config.Interval)
+	defer ticker.Stop()
+	// Expired lookup entries are removed independently of publishing,
+	// so they do not outlive LookupTTL by up to Interval.
+	expiryTicker := time.NewTicker(a.config.LookupTTL / lookupExpiryFraction)
+	defer expiryTicker.Stop()
+	defer func() {
+		a.stopMu.Lock()
+		defer a.stopMu.Unlock()
+		select {
+		case <-a.stopped:
+		default:
+			close(a.stopped)
+		}
+	}()
+	var stop bool
+	for !stop {
+		select {
+		case <-a.stopping:
+			// Record the exit edges of all unresolved exit spans,
+			// as they would otherwise be lost.
+			a.removeExpired(time.Now().Add(a.config.LookupTTL))
+			stop = true
+		case <-ticker.C:
+		case now := <-expiryTicker.C:
+			a.removeExpired(now)
+			continue
+		}
+		if err := a.publish(context.Background()); err != nil {
+			a.config.Logger.With(logp.Error(err)).Warnf(
+				"publishing service map metrics failed: %s", err,
+			)
+		}
+	}
+	return nil
+}
+
+// Stop stops the Aggregator if it is running, waiting for it to flush any
+// aggregated metrics and return, or for the context to be cancelled.
+//
+// After Stop has been called the aggregator cannot be reused, as the Run
+// method will always return immediately.
+func (a *Aggregator) Stop(ctx context.Context) error {
+	a.stopMu.Lock()
+	select {
+	case <-a.stopped:
+	case <-a.stopping:
+		// Already stopping/stopped.
+	default:
+		close(a.stopping)
+	}
+	a.stopMu.Unlock()
+
+	select {
+	case <-a.stopped:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+// CollectMonitoring may be called to collect monitoring metrics from the
+// aggregation. It is intended to be used with libbeat/monitoring.NewFunc.
+//
+// The metrics should be added to the "apm-server.aggregation.servicemap" registry.
+func (a *Aggregator) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor) {
+	V.OnRegistryStart()
+	defer V.OnRegistryFinished()
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	m := a.active
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	monitoring.ReportInt(V, "active_groups", int64(len(m.m)))
+	monitoring.ReportInt(V, "overflowed", atomic.LoadInt64(&a.metrics.overflowed))
+	monitoring.ReportInt(V, "lookup_entries", int64(a.lookup.len()))
+	monitoring.ReportInt(V, "lookup_dropped", atomic.LoadInt64(&a.metrics.lookupDropped))
+}
+
+func (a *Aggregator) publish(ctx context.Context) error {
+	// We hold a.mu only long enough to swap the metrics. This will
+	// be blocked by metrics updates, which is OK, as we prefer not
+	// to block metrics updaters. After the lock is released nothing
+	// will be accessing a.inactive.
+	a.mu.Lock()
+	a.active, a.inactive = a.inactive, a.active
+	a.mu.Unlock()
+
+	size := len(a.inactive.m)
+	if size == 0 {
+		a.config.Logger.Debugf("no service map metrics to publish")
+		return nil
+	}
+
+	now := time.Now()
+	metricsets := make([]transform.Transformable, 0, size)
+	for key, metrics := range a.inactive.m {
+		metricset := makeMetricset(now, key, metrics, a.config.Interval.Milliseconds())
+		metricsets = append(metricsets, &metricset)
+		delete(a.inactive.m, key)
+	}
+	a.config.Logger.Debugf("publishing %d metricsets", len(metricsets))
+	return a.config.Report(ctx, publish.PendingReq{
+		Transformables: metricsets,
+		Trace:          true,
+	})
+}
+
+// ProcessTransformables aggregates all spans and transactions contained
+// in "in", returning the input unmodified.
+//
+// This method is expected to be used immediately prior to publishing
+// the events.
+func (a *Aggregator) ProcessTransformables(ctx context.Context, in []transform.Transformable) ([]transform.Transformable, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	now := time.Now()
+	for _, tf := range in {
+		switch event := tf.(type) {
+		case *model.Span:
+			a.processSpan(event, now)
+		case *model.Transaction:
+			a.processTransaction(event, now)
+		}
+	}
+	return in, nil
+}
+
+func (a *Aggregator) processSpan(span *model.Span, now time.Time) {
+	service := serviceKey{
+		name:        span.Metadata.Service.Name,
+		environment: span.Metadata.Service.Environment,
+	}
+	if span.DestinationService == nil {
+		// Only exit spans may be the parent of another service's
+		// transaction, so other spans are not recorded in the lookup.
+		return
+	}
+	var resource string
+	if span.DestinationService.Resource != nil {
+		resource = *span.DestinationService.Resource
+	}
+	node := lookupNode{service: service, resource: resource, observed: now}
+	if resource != "" && span.RepresentativeCount > 0 {
+		// RepresentativeCount is zero when the sample rate is unknown.
+		// We cannot calculate accurate edge metrics without the sample
+		// rate, so we don't calculate any at all in this case.
+		metrics := makeEdgeMetrics(span.RepresentativeCount, span.Duration, span.Outcome)
+		node.exit = &metrics
+	}
+	if !a.addNode(span.TraceID, span.ID, node) && node.exit != nil {
+		// The span cannot be resolved later, so record its exit edge now.
+		a.storeOrUpdate(exitEdgeKey(node), *node.exit)
+	}
+}
+
+func (a *Aggregator) processTransaction(tx *model.Transaction, now time.Time) {
+	service := serviceKey{
+		name:        tx.Metadata.Service.Name,
+		environment: tx.Metadata.Service.Environment,
+	}
+	a.addNode(tx.TraceID, tx.ID, lookupNode{service: service, observed: now})
+
+	if tx.ParentID == "" || tx.TraceID == "" || tx.RepresentativeCount <= 0 {
+		return
+	}
+	child := pendingChild{
+		service:  service,
+		metrics:  makeEdgeMetrics(tx.RepresentativeCount, tx.Duration, tx.Outcome),
+		observed: now,
+	}
+	parent, ok, dropped := a.lookup.resolveParent(lookupKey{traceID: tx.TraceID, id: tx.ParentID}, child)
+	if dropped {
+		a.lookupFull()
+	}
+	if ok {
+		a.resolveEdge(parent, child)
+	}
+}
+
+// addNode records a span or transaction in the lookup, resolving
+// the edges for any transactions awaiting it as their parent.
+//
+// addNode returns false if the node could not be recorded, and no
+// service edge was resolved from it.
+func (a *Aggregator) addNode(traceID, id string, node lookupNode) bool {
+	if traceID == "" || id == "" {
+		return false
+	}
+	children, dropped := a.lookup.addNode(lookupKey{traceID: traceID, id: id}, node)
+	if dropped {
+		a.lookupFull()
+	}
+	var resolved bool
+	for _, child := range children {
+		if a.resolveEdge(node, child) {
+			resolved = true
+		}
+	}
+	return !dropped || resolved
+}
+
+// removeExpired removes expired entries from the lookup, recording the
+// exit edges of expired exit spans from which no service edge was resolved.
+func (a *Aggregator) removeExpired(now time.Time) {
+	exits := a.lookup.removeExpired(now)
+	if len(exits) == 0 {
+		return
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, node := range exits {
+		a.storeOrUpdate(exitEdgeKey(node), *node.exit)
+	}
+}
+
+// lookupFull records that an entry could not be added to the lookup.
+func (a *Aggregator) lookupFull() {
+	a.lookupFullLogger.Warn(`
+Service map lookup is full, some service edges will not be recorded.
+Consider increasing max_lookup_entries, or decreasing lookup_ttl.`[1:],
+	)
+	atomic.AddInt64(&a.metrics.lookupDropped, 1)
+}
+
+// resolveEdge records the service edge from parent to child, returning
+// false if child is not a transaction of another service.
+func (a *Aggregator) resolveEdge(parent lookupNode, child pendingChild) bool {
+	if !parent.callerOf(child) {
+		// Not a service-to-service call.
+		return false
+	}
+	key := edgeKey{source: parent.service, resource: parent.resource, target: child.service}
+	a.storeOrUpdate(key, child.metrics)
+	return true
+}
+
+// storeOrUpdate records metrics for the edge identified by key,
+// logging if they were instead recorded in the overflow edge.
+func (a *Aggregator) storeOrUpdate(key edgeKey, metrics edgeMetrics) {
+	if a.active.storeOrUpdate(key, metrics) {
+		return
+	}
+	a.tooManyGroupsLogger.Warn(`
+Service map edge limit reached, aggregating excess edges into "_other".
+This is typically caused by high cardinality destination service resources,
+e.g. by including unique identifiers in the resource.`[1:],
+	)
+	atomic.AddInt64(&a.metrics.overflowed, 1)
+}
+
+type metricsBuffer struct {
+	maxSize int
+
+	mu sync.RWMutex
+	m  map[edgeKey]edgeMetrics
+}
+
+func newMetricsBuffer(maxSize int) *metricsBuffer {
+	return &metricsBuffer{
+		maxSize: maxSize,
+		m:       make(map[edgeKey]edgeMetrics),
+	}
+}
+
+// storeOrUpdate records value in the metrics for key, returning false
+// if it was instead recorded in an overflow edge due to the edge limit
+// being reached.
+func (mb *metricsBuffer) storeOrUpdate(key edgeKey, value edgeMetrics) bool {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	old, ok := mb.m[key]
+	var overflowed bool
+	if !ok && len(mb.m) >= mb.maxSize {
+		// The overflow edge may exceed maxSize by one.
+		key = edgeKey{source: serviceKey{name: overflowBucketName}}
+		old = mb.m[key]
+		overflowed = true
+	}
+	mb.m[key] = edgeMetrics{
+		count:        value.count + old.count,
+		failureCount: value.failureCount + old.failureCount,
+		sum:          value.sum + old.sum,
+	}
+	return !overflowed
+}
+
+type edgeKey struct {
+	source serviceKey
+
+	// resource holds the destination service resource, if known.
+	resource string
+
+	// target holds the target service, for service edges.
+	target serviceKey
+}
+
+// exitEdgeKey returns the key of the exit edge for an exit span node.
+func exitEdgeKey(node lookupNode) edgeKey {
+	return edgeKey{source: node.service, resource: node.resource}
+}
+
+type edgeMetrics struct {
+	count        float64
+	failureCount float64
+	sum          float64
+}
+
+func makeEdgeMetrics(count, durationMillis float64, outcome string) edgeMetrics {
+	duration := time.Duration(durationMillis * float64(time.Millisecond))
+	metrics := edgeMetrics{
+		count: count,
+		sum:   float64(duration.Microseconds()) * count,
+	}
+	if outcome == outcomeFailure {
+		metrics.failureCount = count
+	}
+	return metrics
+}
+
+func makeMetricset(timestamp time.Time, key edgeKey, metrics edgeMetrics, interval int64) model.Metricset {
+	out := model.Metricset{
+		Name:      metricsetName,
+		Timestamp: timestamp,
+		Metadata: model.Metadata{
+			Service: model.Service{
+				Name:        key.source.name,
+				Environment: key.source.environment,
+			},
+		},
+		TargetService: model.MetricsetTargetService{
+			Name:        key.target.name,
+			Environment: key.target.environment,
+		},
+		Samples: []model.Sample{
+			{
+				Name:  "service_map.edge.count",
+				Value: math.Round(metrics.count),
+			},
+			{
+				Name:  "service_map.edge.failure_count",
+				Value: math.Round(metrics.failureCount),
+			},
+			{
+				Name:  "service_map.edge.duration.sum.us",
+				Value: math.Round(metrics.sum),
+			},
+		},
+	}
+	if key.resource != "" {
+		resource := key.resource
+		out.Span.DestinationService.Resource = &resource
+	}
+	if interval > 0 {
+		// Only set metricset.period for a positive interval.
+		//
+		// An interval of zero means the metricset is computed
+		// from an instantaneous value, meaning there is no
+		// aggregation period.
+		out.Samples = append(out.Samples, model.Sample{
+			Name:  "metricset.period",
+			Value: float64(interval),
+		})
+	}
+	return out
+}
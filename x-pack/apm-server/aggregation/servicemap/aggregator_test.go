@@ -0,0 +1,418 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package servicemap
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+func TestNewAggregatorConfigInvalid(t *testing.T) {
+	report := makeErrReporter(nil)
+
+	type test struct {
+		config AggregatorConfig
+		err    string
+	}
+
+	for _, test := range []test{{
+		config: AggregatorConfig{},
+		err:    "Report unspecified",
+	}, {
+		config: AggregatorConfig{
+			Report: report,
+		},
+		err: "MaxGroups unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+		},
+		err: "Interval unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+			Interval:  time.Second,
+		},
+		err: "LookupTTL unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+			Interval:  time.Second,
+			LookupTTL: time.Second,
+		},
+		err: "MaxLookupEntries unspecified or negative",
+	}} {
+		agg, err := NewAggregator(test.config)
+		require.Error(t, err)
+		require.Nil(t, agg)
+		assert.EqualError(t, err, "invalid aggregator config: "+test.err)
+	}
+}
+
+func TestAggregatorRun(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:           makeChanReporter(reqs),
+		Interval:         time.Minute,
+		MaxGroups:        1000,
+		LookupTTL:        time.Minute,
+		MaxLookupEntries: 1000,
+	})
+	require.NoError(t, err)
+
+	// frontend -> backend (via exit span) -> database
+	frontendTx := makeTransaction("frontend", "trace1", "tx1", "", "success", 300*time.Millisecond)
+	frontendSpan := makeSpan("frontend", "trace1", "span1", "tx1", "backend:8080", "success", 200*time.Millisecond)
+	backendTx := makeTransaction("backend", "trace1", "tx2", "span1", "failure", 100*time.Millisecond)
+	backendSpan := makeSpan("backend", "trace1", "span2", "tx2", "postgresql", "success", 50*time.Millisecond)
+
+	// backend -> worker, parent transaction received after the child.
+	workerTx := makeTransaction("worker", "trace2", "tx4", "tx3", "success", 100*time.Millisecond)
+	backendTx2 := makeTransaction("backend", "trace2", "tx3", "", "success", 200*time.Millisecond)
+
+	// Transactions with unknown parents do not contribute to any edge.
+	orphanTx := makeTransaction("orphan", "trace3", "tx5", "unknown", "success", 100*time.Millisecond)
+
+	for _, batch := range [][]transform.Transformable{
+		// Child transactions are typically received before their parent span.
+		{backendSpan, backendTx, orphanTx},
+		{frontendSpan, frontendTx},
+		{workerTx},
+		{backendTx2},
+	} {
+		out, err := agg.ProcessTransformables(context.Background(), batch)
+		require.NoError(t, err)
+		assert.Equal(t, batch, out)
+	}
+
+	// Exit edges of unresolved exit spans are recorded when they expire
+	// from the lookup, or when the aggregator is stopped.
+	go agg.Run()
+	require.NoError(t, agg.Stop(context.Background()))
+
+	req := expectPublish(t, reqs)
+	metricsets := make([]*model.Metricset, len(req.Transformables))
+	for i, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		require.NotZero(t, ms.Timestamp)
+		ms.Timestamp = time.Time{}
+		metricsets[i] = ms
+	}
+
+	makeExpected := func(source, resource, target string, count, failureCount, sum float64) *model.Metricset {
+		ms := &model.Metricset{
+			Name:          "service_map_edge",
+			Metadata:      model.Metadata{Service: model.Service{Name: source}},
+			TargetService: model.MetricsetTargetService{Name: target},
+			Samples: []model.Sample{
+				{Name: "service_map.edge.count", Value: count},
+				{Name: "service_map.edge.failure_count", Value: failureCount},
+				{Name: "service_map.edge.duration.sum.us", Value: sum},
+				{Name: "metricset.period", Value: 60000},
+			},
+		}
+		if resource != "" {
+			ms.Span.DestinationService.Resource = &resource
+		}
+		return ms
+	}
+	// The call from frontend to backend is only recorded in the service edge.
+	assert.ElementsMatch(t, []*model.Metricset{
+		makeExpected("backend", "postgresql", "", 1, 0, 50000),
+		makeExpected("frontend", "backend:8080", "backend", 1, 1, 100000),
+		makeExpected("backend", "", "worker", 1, 0, 100000),
+	}, metricsets)
+}
+
+func TestAggregatorLookupExpiry(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:           makeChanReporter(reqs),
+		Interval:         10 * time.Millisecond,
+		MaxGroups:        1000,
+		LookupTTL:        time.Millisecond,
+		MaxLookupEntries: 1000,
+	})
+	require.NoError(t, err)
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	childTx := makeTransaction("backend", "trace1", "tx2", "tx1", "success", 100*time.Millisecond)
+	_, err = agg.ProcessTransformables(context.Background(), []transform.Transformable{childTx})
+	require.NoError(t, err)
+
+	// Wait for the pending child to expire.
+	assert.Eventually(t, func() bool {
+		agg.lookup.mu.Lock()
+		defer agg.lookup.mu.Unlock()
+		return agg.lookup.entries == 0 && len(agg.lookup.pending) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+
+	parentTx := makeTransaction("frontend", "trace1", "tx1", "", "success", 200*time.Millisecond)
+	_, err = agg.ProcessTransformables(context.Background(), []transform.Transformable{parentTx})
+	require.NoError(t, err)
+
+	select {
+	case req := <-reqs:
+		t.Fatalf("unexpected publish: %+v", req)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestAggregatorLookupExitSpans(t *testing.T) {
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:           makeErrReporter(nil),
+		Interval:         time.Minute,
+		MaxGroups:        1000,
+		LookupTTL:        time.Minute,
+		MaxLookupEntries: 2,
+	})
+	require.NoError(t, err)
+
+	// Only exit spans and transactions are recorded in the lookup;
+	// once it is full, further entries are dropped: the nodes for
+	// tx2 and tx3, and tx3 awaiting its parent. The call to backend
+	// is only recorded in the service edge resolved from span2.
+	_, err = agg.ProcessTransformables(context.Background(), []transform.Transformable{
+		makeTransaction("frontend", "trace1", "tx1", "", "success", 300*time.Millisecond),
+		makeSpan("frontend", "trace1", "span1", "tx1", "", "success", 200*time.Millisecond),
+		makeSpan("frontend", "trace1", "span2", "span1", "backend:8080", "success", 100*time.Millisecond),
+		makeTransaction("backend", "trace1", "tx2", "span2", "success", 50*time.Millisecond),
+		makeTransaction("worker", "trace1", "tx3", "unknown", "success", 10*time.Millisecond),
+	})
+	require.NoError(t, err)
+
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["servicemap.active_groups"] = 1
+	expectedMonitoring.Ints["servicemap.overflowed"] = 0
+	expectedMonitoring.Ints["servicemap.lookup_entries"] = 2
+	expectedMonitoring.Ints["servicemap.lookup_dropped"] = 3
+
+	registry := monitoring.NewRegistry()
+	monitoring.NewFunc(registry, "servicemap", agg.CollectMonitoring)
+	assert.Equal(t, expectedMonitoring, monitoring.CollectFlatSnapshot(
+		registry,
+		monitoring.Full,
+		false, // expvar
+	))
+}
+
+func TestAggregatorEdgeCountPerCall(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:           makeChanReporter(reqs),
+		Interval:         time.Minute,
+		MaxGroups:        1000,
+		LookupTTL:        100 * time.Millisecond,
+		MaxLookupEntries: 1000,
+	})
+	require.NoError(t, err)
+	go agg.Run()
+
+	// frontend calls backend three times, and postgresql once. The backend
+	// transactions of two calls are received, before and after the exit
+	// span; that of the third call is never received.
+	for _, batch := range [][]transform.Transformable{{
+		makeTransaction("backend", "trace1", "tx2", "span1", "success", 100*time.Millisecond),
+		makeSpan("frontend", "trace1", "span1", "tx1", "backend:8080", "success", 200*time.Millisecond),
+		makeSpan("frontend", "trace1", "span2", "tx1", "backend:8080", "success", 200*time.Millisecond),
+		makeSpan("frontend", "trace1", "span3", "tx1", "backend:8080", "failure", 200*time.Millisecond),
+		makeSpan("frontend", "trace1", "span4", "tx1", "postgresql", "success", 50*time.Millisecond),
+		makeTransaction("frontend", "trace1", "tx1", "", "success", 300*time.Millisecond),
+	}, {
+		makeTransaction("backend", "trace1", "tx3", "span2", "success", 100*time.Millisecond),
+	}} {
+		_, err := agg.ProcessTransformables(context.Background(), batch)
+		require.NoError(t, err)
+	}
+
+	// Wait for the unresolved exit spans to expire, recording their exit edges.
+	assert.Eventually(t, func() bool {
+		return agg.lookup.len() == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, agg.Stop(context.Background()))
+
+	// Summing the counts of both kinds of edges yields the number of calls.
+	req := expectPublish(t, reqs)
+	counts := make(map[string]float64)
+	var total float64
+	for _, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		require.Equal(t, "frontend", ms.Metadata.Service.Name)
+		require.Equal(t, "service_map.edge.count", ms.Samples[0].Name)
+		counts[*ms.Span.DestinationService.Resource+"->"+ms.TargetService.Name] += ms.Samples[0].Value
+		total += ms.Samples[0].Value
+	}
+	assert.Equal(t, map[string]float64{
+		"backend:8080->backend": 2,
+		"backend:8080->":        1,
+		"postgresql->":          1,
+	}, counts)
+	assert.Equal(t, float64(4), total)
+}
+
+func TestAggregatorOverflow(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:           makeChanReporter(reqs),
+		Interval:         10 * time.Millisecond,
+		MaxGroups:        2,
+		LookupTTL:        time.Minute,
+		MaxLookupEntries: 1000,
+	})
+	require.NoError(t, err)
+
+	// The first two edges will be aggregated individually, as we have
+	// configured the aggregator with a maximum of two edges. Subsequent
+	// edges are aggregated into the overflow edge.
+	var input []transform.Transformable
+	for i := 0; i < 10; i++ {
+		input = append(input, makeSpan("service", "trace", "", "", "destination1", "success", 100*time.Millisecond))
+		input = append(input, makeSpan("service", "trace", "", "", "destination2", "success", 100*time.Millisecond))
+	}
+	for i := 0; i < 2; i++ {
+		input = append(input, makeSpan("service", "trace", "", "", "destination3", "failure", 100*time.Millisecond))
+		input = append(input, makeSpan("other", "trace", "", "", "destination1", "success", 100*time.Millisecond))
+	}
+	output, err := agg.ProcessTransformables(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, input, output)
+
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["servicemap.active_groups"] = 3
+	expectedMonitoring.Ints["servicemap.overflowed"] = 4
+	expectedMonitoring.Ints["servicemap.lookup_entries"] = 0
+	expectedMonitoring.Ints["servicemap.lookup_dropped"] = 0
+
+	registry := monitoring.NewRegistry()
+	monitoring.NewFunc(registry, "servicemap", agg.CollectMonitoring)
+	assert.Equal(t, expectedMonitoring, monitoring.CollectFlatSnapshot(
+		registry,
+		monitoring.Full,
+		false, // expvar
+	))
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	req := expectPublish(t, reqs)
+	require.Len(t, req.Transformables, 3)
+	var overflow *model.Metricset
+	for _, tf := range req.Transformables {
+		if ms := tf.(*model.Metricset); ms.Metadata.Service.Name == "_other" {
+			overflow = ms
+		}
+	}
+	require.NotNil(t, overflow)
+	overflow.Timestamp = time.Time{}
+	assert.Equal(t, &model.Metricset{
+		Name:     "service_map_edge",
+		Metadata: model.Metadata{Service: model.Service{Name: "_other"}},
+		Samples: []model.Sample{
+			{Name: "service_map.edge.count", Value: 4},
+			{Name: "service_map.edge.failure_count", Value: 2},
+			{Name: "service_map.edge.duration.sum.us", Value: 400000},
+			{Name: "metricset.period", Value: 10},
+		},
+	}, overflow)
+}
+
+func TestTraceLookupMaxEntries(t *testing.T) {
+	lookup := newTraceLookup(time.Minute, 2)
+	now := time.Now()
+	node := lookupNode{service: serviceKey{name: "parent"}, observed: now}
+	child := pendingChild{service: serviceKey{name: "child"}, observed: now}
+
+	children, dropped := lookup.addNode(lookupKey{traceID: "trace", id: "1"}, node)
+	assert.Empty(t, children)
+	assert.False(t, dropped)
+	_, ok, dropped := lookup.resolveParent(lookupKey{traceID: "trace", id: "2"}, child)
+	assert.False(t, ok)
+	assert.False(t, dropped)
+
+	// The lookup is full, so neither nodes nor pending children are recorded.
+	children, dropped = lookup.addNode(lookupKey{traceID: "trace", id: "3"}, node)
+	assert.Empty(t, children)
+	assert.True(t, dropped)
+	_, ok, dropped = lookup.resolveParent(lookupKey{traceID: "trace", id: "3"}, child)
+	assert.False(t, ok)
+	assert.True(t, dropped)
+
+	// Resolving a pending child makes room for its parent.
+	children, dropped = lookup.addNode(lookupKey{traceID: "trace", id: "2"}, node)
+	assert.Equal(t, []pendingChild{child}, children)
+	assert.False(t, dropped)
+	assert.Equal(t, 2, lookup.len())
+
+	lookup.removeExpired(now.Add(time.Hour))
+	assert.Equal(t, 0, lookup.entries)
+	assert.Empty(t, lookup.nodes)
+}
+
+func makeTransaction(serviceName, traceID, id, parentID, outcome string, duration time.Duration) *model.Transaction {
+	return &model.Transaction{
+		Metadata:            model.Metadata{Service: model.Service{Name: serviceName}},
+		TraceID:             traceID,
+		ID:                  id,
+		ParentID:            parentID,
+		Outcome:             outcome,
+		Duration:            duration.Seconds() * 1000,
+		RepresentativeCount: 1,
+	}
+}
+
+func makeSpan(serviceName, traceID, id, parentID, resource, outcome string, duration time.Duration) *model.Span {
+	span := &model.Span{
+		Metadata:            model.Metadata{Service: model.Service{Name: serviceName}},
+		TraceID:             traceID,
+		ID:                  id,
+		ParentID:            parentID,
+		Outcome:             outcome,
+		Duration:            duration.Seconds() * 1000,
+		RepresentativeCount: 1,
+	}
+	if resource != "" {
+		span.DestinationService = &model.DestinationService{Resource: &resource}
+	}
+	return span
+}
+
+func makeErrReporter(err error) publish.Reporter {
+	return func(context.Context, publish.PendingReq) error { return err }
+}
+
+func makeChanReporter(ch chan<- publish.PendingReq) publish.Reporter {
+	return func(ctx context.Context, req publish.PendingReq) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- req:
+			return nil
+		}
+	}
+}
+
+func expectPublish(t *testing.T, ch <-chan publish.PendingReq) publish.PendingReq {
+	t.Helper()
+	select {
+	case req := <-ch:
+		return req
+	case <-time.After(time.Second * 5):
+		t.Fatal("expected publish")
+	}
+	panic("unreachable")
+}
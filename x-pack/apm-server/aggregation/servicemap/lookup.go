@@ -0,0 +1,173 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package servicemap
+
+import (
+	"sync"
+	"time"
+)
+
+// traceLookup is a short-lived lookup of exit span and transaction IDs to
+// the services in which they occurred, for resolving the caller of a
+// transaction from its parent ID.
+//
+// Transactions often end, and so are received, before their parent span.
+// Transactions whose parent has not yet been observed are held as pending,
+// and resolved when the parent is observed. Entries of both kinds expire
+// after the configured TTL.
+//
+// Exit spans hold their edge metrics until a transaction of another service
+// is resolved as their child, in which case the call is recorded in a service
+// edge instead, or until they expire.
+type traceLookup struct {
+	ttl        time.Duration
+	maxEntries int
+
+	mu      sync.Mutex
+	entries int
+	nodes   map[lookupKey]lookupNode
+	pending map[lookupKey][]pendingChild
+}
+
+type lookupKey struct {
+	traceID string
+	id      string
+}
+
+// lookupNode holds information about an observed span or transaction.
+type lookupNode struct {
+	service serviceKey
+
+	// resource holds the destination service resource
+	// for exit spans, and is empty for transactions.
+	resource string
+
+	// exit holds the metrics of an exit span, to be recorded in an
+	// exit edge unless a service edge is resolved from the span.
+	// exit is nil for transactions, and once a service edge is resolved.
+	exit *edgeMetrics
+
+	observed time.Time
+}
+
+// callerOf reports whether the node is the caller of another service's
+// transaction, i.e. whether a service edge leads from node to child.
+func (n lookupNode) callerOf(child pendingChild) bool {
+	return n.service != child.service
+}
+
+// pendingChild holds information about a transaction whose
+// parent has not yet been observed.
+type pendingChild struct {
+	service serviceKey
+	metrics edgeMetrics
+
+	observed time.Time
+}
+
+type serviceKey struct {
+	name        string
+	environment string
+}
+
+func newTraceLookup(ttl time.Duration, maxEntries int) *traceLookup {
+	return &traceLookup{
+		ttl:        ttl,
+		maxEntries: maxEntries,
+		nodes:      make(map[lookupKey]lookupNode),
+		pending:    make(map[lookupKey][]pendingChild),
+	}
+}
+
+// addNode records the service in which an exit span or transaction with
+// the given ID occurred, returning any pending children which are resolved
+// by it. If the lookup is full, the node is not recorded, and dropped is
+// true.
+func (l *traceLookup) addNode(key lookupKey, node lookupNode) (children []pendingChild, dropped bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	children, ok := l.pending[key]
+	if ok {
+		delete(l.pending, key)
+		l.entries -= len(children)
+	}
+	for _, child := range children {
+		if node.callerOf(child) {
+			node.exit = nil
+		}
+	}
+	if _, ok := l.nodes[key]; !ok {
+		if l.entries >= l.maxEntries {
+			return children, true
+		}
+		l.entries++
+	}
+	l.nodes[key] = node
+	return children, false
+}
+
+// resolveParent returns the node with the given key, if it has been observed.
+// If the node has not been observed, child is recorded as pending, unless the
+// lookup is full, in which case dropped is true.
+func (l *traceLookup) resolveParent(key lookupKey, child pendingChild) (parent lookupNode, ok, dropped bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if node, ok := l.nodes[key]; ok {
+		if node.exit != nil && node.callerOf(child) {
+			// The exit span's call is recorded in a service edge.
+			node.exit = nil
+			l.nodes[key] = node
+		}
+		return node, true, false
+	}
+	if l.entries >= l.maxEntries {
+		return lookupNode{}, false, true
+	}
+	l.pending[key] = append(l.pending[key], child)
+	l.entries++
+	return lookupNode{}, false, false
+}
+
+// len returns the number of nodes and pending children in the lookup.
+func (l *traceLookup) len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.entries
+}
+
+// removeExpired removes nodes and pending children observed before now-ttl,
+// returning the expired exit span nodes from which no service edge has been
+// resolved. Pending children which expire are discarded, as their callers are
+// unknown.
+func (l *traceLookup) removeExpired(now time.Time) (exits []lookupNode) {
+	cutoff := now.Add(-l.ttl)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for key, node := range l.nodes {
+		if node.observed.Before(cutoff) {
+			delete(l.nodes, key)
+			l.entries--
+			if node.exit != nil {
+				exits = append(exits, node)
+			}
+		}
+	}
+	for key, children := range l.pending {
+		n := len(children)
+		unexpired := children[:0]
+		for _, child := range children {
+			if !child.observed.Before(cutoff) {
+				unexpired = append(unexpired, child)
+			}
+		}
+		if len(unexpired) == 0 {
+			delete(l.pending, key)
+		} else {
+			l.pending[key] = unexpired
+		}
+		l.entries -= n - len(unexpired)
+	}
+	return exits
+}
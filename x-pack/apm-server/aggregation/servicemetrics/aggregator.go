@@ -0,0 +1,442 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package servicemetrics
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	logs "github.com/elastic/apm-server/log"
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/go-hdrhistogram"
+)
+
+const (
+	// metricsetName is the name of the service summary metricsets,
+	// distinguishing them from other metrics with the same fields.
+	metricsetName = "service_summary"
+
+	outcomeFailure = "failure"
+
+	minDuration time.Duration = 0
+	maxDuration time.Duration = time.Hour
+
+	// We scale transaction counts in the histogram, which only permits
+	// storing integer counts, to allow for fractional transactions due
+	// to sampling.
+	//
+	// See the txmetrics package for a more detailed explanation.
+	histogramCountScale = 1000
+
+	// tooManyGroupsLoggerRateLimit is the maximum frequency at which
+	// "too many groups" log messages are logged.
+	tooManyGroupsLoggerRateLimit = time.Minute
+
+	// overflowBucketName is the service name recorded for transactions
+	// which are aggregated into the overflow group.
+	overflowBucketName = "_other"
+)
+
+// AggregatorConfig holds configuration for creating an Aggregator.
+type AggregatorConfig struct {
+	// Report is a publish.Reporter for reporting metrics documents.
+	Report publish.Reporter
+
+	// MaxGroups is the maximum number of distinct service summary group
+	// metrics to store within an aggregation period. Once this number of
+	// groups is reached, transactions with new aggregation keys are
+	// aggregated into an additional overflow group, with the service
+	// name "_other".
+	MaxGroups int
+
+	// Interval is the interval between publishing of aggregated metrics.
+	Interval time.Duration
+
+	// HDRHistogramSignificantFigures is the number of significant figures
+	// to maintain in the HDR Histograms of transaction durations.
+	// HDRHistogramSignificantFigures must be in the range [1,5].
+	HDRHistogramSignificantFigures int
+
+	// Logger is the logger for logging metrics aggregation/publishing.
+	//
+	// If Logger is nil, a new logger will be constructed.
+	Logger *logp.Logger
+}
+
+// Validate validates the aggregator config.
+func (config AggregatorConfig) Validate() error {
+	if config.Report == nil {
+		return errors.New("Report unspecified")
+	}
+	if config.MaxGroups <= 0 {
+		return errors.New("MaxGroups unspecified or negative")
+	}
+	if config.Interval <= 0 {
+		return errors.New("Interval unspecified or negative")
+	}
+	if n := config.HDRHistogramSignificantFigures; n < 1 || n > 5 {
+		return errors.Errorf("HDRHistogramSignificantFigures (%d) outside range [1,5]", n)
+	}
+	return nil
+}
+
+// Aggregator aggregates transactions by service, periodically publishing
+// service-level throughput, failure rate, and latency histogram metrics.
+type Aggregator struct {
+	stopMu   sync.Mutex
+	stopping chan struct{}
+	stopped  chan struct{}
+
+	config              AggregatorConfig
+	metrics             aggregatorMetrics
+	tooManyGroupsLogger *logp.Logger
+
+	mu               sync.RWMutex
+	active, inactive *metricsBuffer
+}
+
+type aggregatorMetrics struct {
+	overflowed int64
+}
+
+// NewAggregator returns a new Aggregator with the given config.
+func NewAggregator(config AggregatorConfig) (*Aggregator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid aggregator config")
+	}
+	if config.Logger == nil {
+		config.Logger = logp.NewLogger(logs.ServiceMetrics)
+	}
+	return &Aggregator{
+		stopping:            make(chan struct{}),
+		stopped:             make(chan struct{}),
+		config:              config,
+		tooManyGroupsLogger: config.Logger.WithOptions(logs.WithRateLimit(tooManyGroupsLoggerRateLimit)),
+		active:              newMetricsBuffer(config.MaxGroups, config.HDRHistogramSignificantFigures),
+		inactive:            newMetricsBuffer(config.MaxGroups, config.HDRHistogramSignificantFigures),
+	}, nil
+}
+
+// Run runs the Aggregator, periodically publishing and clearing aggregated
+// metrics. Run returns when either a fatal error occurs, or the Aggregator's
+// Stop method is invoked.
+func (a *Aggregator) Run() error {
+	ticker := time.NewTicker(a.config.Interval)
+	defer ticker.Stop()
+	defer func() {
+		a.stopMu.Lock()
+		defer a.stopMu.Unlock()
+		select {
+		case <-a.stopped:
+		default:
+			close(a.stopped)
+		}
+	}()
+	var stop bool
+	for !stop {
+		select {
+		case <-a.stopping:
+			stop = true
+		case <-ticker.C:
+		}
+		if err := a.publish(context.Background()); err != nil {
+			a.config.Logger.With(logp.Error(err)).Warnf(
+				"publishing service metrics failed: %s", err,
+			)
+		}
+	}
+	return nil
+}
+
+// Stop stops the Aggregator if it is running, waiting for it to flush any
+// aggregated metrics and return, or for the context to be cancelled.
+//
+// After Stop has been called the aggregator cannot be reused, as the Run
+// method will always return immediately.
+func (a *Aggregator) Stop(ctx context.Context) error {
+	a.stopMu.Lock()
+	select {
+	case <-a.stopped:
+	case <-a.stopping:
+		// Already stopping/stopped.
+	default:
+		close(a.stopping)
+	}
+	a.stopMu.Unlock()
+
+	select {
+	case <-a.stopped:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+// CollectMonitoring may be called to collect monitoring metrics from the
+// aggregation. It is intended to be used with libbeat/monitoring.NewFunc.
+//
+// The metrics should be added to the "apm-server.aggregation.servicemetrics" registry.
+func (a *Aggregator) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor) {
+	V.OnRegistryStart()
+	defer V.OnRegistryFinished()
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	m := a.active
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	monitoring.ReportInt(V, "active_groups", int64(m.entries))
+	monitoring.ReportInt(V, "overflowed", atomic.LoadInt64(&a.metrics.overflowed))
+}
+
+func (a *Aggregator) publish(ctx context.Context) error {
+	// We hold a.mu only long enough to swap the metrics. This will
+	// be blocked by metrics updates, which is OK, as we prefer not
+	// to block metrics updaters. After the lock is released nothing
+	// will be accessing a.inactive.
+	a.mu.Lock()
+	a.active, a.inactive = a.inactive, a.active
+	a.mu.Unlock()
+
+	size := a.inactive.entries
+	if size == 0 {
+		a.config.Logger.Debugf("no service metrics to publish")
+		return nil
+	}
+
+	now := time.Now()
+	metricsets := make([]transform.Transformable, 0, size)
+	for key, metrics := range a.inactive.m {
+		metricset := makeMetricset(now, key, metrics, a.config.Interval.Milliseconds())
+		metricsets = append(metricsets, &metricset)
+		delete(a.inactive.m, key)
+	}
+	a.inactive.entries = 0
+	a.config.Logger.Debugf("publishing %d metricsets", len(metricsets))
+	return a.config.Report(ctx, publish.PendingReq{
+		Transformables: metricsets,
+		Trace:          true,
+	})
+}
+
+// ProcessTransformables aggregates all transactions contained in
+// "in", returning the input unmodified.
+//
+// This method is expected to be used immediately prior to publishing
+// the events.
+func (a *Aggregator) ProcessTransformables(ctx context.Context, in []transform.Transformable) ([]transform.Transformable, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, tf := range in {
+		if tx, ok := tf.(*model.Transaction); ok {
+			a.processTransaction(tx)
+		}
+	}
+	return in, nil
+}
+
+func (a *Aggregator) processTransaction(tx *model.Transaction) {
+	if tx.RepresentativeCount <= 0 {
+		// RepresentativeCount is zero when the sample rate is unknown.
+		// We cannot calculate accurate throughput without the sample
+		// rate, so we don't calculate any metrics at all in this case.
+		return
+	}
+
+	key := aggregationKey{
+		serviceName:        tx.Metadata.Service.Name,
+		serviceEnvironment: tx.Metadata.Service.Environment,
+		agentName:          tx.Metadata.Service.Agent.Name,
+		transactionType:    tx.Type,
+	}
+	duration := time.Duration(tx.Duration * float64(time.Millisecond))
+	failed := tx.Outcome == outcomeFailure
+	if a.active.storeOrUpdate(key, tx.RepresentativeCount, duration, failed) {
+		return
+	}
+	a.tooManyGroupsLogger.Warn(`
+Service summary group limit reached, aggregating excess service summary groups
+into "_other". This is typically caused by high cardinality transaction types.`[1:],
+	)
+	atomic.AddInt64(&a.metrics.overflowed, 1)
+}
+
+type metricsBuffer struct {
+	maxSize            int
+	significantFigures int
+
+	mu      sync.RWMutex
+	entries int
+	m       map[aggregationKey]*serviceMetrics
+	space   []serviceMetrics
+}
+
+func newMetricsBuffer(maxSize, significantFigures int) *metricsBuffer {
+	return &metricsBuffer{
+		maxSize:            maxSize,
+		significantFigures: significantFigures,
+		m:                  make(map[aggregationKey]*serviceMetrics),
+		// Reserve an additional entry for the overflow group.
+		space: make([]serviceMetrics, maxSize+1),
+	}
+}
+
+// storeOrUpdate records count and duration in the metrics for key,
+// returning false if they were instead recorded in an overflow group
+// due to the group limit being reached.
+func (mb *metricsBuffer) storeOrUpdate(key aggregationKey, count float64, duration time.Duration, failed bool) bool {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	metrics, ok := mb.m[key]
+	var overflowed bool
+	if !ok {
+		if mb.entries >= mb.maxSize {
+			key = aggregationKey{serviceName: overflowBucketName}
+			metrics, ok = mb.m[key]
+			overflowed = true
+		}
+		if !ok {
+			// The overflow group uses the space reserved
+			// for it beyond maxSize; see newMetricsBuffer.
+			metrics = mb.newEntryLocked(key)
+		}
+	}
+	metrics.count += count
+	metrics.sum += float64(duration.Microseconds()) * count
+	if failed {
+		metrics.failureCount += count
+	}
+	metrics.histogram.RecordValues(
+		durationMicros(clampDuration(duration)),
+		int64(math.Round(count*histogramCountScale)),
+	)
+	return !overflowed
+}
+
+// newEntryLocked allocates and records a new entry for key.
+//
+// newEntryLocked must be called with mb.mu held for writing.
+func (mb *metricsBuffer) newEntryLocked(key aggregationKey) *serviceMetrics {
+	// Reuse the space and histograms from previous aggregation periods.
+	metrics := &mb.space[mb.entries]
+	if metrics.histogram == nil {
+		metrics.histogram = hdrhistogram.New(
+			durationMicros(minDuration),
+			durationMicros(maxDuration),
+			mb.significantFigures,
+		)
+	} else {
+		metrics.histogram.Reset()
+	}
+	metrics.count = 0
+	metrics.sum = 0
+	metrics.failureCount = 0
+	mb.m[key] = metrics
+	mb.entries++
+	return metrics
+}
+
+type aggregationKey struct {
+	serviceName        string
+	serviceEnvironment string
+	agentName          string
+	transactionType    string
+}
+
+type serviceMetrics struct {
+	count        float64
+	sum          float64
+	failureCount float64
+	histogram    *hdrhistogram.Histogram
+}
+
+func (m *serviceMetrics) histogramBuckets() (counts []int64, values []float64) {
+	// See the txmetrics package for details of the HDR histogram format.
+	distribution := m.histogram.Distribution()
+	counts = make([]int64, 0, len(distribution))
+	values = make([]float64, 0, len(distribution))
+	for _, b := range distribution {
+		if b.Count <= 0 {
+			continue
+		}
+		count := math.Round(float64(b.Count) / histogramCountScale)
+		counts = append(counts, int64(count))
+		values = append(values, float64(b.To))
+	}
+	return counts, values
+}
+
+func makeMetricset(timestamp time.Time, key aggregationKey, metrics *serviceMetrics, interval int64) model.Metricset {
+	counts, values := metrics.histogramBuckets()
+	out := model.Metricset{
+		Name:      metricsetName,
+		Timestamp: timestamp,
+		Metadata: model.Metadata{
+			Service: model.Service{
+				Name:        key.serviceName,
+				Environment: key.serviceEnvironment,
+				Agent:       model.Agent{Name: key.agentName},
+			},
+		},
+		Transaction: model.MetricsetTransaction{
+			Type: key.transactionType,
+		},
+		Samples: []model.Sample{
+			{
+				Name:  "transaction.duration.count",
+				Value: math.Round(metrics.count),
+			},
+			{
+				Name:  "transaction.duration.sum.us",
+				Value: math.Round(metrics.sum),
+			},
+			{
+				Name:   "transaction.duration.histogram",
+				Counts: counts,
+				Values: values,
+			},
+			{
+				Name:  "transaction.failure_count",
+				Value: math.Round(metrics.failureCount),
+			},
+		},
+	}
+	if interval > 0 {
+		// Only set metricset.period for a positive interval.
+		//
+		// An interval of zero means the metricset is computed
+		// from an instantaneous value, meaning there is no
+		// aggregation period.
+		out.Samples = append(out.Samples, model.Sample{
+			Name:  "metricset.period",
+			Value: float64(interval),
+		})
+	}
+	return out
+}
+
+func clampDuration(d time.Duration) time.Duration {
+	if d < minDuration {
+		return minDuration
+	} else if d > maxDuration {
+		return maxDuration
+	}
+	return d
+}
+
+func durationMicros(d time.Duration) int64 {
+	return int64(d / time.Microsecond)
+}
@@ -0,0 +1,254 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package servicemetrics
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+func TestNewAggregatorConfigInvalid(t *testing.T) {
+	report := makeErrReporter(nil)
+
+	type test struct {
+		config AggregatorConfig
+		err    string
+	}
+
+	for _, test := range []test{{
+		config: AggregatorConfig{},
+		err:    "Report unspecified",
+	}, {
+		config: AggregatorConfig{
+			Report: report,
+		},
+		err: "MaxGroups unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+		},
+		err: "Interval unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+			Interval:  time.Nanosecond,
+		},
+		err: "HDRHistogramSignificantFigures (0) outside range [1,5]",
+	}} {
+		agg, err := NewAggregator(test.config)
+		require.Error(t, err)
+		require.Nil(t, agg)
+		assert.EqualError(t, err, "invalid aggregator config: "+test.err)
+	}
+}
+
+func TestAggregatorRun(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:                         makeChanReporter(reqs),
+		Interval:                       10 * time.Millisecond,
+		MaxGroups:                      1000,
+		HDRHistogramSignificantFigures: 5,
+	})
+	require.NoError(t, err)
+
+	type input struct {
+		serviceName string
+		environment string
+		txType      string
+		outcome     string
+		count       float64
+	}
+
+	inputs := []input{
+		{serviceName: "service-A", environment: "prod", txType: "request", outcome: "success", count: 2},
+		{serviceName: "service-A", environment: "prod", txType: "request", outcome: "failure", count: 1},
+		{serviceName: "service-A", environment: "prod", txType: "request", outcome: "unknown", count: 1},
+		{serviceName: "service-A", environment: "prod", txType: "request", outcome: "success", count: 0},
+		{serviceName: "service-A", environment: "staging", txType: "request", outcome: "success", count: 1},
+		{serviceName: "service-A", environment: "prod", txType: "messaging", outcome: "failure", count: 1},
+		{serviceName: "service-B", environment: "prod", txType: "request", outcome: "success", count: 1},
+	}
+
+	var wg sync.WaitGroup
+	for i, in := range inputs {
+		wg.Add(1)
+		go func(i int, in input) {
+			defer wg.Done()
+			tx := makeTransaction(in.serviceName, in.environment, in.txType, in.outcome, 100*time.Millisecond, in.count)
+			tx.Name = string(rune('a' + i)) // names are not aggregated
+			transformables := []transform.Transformable{tx}
+			for i := 0; i < 100; i++ {
+				out, err := agg.ProcessTransformables(context.Background(), transformables)
+				require.NoError(t, err)
+				assert.Equal(t, transformables, out)
+			}
+		}(i, in)
+	}
+	wg.Wait()
+
+	// Start the aggregator after processing to ensure metrics are aggregated deterministically.
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	req := expectPublish(t, reqs)
+	metricsets := make([]*model.Metricset, len(req.Transformables))
+	for i, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		require.NotZero(t, ms.Timestamp)
+		ms.Timestamp = time.Time{}
+		metricsets[i] = ms
+	}
+
+	makeExpected := func(serviceName, environment, txType string, count, failureCount float64) *model.Metricset {
+		return &model.Metricset{
+			Name: "service_summary",
+			Metadata: model.Metadata{
+				Service: model.Service{Name: serviceName, Environment: environment, Agent: model.Agent{Name: "go"}},
+			},
+			Transaction: model.MetricsetTransaction{Type: txType},
+			Samples: []model.Sample{
+				{Name: "transaction.duration.count", Value: count},
+				{Name: "transaction.duration.sum.us", Value: count * 100000},
+				{Name: "transaction.duration.histogram", Counts: []int64{int64(count)}, Values: []float64{100000}},
+				{Name: "transaction.failure_count", Value: failureCount},
+				{Name: "metricset.period", Value: 10},
+			},
+		}
+	}
+	assert.ElementsMatch(t, []*model.Metricset{
+		makeExpected("service-A", "prod", "request", 400, 100),
+		makeExpected("service-A", "staging", "request", 100, 0),
+		makeExpected("service-A", "prod", "messaging", 100, 100),
+		makeExpected("service-B", "prod", "request", 100, 0),
+	}, metricsets)
+
+	select {
+	case <-reqs:
+		t.Fatal("unexpected publish")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestAggregatorOverflow(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:                         makeChanReporter(reqs),
+		Interval:                       10 * time.Millisecond,
+		MaxGroups:                      2,
+		HDRHistogramSignificantFigures: 5,
+	})
+	require.NoError(t, err)
+
+	// The first two service groups will be aggregated individually,
+	// as we have configured the aggregator with a maximum of two groups.
+	// Subsequent groups are aggregated into the overflow group.
+	var input []transform.Transformable
+	for i := 0; i < 10; i++ {
+		input = append(input, makeTransaction("service1", "", "request", "success", 100*time.Millisecond, 1))
+		input = append(input, makeTransaction("service2", "", "request", "success", 100*time.Millisecond, 1))
+	}
+	for i := 0; i < 2; i++ {
+		input = append(input, makeTransaction("service3", "", "request", "failure", 100*time.Millisecond, 1))
+		input = append(input, makeTransaction("service4", "", "request", "success", 100*time.Millisecond, 1))
+	}
+	output, err := agg.ProcessTransformables(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, input, output)
+
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["servicemetrics.active_groups"] = 3
+	expectedMonitoring.Ints["servicemetrics.overflowed"] = 4
+
+	registry := monitoring.NewRegistry()
+	monitoring.NewFunc(registry, "servicemetrics", agg.CollectMonitoring)
+	assert.Equal(t, expectedMonitoring, monitoring.CollectFlatSnapshot(
+		registry,
+		monitoring.Full,
+		false, // expvar
+	))
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	req := expectPublish(t, reqs)
+	require.Len(t, req.Transformables, 3)
+	var overflow *model.Metricset
+	for _, tf := range req.Transformables {
+		if ms := tf.(*model.Metricset); ms.Metadata.Service.Name == "_other" {
+			overflow = ms
+		}
+	}
+	require.NotNil(t, overflow)
+	overflow.Timestamp = time.Time{}
+	assert.Equal(t, &model.Metricset{
+		Name:     "service_summary",
+		Metadata: model.Metadata{Service: model.Service{Name: "_other"}},
+		Samples: []model.Sample{
+			{Name: "transaction.duration.count", Value: 4},
+			{Name: "transaction.duration.sum.us", Value: 400000},
+			{Name: "transaction.duration.histogram", Counts: []int64{4}, Values: []float64{100000}},
+			{Name: "transaction.failure_count", Value: 2},
+			{Name: "metricset.period", Value: 10},
+		},
+	}, overflow)
+}
+
+func makeTransaction(
+	serviceName, environment, txType, outcome string,
+	duration time.Duration,
+	count float64,
+) *model.Transaction {
+	return &model.Transaction{
+		Metadata: model.Metadata{Service: model.Service{
+			Name:        serviceName,
+			Environment: environment,
+			Agent:       model.Agent{Name: "go"},
+		}},
+		Name:                "transaction",
+		Type:                txType,
+		Duration:            duration.Seconds() * 1000,
+		RepresentativeCount: count,
+		Outcome:             outcome,
+	}
+}
+
+func makeErrReporter(err error) publish.Reporter {
+	return func(context.Context, publish.PendingReq) error { return err }
+}
+
+func makeChanReporter(ch chan<- publish.PendingReq) publish.Reporter {
+	return func(ctx context.Context, req publish.PendingReq) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- req:
+			return nil
+		}
+	}
+}
+
+func expectPublish(t *testing.T, ch <-chan publish.PendingReq) publish.PendingReq {
+	t.Helper()
+	select {
+	case req := <-ch:
+		return req
+	case <-time.After(time.Second * 5):
+		t.Fatal("expected publish")
+	}
+	panic("unreachable")
+}
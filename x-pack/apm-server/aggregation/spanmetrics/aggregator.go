@@ -8,6 +8,7 @@ import (
 	"context"
 	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/pkg/errors"
@@ -16,7 +17,30 @@ import (
 	"github.com/elastic/apm-server/model"
 	"github.com/elastic/apm-server/publish"
 	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/apm-server/x-pack/apm-server/aggregation/exemplars"
 	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/go-hdrhistogram"
+)
+
+const (
+	minDuration time.Duration = 0
+	maxDuration time.Duration = time.Hour
+
+	// We scale span counts in the histogram, which only permits storing
+	// integer counts, to allow for fractional spans due to sampling.
+	//
+	// See the txmetrics package for a more detailed explanation.
+	histogramCountScale = 1000
+
+	// tooManyGroupsLoggerRateLimit is the maximum frequency at which
+	// "too many groups" log messages are logged.
+	tooManyGroupsLoggerRateLimit = time.Minute
+
+	// overflowBucketName is the destination service resource (and, for
+	// the global overflow group, the service name) recorded for spans
+	// which are aggregated into an overflow group.
+	overflowBucketName = "_other"
 )
 
 // AggregatorConfig holds configuration for creating an Aggregator.
@@ -26,19 +50,46 @@ type AggregatorConfig struct {
 
 	// MaxGroups is the maximum number of distinct service destination
 	// group metrics to store within an aggregation period. Once this
-	// number of groups is reached, any new aggregation keys will cause
-	// individual metrics documents to be immediately published.
+	// number of groups is reached, spans with new aggregation keys are
+	// aggregated into an overflow group for their service, with the
+	// destination service resource "_other".
+	//
+	// One additional group is reserved for spans of services that have
+	// no overflow group when the limit is reached; these are recorded
+	// with the service name "_other".
 	MaxGroups int
 
+	// MaxGroupsPerService is the maximum number of distinct service
+	// destination groups to store for any one service (identified by
+	// name and environment) within an aggregation period. Once a service
+	// reaches this number of groups, its spans with new aggregation keys
+	// are aggregated into the service's overflow group.
+	//
+	// If MaxGroupsPerService is zero, services are limited only by
+	// MaxGroups.
+	MaxGroupsPerService int
+
 	// Interval is the interval between publishing of aggregated metrics.
-	// There may be additional metrics reported at arbitrary times if the
-	// aggregation groups fill up.
 	Interval time.Duration
 
 	// Logger is the logger for logging metrics aggregation/publishing.
 	//
 	// If Logger is nil, a new logger will be constructed.
 	Logger *logp.Logger
+
+	// HDRHistogramSignificantFigures is the number of significant figures
+	// to maintain in the HDR Histograms of span durations.
+	// HDRHistogramSignificantFigures must be in the range [1,5].
+	HDRHistogramSignificantFigures int
+
+	// Exemplars controls whether a sample of the trace, transaction,
+	// and span IDs of spans is recorded for each service destination
+	// group, and published with the metrics as exemplars. At most one
+	// exemplar is recorded for each power-of-two range of span durations.
+	//
+	// Exemplars are chosen as events are aggregated, so they may refer
+	// to traces which are later dropped by tail-based sampling.
+	Exemplars bool
 }
 
 // Validate validates the aggregator config.
@@ -49,9 +100,15 @@ func (config AggregatorConfig) Validate() error {
 	if config.MaxGroups <= 0 {
 		return errors.New("MaxGroups unspecified or negative")
 	}
+	if config.MaxGroupsPerService < 0 {
+		return errors.New("MaxGroupsPerService negative")
+	}
 	if config.Interval <= 0 {
 		return errors.New("Interval unspecified or negative")
 	}
+	if n := config.HDRHistogramSignificantFigures; n < 1 || n > 5 {
+		return errors.Errorf("HDRHistogramSignificantFigures (%d) outside range [1,5]", n)
+	}
 	return nil
 }
 
@@ -61,12 +118,18 @@ type Aggregator struct {
 	stopping chan struct{}
 	stopped  chan struct{}
 
-	config AggregatorConfig
+	config              AggregatorConfig
+	metrics             aggregatorMetrics
+	tooManyGroupsLogger *logp.Logger
 
 	mu               sync.RWMutex
 	active, inactive *metricsBuffer
 }
 
+type aggregatorMetrics struct {
+	overflowed int64
+}
+
 // NewAggregator returns a new Aggregator with the given config.
 func NewAggregator(config AggregatorConfig) (*Aggregator, error) {
 	if err := config.Validate(); err != nil {
@@ -76,11 +139,12 @@ func NewAggregator(config AggregatorConfig) (*Aggregator, error) {
 		config.Logger = logp.NewLogger(logs.SpanMetrics)
 	}
 	return &Aggregator{
-		stopping: make(chan struct{}),
-		stopped:  make(chan struct{}),
-		config:   config,
-		active:   newMetricsBuffer(config.MaxGroups),
-		inactive: newMetricsBuffer(config.MaxGroups),
+		stopping:            make(chan struct{}),
+		stopped:             make(chan struct{}),
+		config:              config,
+		tooManyGroupsLogger: config.Logger.WithOptions(logs.WithRateLimit(tooManyGroupsLoggerRateLimit)),
+		active:              newMetricsBuffer(config),
+		inactive:            newMetricsBuffer(config),
 	}, nil
 }
 
@@ -139,6 +203,25 @@ func (a *Aggregator) Stop(ctx context.Context) error {
 	return nil
 }
 
+// CollectMonitoring may be called to collect monitoring metrics from the
+// aggregation. It is intended to be used with libbeat/monitoring.NewFunc.
+//
+// The metrics should be added to the "apm-server.aggregation.spanmetrics" registry.
+func (a *Aggregator) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor) {
+	V.OnRegistryStart()
+	defer V.OnRegistryFinished()
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	m := a.active
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	monitoring.ReportInt(V, "active_groups", int64(m.entries))
+	monitoring.ReportInt(V, "overflowed", atomic.LoadInt64(&a.metrics.overflowed))
+}
+
 func (a *Aggregator) publish(ctx context.Context) error {
 	// We hold a.mu only long enough to swap the spanMetrics. This will
 	// be blocked by spanMetrics updates, which is OK, as we prefer not
@@ -148,7 +231,7 @@ func (a *Aggregator) publish(ctx context.Context) error {
 	a.active, a.inactive = a.inactive, a.active
 	a.mu.Unlock()
 
-	size := len(a.inactive.m)
+	size := a.inactive.entries
 	if size == 0 {
 		a.config.Logger.Debugf("no span metrics to publish")
 		return nil
@@ -157,10 +240,14 @@ func (a *Aggregator) publish(ctx context.Context) error {
 	now := time.Now()
 	metricsets := make([]transform.Transformable, 0, size)
 	for key, metrics := range a.inactive.m {
-		metricset := makeMetricset(now, key, metrics, a.config.Interval.Milliseconds())
+		metricset := makeMetricset(now, key, *metrics, a.config.Interval.Milliseconds())
 		metricsets = append(metricsets, &metricset)
 		delete(a.inactive.m, key)
 	}
+	a.inactive.entries = 0
+	for key := range a.inactive.services {
+		delete(a.inactive.services, key)
+	}
 	a.config.Logger.Debugf("publishing %d metricsets", len(metricsets))
 	return a.config.Report(ctx, publish.PendingReq{
 		Transformables: metricsets,
@@ -176,26 +263,23 @@ func (a *Aggregator) publish(ctx context.Context) error {
 func (a *Aggregator) ProcessTransformables(ctx context.Context, in []transform.Transformable) ([]transform.Transformable, error) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
-	out := in
 	for _, tf := range in {
 		if span, ok := tf.(*model.Span); ok {
-			if metricset := a.processSpan(span); metricset != nil {
-				out = append(out, metricset)
-			}
+			a.processSpan(span)
 		}
 	}
-	return out, nil
+	return in, nil
 }
 
-func (a *Aggregator) processSpan(span *model.Span) *model.Metricset {
+func (a *Aggregator) processSpan(span *model.Span) {
 	if span.DestinationService == nil || span.DestinationService.Resource == nil {
-		return nil
+		return
 	}
 	if span.RepresentativeCount <= 0 {
 		// RepresentativeCount is zero when the sample rate is unknown.
 		// We cannot calculate accurate span metrics without the sample
 		// rate, so we don't calculate any at all in this case.
-		return nil
+		return
 	}
 
 	key := aggregationKey{
@@ -206,40 +290,143 @@ func (a *Aggregator) processSpan(span *model.Span) *model.Metricset {
 		resource:           *span.DestinationService.Resource,
 	}
 	duration := time.Duration(span.Duration * float64(time.Millisecond))
-	metrics := spanMetrics{
-		count: span.RepresentativeCount,
-		sum:   float64(duration.Microseconds()) * span.RepresentativeCount,
+	var exemplar *model.MetricsetExemplar
+	if a.config.Exemplars && span.TraceID != "" {
+		exemplar = &model.MetricsetExemplar{
+			TraceID:       span.TraceID,
+			TransactionID: span.TransactionID,
+			SpanID:        span.ID,
+			Duration:      duration,
+		}
 	}
-	if a.active.storeOrUpdate(key, metrics) {
-		return nil
+	if a.active.storeOrUpdate(key, span.RepresentativeCount, duration, exemplar) {
+		return
 	}
-	metricset := makeMetricset(time.Now(), key, metrics, 0)
-	return &metricset
+	a.tooManyGroupsLogger.Warn(`
+Service destination group limit reached, aggregating excess service destination
+groups into "_other". This is typically caused by high cardinality destination
+service resources, e.g. by including unique identifiers in the resource.`[1:],
+	)
+	atomic.AddInt64(&a.metrics.overflowed, 1)
 }
 
 type metricsBuffer struct {
-	maxSize int
+	maxSize            int
+	maxSizePerService  int
+	significantFigures int
 
-	mu sync.RWMutex
-	m  map[aggregationKey]spanMetrics
+	mu       sync.RWMutex
+	entries  int
+	m        map[aggregationKey]*spanMetrics
+	services map[serviceKey]int
+	space    []spanMetrics
 }
 
-func newMetricsBuffer(maxSize int) *metricsBuffer {
+func newMetricsBuffer(config AggregatorConfig) *metricsBuffer {
 	return &metricsBuffer{
-		maxSize: maxSize,
-		m:       make(map[aggregationKey]spanMetrics),
+		maxSize:            config.MaxGroups,
+		maxSizePerService:  config.MaxGroupsPerService,
+		significantFigures: config.HDRHistogramSignificantFigures,
+		m:                  make(map[aggregationKey]*spanMetrics),
+		services:           make(map[serviceKey]int),
+		// Reserve an additional entry for the global overflow group.
+		space: make([]spanMetrics, config.MaxGroups+1),
 	}
 }
 
-func (mb *metricsBuffer) storeOrUpdate(key aggregationKey, value spanMetrics) bool {
+// storeOrUpdate records count, duration, and exemplar (if non-nil) in the
+// metrics for key, returning false if they were instead recorded in an
+// overflow group due to the group limits being reached.
+func (mb *metricsBuffer) storeOrUpdate(key aggregationKey, count float64, duration time.Duration, exemplar *model.MetricsetExemplar) bool {
 	mb.mu.Lock()
 	defer mb.mu.Unlock()
-	old, ok := mb.m[key]
-	if !ok && len(mb.m) == mb.maxSize {
+	metrics, ok := mb.m[key]
+	var overflowed bool
+	if !ok {
+		service := serviceKey{name: key.serviceName, environment: key.serviceEnvironment}
+		if mb.hasCapacityLocked(service) {
+			metrics = mb.newEntryLocked(key)
+			mb.services[service]++
+		} else {
+			metrics = mb.overflowEntryLocked(key)
+			overflowed = true
+		}
+	}
+	metrics.count += count
+	metrics.sum += float64(duration.Microseconds()) * count
+	metrics.histogram.RecordValues(
+		durationMicros(clampDuration(duration)),
+		int64(math.Round(count*histogramCountScale)),
+	)
+	if exemplar != nil {
+		metrics.exemplars.Offer(*exemplar, count)
+	}
+	return !overflowed
+}
+
+// hasCapacityLocked reports whether a new group may be created for
+// service without exceeding the total or per-service group limits.
+//
+// hasCapacityLocked must be called with mb.mu held for writing.
+func (mb *metricsBuffer) hasCapacityLocked(service serviceKey) bool {
+	if mb.entries >= mb.maxSize {
 		return false
 	}
-	mb.m[key] = spanMetrics{count: value.count + old.count, sum: value.sum + old.sum}
-	return true
+	return mb.maxSizePerService <= 0 || mb.services[service] < mb.maxSizePerService
+}
+
+// overflowEntryLocked returns the overflow group for key's service, creating
+// it if possible. If the total group limit has been reached and the service
+// has no overflow group, the global overflow group is returned instead.
+//
+// overflowEntryLocked must be called with mb.mu held for writing.
+func (mb *metricsBuffer) overflowEntryLocked(key aggregationKey) *spanMetrics {
+	overflowKey := aggregationKey{
+		serviceName:        key.serviceName,
+		serviceEnvironment: key.serviceEnvironment,
+		agentName:          key.agentName,
+		resource:           overflowBucketName,
+	}
+	if metrics, ok := mb.m[overflowKey]; ok {
+		return metrics
+	}
+	if mb.entries < mb.maxSize {
+		return mb.newEntryLocked(overflowKey)
+	}
+
+	overflowKey = aggregationKey{
+		serviceName: overflowBucketName,
+		resource:    overflowBucketName,
+	}
+	if metrics, ok := mb.m[overflowKey]; ok {
+		return metrics
+	}
+	// The global overflow group uses the space reserved for it
+	// beyond maxSize; see newMetricsBuffer.
+	return mb.newEntryLocked(overflowKey)
+}
+
+// newEntryLocked allocates and records a new entry for key.
+//
+// newEntryLocked must be called with mb.mu held for writing.
+func (mb *metricsBuffer) newEntryLocked(key aggregationKey) *spanMetrics {
+	// Reuse the space and histograms from previous aggregation periods.
+	metrics := &mb.space[mb.entries]
+	if metrics.histogram == nil {
+		metrics.histogram = hdrhistogram.New(
+			durationMicros(minDuration),
+			durationMicros(maxDuration),
+			mb.significantFigures,
+		)
+	} else {
+		metrics.histogram.Reset()
+	}
+	metrics.count = 0
+	metrics.sum = 0
+	metrics.exemplars.Reset()
+	mb.m[key] = metrics
+	mb.entries++
+	return metrics
 }
 
 type aggregationKey struct {
@@ -252,12 +439,38 @@ type aggregationKey struct {
 	outcome  string
 }
 
+// serviceKey identifies a service for the purposes of
+// limiting the number of groups per service.
+type serviceKey struct {
+	name        string
+	environment string
+}
+
 type spanMetrics struct {
-	count float64
-	sum   float64
+	count     float64
+	sum       float64
+	histogram *hdrhistogram.Histogram
+	exemplars exemplars.Reservoir
+}
+
+func (m *spanMetrics) histogramBuckets() (counts []int64, values []float64) {
+	// See the txmetrics package for details of the HDR histogram format.
+	distribution := m.histogram.Distribution()
+	counts = make([]int64, 0, len(distribution))
+	values = make([]float64, 0, len(distribution))
+	for _, b := range distribution {
+		if b.Count <= 0 {
+			continue
+		}
+		count := math.Round(float64(b.Count) / histogramCountScale)
+		counts = append(counts, int64(count))
+		values = append(values, float64(b.To))
+	}
+	return counts, values
 }
 
 func makeMetricset(timestamp time.Time, key aggregationKey, metrics spanMetrics, interval int64) model.Metricset {
+	counts, values := metrics.histogramBuckets()
 	out := model.Metricset{
 		Timestamp: timestamp,
 		Metadata: model.Metadata{
@@ -273,6 +486,7 @@ func makeMetricset(timestamp time.Time, key aggregationKey, metrics spanMetrics,
 		Span: model.MetricsetSpan{
 			DestinationService: model.DestinationService{Resource: &key.resource},
 		},
+		Exemplars: metrics.exemplars.Exemplars(),
 		Samples: []model.Sample{
 			{
 				Name:  "span.destination.service.response_time.count",
@@ -282,6 +496,11 @@ func makeMetricset(timestamp time.Time, key aggregationKey, metrics spanMetrics,
 				Name:  "span.destination.service.response_time.sum.us",
 				Value: math.Round(metrics.sum),
 			},
+			{
+				Name:   "span.destination.service.response_time.histogram",
+				Counts: counts,
+				Values: values,
+			},
 		},
 	}
 	if interval > 0 {
@@ -297,3 +516,16 @@ func makeMetricset(timestamp time.Time, key aggregationKey, metrics spanMetrics,
 	}
 	return out
 }
+
+func clampDuration(d time.Duration) time.Duration {
+	if d < minDuration {
+		return minDuration
+	} else if d > maxDuration {
+		return maxDuration
+	}
+	return d
+}
+
+func durationMicros(d time.Duration) int64 {
+	return int64(d / time.Microsecond)
+}
@@ -6,6 +6,7 @@ package spanmetrics
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"testing"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/elastic/apm-server/model"
 	"github.com/elastic/apm-server/publish"
 	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
 )
 
 func BenchmarkAggregateSpan(b *testing.B) {
@@ -23,6 +25,8 @@ func BenchmarkAggregateSpan(b *testing.B) {
 		Report:    makeErrReporter(nil),
 		Interval:  time.Minute,
 		MaxGroups: 1000,
+
+		HDRHistogramSignificantFigures: 5,
 	})
 	require.NoError(b, err)
 
@@ -53,12 +57,34 @@ func TestNewAggregatorConfigInvalid(t *testing.T) {
 			Report: report,
 		},
 		err: "MaxGroups unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:              report,
+			MaxGroups:           1,
+			MaxGroupsPerService: -1,
+		},
+		err: "MaxGroupsPerService negative",
 	}, {
 		config: AggregatorConfig{
 			Report:    report,
 			MaxGroups: 1,
 		},
 		err: "Interval unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+			Interval:  time.Nanosecond,
+		},
+		err: "HDRHistogramSignificantFigures (0) outside range [1,5]",
+	}, {
+		config: AggregatorConfig{
+			Report:                         report,
+			MaxGroups:                      1,
+			Interval:                       time.Nanosecond,
+			HDRHistogramSignificantFigures: 6,
+		},
+		err: "HDRHistogramSignificantFigures (6) outside range [1,5]",
 	}} {
 		agg, err := NewAggregator(test.config)
 		require.Error(t, err)
@@ -73,6 +99,8 @@ func TestAggregatorRun(t *testing.T) {
 		Report:    makeChanReporter(reqs),
 		Interval:  10 * time.Millisecond,
 		MaxGroups: 1000,
+
+		HDRHistogramSignificantFigures: 5,
 	})
 	require.NoError(t, err)
 
@@ -138,6 +166,7 @@ func TestAggregatorRun(t *testing.T) {
 		Samples: []model.Sample{
 			{Name: "span.destination.service.response_time.count", Value: 100.0},
 			{Name: "span.destination.service.response_time.sum.us", Value: 10000000.0},
+			{Name: "span.destination.service.response_time.histogram", Counts: []int64{100}, Values: []float64{100000}},
 			{Name: "metricset.period", Value: 10},
 		},
 	}, {
@@ -153,6 +182,7 @@ func TestAggregatorRun(t *testing.T) {
 		Samples: []model.Sample{
 			{Name: "span.destination.service.response_time.count", Value: 100.0},
 			{Name: "span.destination.service.response_time.sum.us", Value: 10000000.0},
+			{Name: "span.destination.service.response_time.histogram", Counts: []int64{100}, Values: []float64{100000}},
 			{Name: "metricset.period", Value: 10},
 		},
 	}, {
@@ -168,6 +198,7 @@ func TestAggregatorRun(t *testing.T) {
 		Samples: []model.Sample{
 			{Name: "span.destination.service.response_time.count", Value: 300.0},
 			{Name: "span.destination.service.response_time.sum.us", Value: 30000000.0},
+			{Name: "span.destination.service.response_time.histogram", Counts: []int64{300}, Values: []float64{100000}},
 			{Name: "metricset.period", Value: 10},
 		},
 	}, {
@@ -183,6 +214,7 @@ func TestAggregatorRun(t *testing.T) {
 		Samples: []model.Sample{
 			{Name: "span.destination.service.response_time.count", Value: 100.0},
 			{Name: "span.destination.service.response_time.sum.us", Value: 10000000.0},
+			{Name: "span.destination.service.response_time.histogram", Counts: []int64{100}, Values: []float64{100000}},
 			{Name: "metricset.period", Value: 10},
 		},
 	}}, metricsets)
@@ -194,59 +226,149 @@ func TestAggregatorRun(t *testing.T) {
 	}
 }
 
-func TestAggregatorOverflow(t *testing.T) {
+func TestAggregatorHistogram(t *testing.T) {
 	reqs := make(chan publish.PendingReq, 1)
 	agg, err := NewAggregator(AggregatorConfig{
 		Report:    makeChanReporter(reqs),
 		Interval:  10 * time.Millisecond,
-		MaxGroups: 2,
+		MaxGroups: 1000,
+
+		HDRHistogramSignificantFigures: 5,
 	})
 	require.NoError(t, err)
 
-	// The first two transaction groups will not require immediate publication,
-	// as we have configured the spanmetrics with a maximum of two buckets.
+	var input []transform.Transformable
+	for _, duration := range []time.Duration{time.Millisecond, 10 * time.Millisecond, time.Millisecond, 100 * time.Millisecond} {
+		input = append(input, makeSpan("service", "agent", "destination", "success", duration, 2.5))
+	}
+	_, err = agg.ProcessTransformables(context.Background(), input)
+	require.NoError(t, err)
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	req := expectPublish(t, reqs)
+	require.Len(t, req.Transformables, 1)
+	ms := req.Transformables[0].(*model.Metricset)
+	require.Len(t, ms.Samples, 4)
+
+	// Counts are scaled by the representative count of each span.
+	assert.Equal(t, model.Sample{
+		Name:   "span.destination.service.response_time.histogram",
+		Counts: []int64{5, 3, 3},
+		Values: []float64{1000, 10000, 100000},
+	}, ms.Samples[2])
+}
+
+func TestAggregatorExemplars(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:    makeChanReporter(reqs),
+		Interval:  10 * time.Millisecond,
+		MaxGroups: 1000,
+		Exemplars: true,
+
+		HDRHistogramSignificantFigures: 5,
+	})
+	require.NoError(t, err)
+
+	var input []transform.Transformable
+	for i, duration := range []time.Duration{time.Millisecond, 100 * time.Millisecond} {
+		span := makeSpan("service", "agent", "destination", "success", duration, 1)
+		span.TraceID = fmt.Sprintf("trace%d", i)
+		span.TransactionID = fmt.Sprintf("tx%d", i)
+		span.ID = fmt.Sprintf("span%d", i)
+		input = append(input, span)
+	}
+	_, err = agg.ProcessTransformables(context.Background(), input)
+	require.NoError(t, err)
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	req := expectPublish(t, reqs)
+	require.Len(t, req.Transformables, 1)
+	ms := req.Transformables[0].(*model.Metricset)
+	assert.Equal(t, []model.MetricsetExemplar{
+		{TraceID: "trace0", TransactionID: "tx0", SpanID: "span0", Duration: time.Millisecond},
+		{TraceID: "trace1", TransactionID: "tx1", SpanID: "span1", Duration: 100 * time.Millisecond},
+	}, ms.Exemplars)
+}
+
+func TestAggregatorOverflow(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:              makeChanReporter(reqs),
+		Interval:            10 * time.Millisecond,
+		MaxGroups:           4,
+		MaxGroupsPerService: 2,
+
+		HDRHistogramSignificantFigures: 5,
+	})
+	require.NoError(t, err)
+
+	// The first two groups of service-a fit within its budget.
 	var input []transform.Transformable
 	for i := 0; i < 10; i++ {
-		input = append(input, makeSpan("service", "agent", "destination1", "success", 100*time.Millisecond, 1))
-		input = append(input, makeSpan("service", "agent", "destination2", "success", 100*time.Millisecond, 1))
+		input = append(input, makeSpan("service-a", "agent", "destination1", "success", 100*time.Millisecond, 1))
+		input = append(input, makeSpan("service-a", "agent", "destination2", "success", 100*time.Millisecond, 1))
+	}
+	// The third group of service-a exceeds its budget, and is
+	// aggregated into its overflow group.
+	for i := 0; i < 2; i++ {
+		input = append(input, makeSpan("service-a", "agent", "destination3", "failure", 100*time.Millisecond, 1))
 	}
+	// The second group of service-b exceeds the total group limit. There
+	// is no room for an overflow group for service-b, so it is aggregated
+	// into the global overflow group.
+	input = append(input, makeSpan("service-b", "agent", "destination1", "success", 100*time.Millisecond, 1))
+	input = append(input, makeSpan("service-b", "agent", "destination2", "success", 100*time.Millisecond, 1))
+
 	output, err := agg.ProcessTransformables(context.Background(), input)
 	require.NoError(t, err)
 	assert.Equal(t, input, output)
 
-	// The third group will return a metricset for immediate publication.
-	for i := 0; i < 2; i++ {
-		input = append(input, makeSpan("service", "agent", "destination3", "success", 100*time.Millisecond, 1))
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["spanmetrics.active_groups"] = 5
+	expectedMonitoring.Ints["spanmetrics.overflowed"] = 3
+
+	registry := monitoring.NewRegistry()
+	monitoring.NewFunc(registry, "spanmetrics", agg.CollectMonitoring)
+	assert.Equal(t, expectedMonitoring, monitoring.CollectFlatSnapshot(
+		registry,
+		monitoring.Full,
+		false, // expvar
+	))
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	type group struct {
+		serviceName string
+		agentName   string
+		resource    string
+		outcome     string
+		count       float64
 	}
-	output, err = agg.ProcessTransformables(context.Background(), input)
-	require.NoError(t, err)
-	assert.Len(t, output, len(input)+2)
-	assert.Equal(t, input, output[:len(input)])
-
-	for _, tf := range output[len(input):] {
-		m, ok := tf.(*model.Metricset)
-		require.True(t, ok)
-		require.NotNil(t, m)
-		require.False(t, m.Timestamp.IsZero())
-
-		m.Timestamp = time.Time{}
-		assert.Equal(t, &model.Metricset{
-			Metadata: model.Metadata{
-				Service: model.Service{Name: "service", Agent: model.Agent{Name: "agent"}},
-			},
-			Event: model.MetricsetEventCategorization{
-				Outcome: "success",
-			},
-			Span: model.MetricsetSpan{
-				DestinationService: model.DestinationService{Resource: newString("destination3")},
-			},
-			Samples: []model.Sample{
-				{Name: "span.destination.service.response_time.count", Value: 1.0},
-				{Name: "span.destination.service.response_time.sum.us", Value: 100000.0},
-				// No metricset.period is recorded as these metrics are instantanous, not aggregated.
-			},
-		}, m)
+	var groups []group
+	req := expectPublish(t, reqs)
+	for _, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		groups = append(groups, group{
+			serviceName: ms.Metadata.Service.Name,
+			agentName:   ms.Metadata.Service.Agent.Name,
+			resource:    *ms.Span.DestinationService.Resource,
+			outcome:     ms.Event.Outcome,
+			count:       ms.Samples[0].Value,
+		})
 	}
+	assert.ElementsMatch(t, []group{
+		{serviceName: "service-a", agentName: "agent", resource: "destination1", outcome: "success", count: 10},
+		{serviceName: "service-a", agentName: "agent", resource: "destination2", outcome: "success", count: 10},
+		{serviceName: "service-a", agentName: "agent", resource: "_other", count: 2},
+		{serviceName: "service-b", agentName: "agent", resource: "destination1", outcome: "success", count: 1},
+		{serviceName: "_other", resource: "_other", count: 1},
+	}, groups)
 }
 
 func makeSpan(
@@ -24,6 +24,7 @@ import (
 	"github.com/elastic/apm-server/model"
 	"github.com/elastic/apm-server/publish"
 	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/apm-server/x-pack/apm-server/aggregation/exemplars"
 )
 
 const (
@@ -42,6 +43,11 @@ const (
 	// tooManyGroupsLoggerRateLimit is the maximum frequency at which
 	// "too many groups" log messages are logged.
 	tooManyGroupsLoggerRateLimit = time.Minute
+
+	// overflowBucketName is the transaction name (and, for the global
+	// overflow group, the service name) recorded for transactions which
+	// are aggregated into an overflow group.
+	overflowBucketName = "_other"
 )
 
 // Aggregator aggregates transaction durations, periodically publishing histogram metrics.
@@ -56,6 +62,23 @@ type Aggregator struct {
 
 	mu               sync.RWMutex
 	active, inactive *metrics
+
+	// rollups holds metrics for each of the configured RollupIntervals.
+	// rollups, ticks, and started are only accessed by the publishing
+	// goroutine.
+	rollups []*rollup
+	ticks   int
+	started time.Time
+}
+
+// rollup holds transaction metrics aggregated over a multiple of
+// MetricsInterval, merged from the histograms published at each
+// MetricsInterval.
+type rollup struct {
+	interval time.Duration
+	periods  int
+	metrics  *metrics
+	started  time.Time
 }
 
 type aggregatorMetrics struct {
@@ -74,19 +97,69 @@ type AggregatorConfig struct {
 
 	// MaxTransactionGroups is the maximum number of distinct transaction
 	// group metrics to store within an aggregation period. Once this number
-	// of groups has been reached, any new aggregation keys will cause
-	// individual metrics documents to be immediately published.
+	// of groups has been reached, transactions with new aggregation keys
+	// are aggregated into an overflow group for their service, with the
+	// transaction name "_other".
+	//
+	// One additional group is reserved for transactions of services that
+	// have no overflow group when the limit is reached; these are recorded
+	// with the service name "_other".
 	MaxTransactionGroups int
 
+	// MaxTransactionGroupsPerService is the maximum number of distinct
+	// transaction groups to store for any one service (identified by name
+	// and environment) within an aggregation period. Once a service reaches
+	// this number of groups, its transactions with new aggregation keys are
+	// aggregated into the service's overflow group.
+	//
+	// If MaxTransactionGroupsPerService is zero, services are limited only
+	// by MaxTransactionGroups.
+	MaxTransactionGroupsPerService int
+
 	// MetricsInterval is the interval between publishing of aggregated
-	// metrics. There may be additional metrics reported at arbitrary
-	// times if the aggregation groups fill up.
+	// metrics.
 	MetricsInterval time.Duration
 
+	// RollupIntervals holds additional, coarser intervals at which
+	// aggregated metrics are published. Each rollup interval must be a
+	// multiple of MetricsInterval, and the rollups are computed by merging
+	// the histograms published at each MetricsInterval.
+	//
+	// Metricsets are published with metricset.period set to the interval
+	// over which they were aggregated, and rollups are published with
+	// model.Metricset.RollupInterval set, recording the rollup interval
+	// in metricset.interval to distinguish them from each other and from
+	// the metrics published at MetricsInterval.
+	//
+	// Rollups are subject to the same group limits as MetricsInterval,
+	// so transaction groups from different MetricsInterval periods may
+	// be aggregated into overflow groups in the rollups.
+	RollupIntervals []time.Duration
+
 	// HDRHistogramSignificantFigures is the number of significant figures
 	// to maintain in the HDR Histograms. HDRHistogramSignificantFigures
 	// must be in the range [1,5].
 	HDRHistogramSignificantFigures int
+
+	// ExtraDimensions holds the names of additional fields by which
+	// transaction metrics are aggregated, in addition to the fixed set of
+	// service, host, and transaction fields. Each name must be of the form
+	// "labels.<key>", or one of the supported metadata fields such as
+	// "cloud.region".
+	//
+	// Each extra dimension multiplies the number of transaction groups
+	// by the number of distinct values it takes, so MaxTransactionGroups
+	// may need to be increased accordingly.
+	ExtraDimensions []string
+
+	// Exemplars controls whether a sample of the trace and transaction
+	// IDs of sampled transactions is recorded for each transaction group,
+	// and published with the metrics as exemplars. At most one exemplar
+	// is recorded for each power-of-two range of transaction durations.
+	//
+	// Exemplars are chosen as events are aggregated, so they may refer
+	// to traces which are later dropped by tail-based sampling.
+	Exemplars bool
 }
 
 // Validate validates the aggregator config.
@@ -97,12 +170,33 @@ func (config AggregatorConfig) Validate() error {
 	if config.MaxTransactionGroups <= 0 {
 		return errors.New("MaxTransactionGroups unspecified or negative")
 	}
+	if config.MaxTransactionGroupsPerService < 0 {
+		return errors.New("MaxTransactionGroupsPerService negative")
+	}
 	if config.MetricsInterval <= 0 {
 		return errors.New("MetricsInterval unspecified or negative")
 	}
+	for i, interval := range config.RollupIntervals {
+		if interval <= config.MetricsInterval || interval%config.MetricsInterval != 0 {
+			return errors.Errorf(
+				"RollupIntervals (%s) must be greater than, and a multiple of, MetricsInterval (%s)",
+				interval, config.MetricsInterval,
+			)
+		}
+		for _, other := range config.RollupIntervals[:i] {
+			if interval == other {
+				return errors.Errorf("RollupIntervals (%s) duplicated", interval)
+			}
+		}
+	}
 	if n := config.HDRHistogramSignificantFigures; n < 1 || n > 5 {
 		return errors.Errorf("HDRHistogramSignificantFigures (%d) outside range [1,5]", n)
 	}
+	for _, name := range config.ExtraDimensions {
+		if err := validateDimension(name); err != nil {
+			return errors.Wrap(err, "invalid ExtraDimensions")
+		}
+	}
 	return nil
 }
 
@@ -114,6 +208,14 @@ func NewAggregator(config AggregatorConfig) (*Aggregator, error) {
 	if config.Logger == nil {
 		config.Logger = logp.NewLogger(logs.TransactionMetrics)
 	}
+	rollups := make([]*rollup, len(config.RollupIntervals))
+	for i, interval := range config.RollupIntervals {
+		rollups[i] = &rollup{
+			interval: interval,
+			periods:  int(interval / config.MetricsInterval),
+			metrics:  newMetrics(config.MaxTransactionGroups),
+		}
+	}
 	return &Aggregator{
 		stopping:            make(chan struct{}),
 		stopped:             make(chan struct{}),
@@ -121,15 +223,24 @@ func NewAggregator(config AggregatorConfig) (*Aggregator, error) {
 		tooManyGroupsLogger: config.Logger.WithOptions(logs.WithRateLimit(tooManyGroupsLoggerRateLimit)),
 		active:              newMetrics(config.MaxTransactionGroups),
 		inactive:            newMetrics(config.MaxTransactionGroups),
+		rollups:             rollups,
 	}, nil
 }
 
 // Run runs the Aggregator, periodically publishing and clearing aggregated
 // metrics. Run returns when either a fatal error occurs, or the Aggregator's
 // Stop method is invoked.
+//
+// When the Aggregator is stopped, any partially aggregated rollups are
+// published along with the final metrics, recording the period elapsed
+// since they were last published.
 func (a *Aggregator) Run() error {
 	ticker := time.NewTicker(a.config.MetricsInterval)
 	defer ticker.Stop()
+	a.started = time.Now()
+	for _, r := range a.rollups {
+		r.started = a.started
+	}
 	defer func() {
 		a.stopMu.Lock()
 		defer a.stopMu.Unlock()
@@ -146,7 +257,8 @@ func (a *Aggregator) Run() error {
 			stop = true
 		case <-ticker.C:
 		}
-		if err := a.publish(context.Background()); err != nil {
+		a.ticks++
+		if err := a.publish(context.Background(), stop); err != nil {
 			a.config.Logger.With(logp.Error(err)).Warnf(
 				"publishing transaction metrics failed: %s", err,
 			)
@@ -198,7 +310,11 @@ func (a *Aggregator) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor)
 	monitoring.ReportInt(V, "overflowed", atomic.LoadInt64(&a.metrics.overflowed))
 }
 
-func (a *Aggregator) publish(ctx context.Context) error {
+// publish publishes the metrics aggregated since the last call, merging
+// them into the rollups, and publishes any rollups whose interval has
+// elapsed. If flush is true, all rollups are published regardless, with
+// the period elapsed since they were last published.
+func (a *Aggregator) publish(ctx context.Context, flush bool) error {
 	// We hold a.mu only long enough to swap the metrics. This will
 	// be blocked by metrics updates, which is OK, as we prefer not
 	// to block metrics updaters. After the lock is released nothing
@@ -207,25 +323,35 @@ func (a *Aggregator) publish(ctx context.Context) error {
 	a.active, a.inactive = a.inactive, a.active
 	a.mu.Unlock()
 
-	if a.inactive.entries == 0 {
-		a.config.Logger.Debugf("no metrics to publish")
-		return nil
+	now := time.Now()
+	period := a.config.MetricsInterval
+	if flush {
+		period = elapsedPeriod(a.started, now, period)
 	}
+	a.started = now
 
-	// TODO(axw) record either the aggregation interval in effect, or
-	// the specific time period (date_range) on the metrics documents.
-
-	now := time.Now()
-	metricsets := make([]transform.Transformable, 0, a.inactive.entries)
-	for hash, entries := range a.inactive.m {
-		for _, entry := range entries {
-			counts, values := entry.transactionMetrics.histogramBuckets()
-			metricset := makeMetricset(entry.transactionAggregationKey, hash, now, counts, values)
-			metricsets = append(metricsets, &metricset)
+	var metricsets []transform.Transformable
+	if a.inactive.entries > 0 {
+		for _, r := range a.rollups {
+			a.mergeRollup(r.metrics, a.inactive)
 		}
-		delete(a.inactive.m, hash)
+		metricsets = a.appendMetricsets(metricsets, a.inactive, 0, period, now)
+	}
+	for _, r := range a.rollups {
+		if !flush && a.ticks%r.periods != 0 {
+			continue
+		}
+		period := r.interval
+		if flush {
+			period = elapsedPeriod(r.started, now, period)
+		}
+		metricsets = a.appendMetricsets(metricsets, r.metrics, r.interval, period, now)
+		r.started = now
+	}
+	if len(metricsets) == 0 {
+		a.config.Logger.Debugf("no metrics to publish")
+		return nil
 	}
-	a.inactive.entries = 0
 
 	a.config.Logger.Debugf("publishing %d metricsets", len(metricsets))
 	return a.config.Report(ctx, publish.PendingReq{
@@ -234,58 +360,112 @@ func (a *Aggregator) publish(ctx context.Context) error {
 	})
 }
 
+// appendMetricsets appends a metricset for each of the groups in m, which
+// were aggregated over the given period, to out, and then clears m.
+//
+// If the metrics are rolled up, rollupInterval holds the configured rollup
+// interval; period may be shorter if the rollup is flushed early.
+func (a *Aggregator) appendMetricsets(out []transform.Transformable, m *metrics, rollupInterval, period time.Duration, ts time.Time) []transform.Transformable {
+	for hash, entries := range m.m {
+		for _, entry := range entries {
+			counts, values := entry.transactionMetrics.histogramBuckets()
+			metricset := a.makeMetricset(entry.transactionAggregationKey, hash, ts, rollupInterval, period, counts, values)
+			metricset.Exemplars = entry.transactionMetrics.exemplars.Exemplars()
+			out = append(out, &metricset)
+		}
+		delete(m.m, hash)
+	}
+	m.entries = 0
+	for key := range m.services {
+		delete(m.services, key)
+	}
+	return out
+}
+
+// elapsedPeriod returns the period elapsed between start and now, up to
+// interval, for metrics published before the end of their interval.
+func elapsedPeriod(start, now time.Time, interval time.Duration) time.Duration {
+	if elapsed := now.Sub(start); elapsed >= 0 && elapsed < interval {
+		return elapsed
+	}
+	return interval
+}
+
+// mergeRollup merges the histograms of each group in "from" into the
+// corresponding group in "to", subject to the same group limits as
+// transactions aggregated by AggregateTransaction.
+func (a *Aggregator) mergeRollup(to, from *metrics) {
+	to.mu.Lock()
+	defer to.mu.Unlock()
+	for hash, entries := range from.m {
+		for _, from := range entries {
+			key := from.transactionAggregationKey
+			entry := to.searchLocked(key, hash, 0)
+			if entry == nil {
+				service := serviceKey{name: key.serviceName, environment: key.serviceEnvironment}
+				if a.hasCapacityLocked(to, service) {
+					entry = a.newEntryLocked(to, key, hash)
+					to.services[service]++
+				} else {
+					entry = a.overflowEntryLocked(to, key)
+				}
+			}
+			entry.histogram.Merge(from.histogram)
+			entry.exemplars.Merge(&from.exemplars)
+		}
+	}
+}
+
 // ProcessTransformables aggregates all transactions contained in
-// "in", returning the input with any metricsets requiring immediate
-// publication appended.
+// "in", returning the input unmodified.
 //
 // This method is expected to be used immediately prior to publishing
-// the events, so that the metricsets requiring immediate publication
-// can be included in the same batch.
+// the events.
 func (a *Aggregator) ProcessTransformables(ctx context.Context, in []transform.Transformable) ([]transform.Transformable, error) {
-	out := in
 	for _, tf := range in {
 		if tx, ok := tf.(*model.Transaction); ok {
-			if metricset := a.AggregateTransaction(tx); metricset != nil {
-				out = append(out, metricset)
-			}
+			a.AggregateTransaction(tx)
 		}
 	}
-	return out, nil
+	return in, nil
 }
 
 // AggregateTransaction aggregates transaction metrics.
 //
-// If the transaction cannot be aggregated due to the maximum number
-// of transaction groups being exceeded, then a *model.Metricset will
-// be returned which should be published immediately, along with the
-// transaction. Otherwise, the returned metricset will be nil.
-func (a *Aggregator) AggregateTransaction(tx *model.Transaction) *model.Metricset {
+// If the transaction would exceed the maximum number of transaction
+// groups, either in total or for its service, then it is aggregated
+// into an overflow group.
+func (a *Aggregator) AggregateTransaction(tx *model.Transaction) {
 	if tx.RepresentativeCount <= 0 {
-		return nil
+		return
 	}
 
 	key := a.makeTransactionAggregationKey(tx)
 	hash := key.hash()
-	count := transactionCount(tx)
 	duration := time.Duration(tx.Duration * float64(time.Millisecond))
-	if a.updateTransactionMetrics(key, hash, tx.RepresentativeCount, duration) {
-		return nil
+	var exemplar *model.MetricsetExemplar
+	if a.config.Exemplars && tx.TraceID != "" && (tx.Sampled == nil || *tx.Sampled) {
+		exemplar = &model.MetricsetExemplar{
+			TraceID:       tx.TraceID,
+			TransactionID: tx.ID,
+			Duration:      duration,
+		}
+	}
+	if a.updateTransactionMetrics(key, hash, tx.RepresentativeCount, duration, exemplar) {
+		return
 	}
-	// Too many aggregation keys: could not update metrics, so immediately
-	// publish a single-value metric document.
 	a.tooManyGroupsLogger.Warn(`
-Transaction group limit reached, falling back to sending individual metric documents.
+Transaction group limit reached, aggregating excess transaction groups into "_other".
 This is typically caused by ineffective transaction grouping, e.g. by creating many
 unique transaction names.`[1:],
 	)
 	atomic.AddInt64(&a.metrics.overflowed, 1)
-	counts := []int64{int64(math.Round(count))}
-	values := []float64{float64(durationMicros(duration))}
-	metricset := makeMetricset(key, hash, time.Now(), counts, values)
-	return &metricset
 }
 
-func (a *Aggregator) updateTransactionMetrics(key transactionAggregationKey, hash uint64, count float64, duration time.Duration) bool {
+// updateTransactionMetrics records duration, count, and exemplar (if non-nil)
+// in the metrics for key, returning false if the transaction was instead
+// recorded in an overflow group due to the group limits being reached.
+func (a *Aggregator) updateTransactionMetrics(key transactionAggregationKey, hash uint64, count float64, duration time.Duration, exemplar *model.MetricsetExemplar) bool {
 	if duration < minDuration {
 		duration = minDuration
 	} else if duration > maxDuration {
@@ -303,7 +483,7 @@ func (a *Aggregator) updateTransactionMetrics(key transactionAggregationKey, has
 	if ok {
 		for offset = range entries {
 			if entries[offset].transactionAggregationKey == key {
-				entries[offset].recordDuration(duration, count)
+				entries[offset].record(duration, count, exemplar)
 				return true
 			}
 		}
@@ -311,19 +491,72 @@ func (a *Aggregator) updateTransactionMetrics(key transactionAggregationKey, has
 	}
 
 	m.mu.Lock()
-	entries, ok = m.m[hash]
-	if ok {
-		for i := range entries[offset:] {
-			if entries[offset+i].transactionAggregationKey == key {
-				m.mu.Unlock()
-				entries[offset+i].recordDuration(duration, count)
-				return true
-			}
+	entry := m.searchLocked(key, hash, offset)
+	var overflowed bool
+	if entry == nil {
+		service := serviceKey{name: key.serviceName, environment: key.serviceEnvironment}
+		if a.hasCapacityLocked(m, service) {
+			entry = a.newEntryLocked(m, key, hash)
+			m.services[service]++
+		} else {
+			entry = a.overflowEntryLocked(m, key)
+			overflowed = true
 		}
-	} else if m.entries >= len(m.space) {
-		m.mu.Unlock()
+	}
+	m.mu.Unlock()
+	entry.record(duration, count, exemplar)
+	return !overflowed
+}
+
+// hasCapacityLocked reports whether a new transaction group may be created
+// for service without exceeding the total or per-service group limits.
+//
+// hasCapacityLocked must be called with m.mu held for writing.
+func (a *Aggregator) hasCapacityLocked(m *metrics, service serviceKey) bool {
+	if m.entries >= a.config.MaxTransactionGroups {
 		return false
 	}
+	perService := a.config.MaxTransactionGroupsPerService
+	return perService <= 0 || m.services[service] < perService
+}
+
+// overflowEntryLocked returns the overflow group for key's service, creating
+// it if possible. If the total group limit has been reached and the service
+// has no overflow group, the global overflow group is returned instead.
+//
+// overflowEntryLocked must be called with m.mu held for writing.
+func (a *Aggregator) overflowEntryLocked(m *metrics, key transactionAggregationKey) *metricsMapEntry {
+	overflowKey := transactionAggregationKey{
+		agentName:          key.agentName,
+		serviceEnvironment: key.serviceEnvironment,
+		serviceName:        key.serviceName,
+		transactionName:    overflowBucketName,
+	}
+	hash := overflowKey.hash()
+	if entry := m.searchLocked(overflowKey, hash, 0); entry != nil {
+		return entry
+	}
+	if m.entries < a.config.MaxTransactionGroups {
+		return a.newEntryLocked(m, overflowKey, hash)
+	}
+
+	overflowKey = transactionAggregationKey{
+		serviceName:     overflowBucketName,
+		transactionName: overflowBucketName,
+	}
+	hash = overflowKey.hash()
+	if entry := m.searchLocked(overflowKey, hash, 0); entry != nil {
+		return entry
+	}
+	// The global overflow group uses the space reserved for it
+	// beyond MaxTransactionGroups; see newMetrics.
+	return a.newEntryLocked(m, overflowKey, hash)
+}
+
+// newEntryLocked allocates and records a new entry for key.
+//
+// newEntryLocked must be called with m.mu held for writing.
+func (a *Aggregator) newEntryLocked(m *metrics, key transactionAggregationKey, hash uint64) *metricsMapEntry {
 	entry := &m.space[m.entries]
 	entry.transactionAggregationKey = key
 	if entry.transactionMetrics.histogram == nil {
@@ -335,11 +568,10 @@ func (a *Aggregator) updateTransactionMetrics(key transactionAggregationKey, has
 	} else {
 		entry.transactionMetrics.histogram.Reset()
 	}
-	entry.recordDuration(duration, count)
-	m.m[hash] = append(entries, entry)
+	entry.transactionMetrics.exemplars.Reset()
+	m.m[hash] = append(m.m[hash], entry)
 	m.entries++
-	m.mu.Unlock()
-	return true
+	return entry
 }
 
 func (a *Aggregator) makeTransactionAggregationKey(tx *model.Transaction) transactionAggregationKey {
@@ -358,13 +590,18 @@ func (a *Aggregator) makeTransactionAggregationKey(tx *model.Transaction) transa
 		hostname:          tx.Metadata.System.Hostname(),
 		containerID:       tx.Metadata.System.Container.ID,
 		kubernetesPodName: tx.Metadata.System.Kubernetes.PodName,
+
+		extraDimensions: a.extraDimensionValues(tx),
 	}
 }
 
-// makeMetricset makes a Metricset from key, counts, and values, with timestamp ts.
-func makeMetricset(key transactionAggregationKey, hash uint64, ts time.Time, counts []int64, values []float64) model.Metricset {
+// makeMetricset makes a Metricset from key, counts, and values, aggregated
+// over period, with timestamp ts. If rollupInterval is non-zero, the
+// metricset is a rollup over that interval.
+func (a *Aggregator) makeMetricset(key transactionAggregationKey, hash uint64, ts time.Time, rollupInterval, period time.Duration, counts []int64, values []float64) model.Metricset {
 	out := model.Metricset{
-		Timestamp: ts,
+		Timestamp:      ts,
+		RollupInterval: rollupInterval,
 		Metadata: model.Metadata{
 			Service: model.Service{
 				Name:        key.serviceName,
@@ -391,8 +628,12 @@ func makeMetricset(key transactionAggregationKey, hash uint64, ts time.Time, cou
 			Name:   "transaction.duration.histogram",
 			Counts: counts,
 			Values: values,
+		}, {
+			Name:  "metricset.period",
+			Value: float64(period.Milliseconds()),
 		}},
 	}
+	setExtraDimensions(&out, a.config.ExtraDimensions, key.extraDimensions)
 
 	// Record an timeseries instance ID, which should be uniquely identify the aggregation key.
 	var timeseriesInstanceID strings.Builder
@@ -401,25 +642,58 @@ func makeMetricset(key transactionAggregationKey, hash uint64, ts time.Time, cou
 	timeseriesInstanceID.WriteString(key.transactionName)
 	timeseriesInstanceID.WriteRune(':')
 	timeseriesInstanceID.WriteString(fmt.Sprintf("%x", hash))
+	if rollupInterval > 0 {
+		// Rollups are distinct timeseries from those published
+		// at MetricsInterval, so they are identified separately.
+		timeseriesInstanceID.WriteRune(':')
+		timeseriesInstanceID.WriteString(rollupInterval.String())
+	}
 	out.TimeseriesInstanceID = timeseriesInstanceID.String()
 
 	return out
 }
 
 type metrics struct {
-	mu      sync.RWMutex
-	entries int
-	m       map[uint64][]*metricsMapEntry
-	space   []metricsMapEntry
+	mu       sync.RWMutex
+	entries  int
+	m        map[uint64][]*metricsMapEntry
+	services map[serviceKey]int
+	space    []metricsMapEntry
 }
 
 func newMetrics(maxGroups int) *metrics {
 	return &metrics{
-		m:     make(map[uint64][]*metricsMapEntry),
-		space: make([]metricsMapEntry, maxGroups),
+		m:        make(map[uint64][]*metricsMapEntry),
+		services: make(map[serviceKey]int),
+		// Reserve an additional entry for the global overflow group.
+		space: make([]metricsMapEntry, maxGroups+1),
 	}
 }
 
+// searchLocked returns the entry for key, searching from the given
+// offset within the entries for hash, or nil if there is no such entry.
+//
+// searchLocked must be called with m.mu held.
+func (m *metrics) searchLocked(key transactionAggregationKey, hash uint64, offset int) *metricsMapEntry {
+	entries := m.m[hash]
+	if offset > len(entries) {
+		return nil
+	}
+	for _, entry := range entries[offset:] {
+		if entry.transactionAggregationKey == key {
+			return entry
+		}
+	}
+	return nil
+}
+
+// serviceKey identifies a service for the purposes of
+// limiting the number of transaction groups per service.
+type serviceKey struct {
+	name        string
+	environment string
+}
+
 type metricsMapEntry struct {
 	transactionAggregationKey
 	transactionMetrics
@@ -438,6 +712,10 @@ type transactionAggregationKey struct {
 	transactionOutcome string
 	transactionResult  string
 	transactionType    string
+
+	// extraDimensions holds the values of the configured
+	// extra dimensions, encoded by extraDimensionValues.
+	extraDimensions string
 }
 
 func (k *transactionAggregationKey) hash() uint64 {
@@ -456,11 +734,28 @@ func (k *transactionAggregationKey) hash() uint64 {
 	h.WriteString(k.transactionOutcome)
 	h.WriteString(k.transactionResult)
 	h.WriteString(k.transactionType)
+	h.WriteString(k.extraDimensions)
 	return h.Sum64()
 }
 
 type transactionMetrics struct {
 	histogram *hdrhistogram.Histogram
+
+	// exemplarsMu guards exemplars, which may be updated
+	// concurrently by updateTransactionMetrics.
+	exemplarsMu sync.Mutex
+	exemplars   exemplars.Reservoir
+}
+
+// record records a transaction duration representing n transactions,
+// and offers exemplar to the reservoir if it is non-nil.
+func (m *transactionMetrics) record(d time.Duration, n float64, exemplar *model.MetricsetExemplar) {
+	m.recordDuration(d, n)
+	if exemplar != nil {
+		m.exemplarsMu.Lock()
+		m.exemplars.Offer(*exemplar, n)
+		m.exemplarsMu.Unlock()
+	}
 }
 
 func (m *transactionMetrics) recordDuration(d time.Duration, n float64) {
@@ -488,13 +783,6 @@ func (m *transactionMetrics) histogramBuckets() (counts []int64, values []float6
 	return counts, values
 }
 
-func transactionCount(tx *model.Transaction) float64 {
-	if tx.RepresentativeCount > 0 {
-		return tx.RepresentativeCount
-	}
-	return 1
-}
-
 func durationMicros(d time.Duration) int64 {
 	return int64(d / time.Microsecond)
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/elastic/apm-server/publish"
 	"github.com/elastic/apm-server/transform"
 	"github.com/elastic/apm-server/x-pack/apm-server/aggregation/txmetrics"
+	"github.com/elastic/beats/v7/libbeat/common"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
 )
@@ -42,6 +43,13 @@ func TestNewAggregatorConfigInvalid(t *testing.T) {
 			Report: report,
 		},
 		err: "MaxTransactionGroups unspecified or negative",
+	}, {
+		config: txmetrics.AggregatorConfig{
+			Report:                         report,
+			MaxTransactionGroups:           1,
+			MaxTransactionGroupsPerService: -1,
+		},
+		err: "MaxTransactionGroupsPerService negative",
 	}, {
 		config: txmetrics.AggregatorConfig{
 			Report:               report,
@@ -56,6 +64,51 @@ func TestNewAggregatorConfigInvalid(t *testing.T) {
 			HDRHistogramSignificantFigures: 6,
 		},
 		err: "HDRHistogramSignificantFigures (6) outside range [1,5]",
+	}, {
+		config: txmetrics.AggregatorConfig{
+			Report:                         report,
+			MaxTransactionGroups:           1,
+			MetricsInterval:                time.Nanosecond,
+			HDRHistogramSignificantFigures: 1,
+			ExtraDimensions:                []string{"labels.tenant", "user.id"},
+		},
+		err: `invalid ExtraDimensions: unsupported dimension "user.id"`,
+	}, {
+		config: txmetrics.AggregatorConfig{
+			Report:                         report,
+			MaxTransactionGroups:           1,
+			MetricsInterval:                time.Nanosecond,
+			HDRHistogramSignificantFigures: 1,
+			ExtraDimensions:                []string{"labels."},
+		},
+		err: `invalid ExtraDimensions: invalid dimension "labels.": missing label key`,
+	}, {
+		config: txmetrics.AggregatorConfig{
+			Report:                         report,
+			MaxTransactionGroups:           1,
+			MetricsInterval:                time.Minute,
+			RollupIntervals:                []time.Duration{90 * time.Second},
+			HDRHistogramSignificantFigures: 1,
+		},
+		err: "RollupIntervals (1m30s) must be greater than, and a multiple of, MetricsInterval (1m0s)",
+	}, {
+		config: txmetrics.AggregatorConfig{
+			Report:                         report,
+			MaxTransactionGroups:           1,
+			MetricsInterval:                time.Minute,
+			RollupIntervals:                []time.Duration{time.Minute},
+			HDRHistogramSignificantFigures: 1,
+		},
+		err: "RollupIntervals (1m0s) must be greater than, and a multiple of, MetricsInterval (1m0s)",
+	}, {
+		config: txmetrics.AggregatorConfig{
+			Report:                         report,
+			MaxTransactionGroups:           1,
+			MetricsInterval:                time.Minute,
+			RollupIntervals:                []time.Duration{10 * time.Minute, time.Hour, 10 * time.Minute},
+			HDRHistogramSignificantFigures: 1,
+		},
+		err: "RollupIntervals (10m0s) duplicated",
 	}} {
 		agg, err := txmetrics.NewAggregator(test.config)
 		require.Error(t, err)
@@ -74,62 +127,46 @@ func TestProcessTransformablesOverflow(t *testing.T) {
 
 	agg, err := txmetrics.NewAggregator(txmetrics.AggregatorConfig{
 		Report:                         makeChanReporter(reqs),
-		MaxTransactionGroups:           2,
-		MetricsInterval:                time.Microsecond,
+		MaxTransactionGroups:           4,
+		MaxTransactionGroupsPerService: 2,
+		MetricsInterval:                10 * time.Millisecond,
 		HDRHistogramSignificantFigures: 1,
 		Logger:                         logger,
 	})
 	require.NoError(t, err)
 
-	// The first two transaction groups will not require immediate publication,
-	// as we have configured the txmetrics with a maximum of two buckets.
+	makeTransaction := func(serviceName, transactionName string) *model.Transaction {
+		return &model.Transaction{
+			Metadata:            model.Metadata{Service: model.Service{Name: serviceName}},
+			Name:                transactionName,
+			RepresentativeCount: 1,
+		}
+	}
+
+	// The first two transaction groups of service-a fit within its budget.
 	var input []transform.Transformable
 	for i := 0; i < 10; i++ {
-		input = append(input, &model.Transaction{Name: "foo", RepresentativeCount: 1})
-		input = append(input, &model.Transaction{Name: "bar", RepresentativeCount: 1})
+		input = append(input, makeTransaction("service-a", "foo"))
+		input = append(input, makeTransaction("service-a", "bar"))
 	}
-	output, err := agg.ProcessTransformables(context.Background(), input)
-	require.NoError(t, err)
-	assert.Equal(t, input, output)
-
-	// The third transaction group will return a metricset for immediate publication.
+	// The third transaction group of service-a exceeds its budget, and is
+	// aggregated into its overflow group.
 	for i := 0; i < 2; i++ {
-		input = append(input, &model.Transaction{
-			Name:                "baz",
-			Duration:            float64(time.Minute / time.Millisecond),
-			RepresentativeCount: 1,
-		})
+		input = append(input, makeTransaction("service-a", "baz"))
 	}
-	output, err = agg.ProcessTransformables(context.Background(), input)
+	// The second transaction group of service-b exceeds the total group
+	// limit. There is no room for an overflow group for service-b, so it is
+	// aggregated into the global overflow group.
+	input = append(input, makeTransaction("service-b", "foo"))
+	input = append(input, makeTransaction("service-b", "bar"))
+
+	output, err := agg.ProcessTransformables(context.Background(), input)
 	require.NoError(t, err)
-	assert.Len(t, output, len(input)+2)
-	assert.Equal(t, input, output[:len(input)])
-
-	for _, tf := range output[len(input):] {
-		m, ok := tf.(*model.Metricset)
-		require.True(t, ok)
-		require.NotNil(t, m)
-		require.False(t, m.Timestamp.IsZero())
-
-		m.Timestamp = time.Time{}
-		assert.Equal(t, &model.Metricset{
-			Metadata: model.Metadata{},
-			Transaction: model.MetricsetTransaction{
-				Name: "baz",
-				Root: true,
-			},
-			Samples: []model.Sample{{
-				Name:   "transaction.duration.histogram",
-				Counts: []int64{1},
-				Values: []float64{float64(time.Minute / time.Microsecond)},
-			}},
-			TimeseriesInstanceID: ":baz:bc30224a3738a508",
-		}, m)
-	}
+	assert.Equal(t, input, output)
 
 	expectedMonitoring := monitoring.MakeFlatSnapshot()
-	expectedMonitoring.Ints["txmetrics.active_groups"] = 2
-	expectedMonitoring.Ints["txmetrics.overflowed"] = 2 // third group is processed twice
+	expectedMonitoring.Ints["txmetrics.active_groups"] = 5
+	expectedMonitoring.Ints["txmetrics.overflowed"] = 3
 
 	registry := monitoring.NewRegistry()
 	monitoring.NewFunc(registry, "txmetrics", agg.CollectMonitoring)
@@ -141,6 +178,33 @@ func TestProcessTransformablesOverflow(t *testing.T) {
 
 	overflowLogEntries := observed.FilterMessageSnippet("Transaction group limit reached")
 	assert.Equal(t, 1, overflowLogEntries.Len()) // rate limited
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	type group struct {
+		serviceName     string
+		transactionName string
+		count           int64
+	}
+	var groups []group
+	req := expectPublish(t, reqs)
+	for _, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		require.Len(t, ms.Samples, 2)
+		groups = append(groups, group{
+			serviceName:     ms.Metadata.Service.Name,
+			transactionName: ms.Transaction.Name,
+			count:           ms.Samples[0].Counts[0],
+		})
+	}
+	assert.ElementsMatch(t, []group{
+		{serviceName: "service-a", transactionName: "foo", count: 10},
+		{serviceName: "service-a", transactionName: "bar", count: 10},
+		{serviceName: "service-a", transactionName: "_other", count: 2},
+		{serviceName: "service-b", transactionName: "foo", count: 1},
+		{serviceName: "_other", transactionName: "_other", count: 1},
+	}, groups)
 }
 
 func TestAggregatorRun(t *testing.T) {
@@ -154,18 +218,16 @@ func TestAggregatorRun(t *testing.T) {
 	require.NoError(t, err)
 
 	for i := 0; i < 1000; i++ {
-		metricset := agg.AggregateTransaction(&model.Transaction{
+		agg.AggregateTransaction(&model.Transaction{
 			Name:                "T-1000",
 			RepresentativeCount: 1,
 		})
-		require.Nil(t, metricset)
 	}
 	for i := 0; i < 800; i++ {
-		metricset := agg.AggregateTransaction(&model.Transaction{
+		agg.AggregateTransaction(&model.Transaction{
 			Name:                "T-800",
 			RepresentativeCount: 1,
 		})
-		require.Nil(t, metricset)
 	}
 
 	go agg.Run()
@@ -193,6 +255,93 @@ func TestAggregatorRun(t *testing.T) {
 	}
 }
 
+func TestAggregatorRollups(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := txmetrics.NewAggregator(txmetrics.AggregatorConfig{
+		Report:                         makeChanReporter(reqs),
+		MaxTransactionGroups:           10,
+		MetricsInterval:                50 * time.Millisecond,
+		RollupIntervals:                []time.Duration{100 * time.Millisecond},
+		HDRHistogramSignificantFigures: 1,
+	})
+	require.NoError(t, err)
+
+	// The transactions aggregated in each 50ms period are published,
+	// and then merged into the 100ms rollup, which is published along
+	// with every second 50ms period.
+	agg.AggregateTransaction(&model.Transaction{Name: "foo", RepresentativeCount: 1})
+	agg.AggregateTransaction(&model.Transaction{Name: "bar", RepresentativeCount: 1})
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	assert.ElementsMatch(t, []rollupGroup{
+		{transactionName: "foo", period: 50, count: 1},
+		{transactionName: "bar", period: 50, count: 1},
+	}, rollupGroups(t, expectPublish(t, reqs)))
+
+	agg.AggregateTransaction(&model.Transaction{Name: "foo", RepresentativeCount: 2})
+	assert.ElementsMatch(t, []rollupGroup{
+		{transactionName: "foo", period: 50, count: 2},
+		{transactionName: "foo", rollupInterval: 100 * time.Millisecond, period: 100, count: 3},
+		{transactionName: "bar", rollupInterval: 100 * time.Millisecond, period: 100, count: 1},
+	}, rollupGroups(t, expectPublish(t, reqs)))
+}
+
+func TestAggregatorRollupsStop(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := txmetrics.NewAggregator(txmetrics.AggregatorConfig{
+		Report:                         makeChanReporter(reqs),
+		MaxTransactionGroups:           10,
+		MetricsInterval:                time.Minute,
+		RollupIntervals:                []time.Duration{10 * time.Minute},
+		HDRHistogramSignificantFigures: 1,
+	})
+	require.NoError(t, err)
+	go agg.Run()
+
+	// Partially aggregated metrics and rollups are published when the
+	// aggregator stops, recording the period that actually elapsed.
+	agg.AggregateTransaction(&model.Transaction{Name: "foo", RepresentativeCount: 1})
+	time.Sleep(10 * time.Millisecond)
+	stopped := make(chan error, 1)
+	go func() { stopped <- agg.Stop(context.Background()) }()
+	groups := rollupGroups(t, expectPublish(t, reqs))
+	require.Len(t, groups, 2)
+	var rollupIntervals []time.Duration
+	for _, group := range groups {
+		assert.Equal(t, int64(1), group.count)
+		assert.GreaterOrEqual(t, group.period, float64(10))
+		assert.Less(t, group.period, float64(60000))
+		rollupIntervals = append(rollupIntervals, group.rollupInterval)
+	}
+	assert.ElementsMatch(t, []time.Duration{0, 10 * time.Minute}, rollupIntervals)
+	assert.NoError(t, <-stopped)
+}
+
+type rollupGroup struct {
+	transactionName string
+	rollupInterval  time.Duration
+	period          float64
+	count           int64
+}
+
+func rollupGroups(t *testing.T, req publish.PendingReq) []rollupGroup {
+	var groups []rollupGroup
+	for _, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		require.Len(t, ms.Samples, 2)
+		assert.Equal(t, "metricset.period", ms.Samples[1].Name)
+		groups = append(groups, rollupGroup{
+			transactionName: ms.Transaction.Name,
+			rollupInterval:  ms.RollupInterval,
+			period:          ms.Samples[1].Value,
+			count:           ms.Samples[0].Counts[0],
+		})
+	}
+	return groups
+}
+
 func TestAggregatorRunPublishErrors(t *testing.T) {
 	reqs := make(chan publish.PendingReq, 1)
 	chanReporter := makeChanReporter(reqs)
@@ -222,11 +371,10 @@ func TestAggregatorRunPublishErrors(t *testing.T) {
 	defer agg.Stop(context.Background())
 
 	for i := 0; i < 2; i++ {
-		metricset := agg.AggregateTransaction(&model.Transaction{
+		agg.AggregateTransaction(&model.Transaction{
 			Name:                "T-1000",
 			RepresentativeCount: 1,
 		})
-		require.Nil(t, metricset)
 		expectPublish(t, reqs)
 	}
 
@@ -247,26 +395,31 @@ func TestAggregateRepresentativeCount(t *testing.T) {
 
 	agg, err := txmetrics.NewAggregator(txmetrics.AggregatorConfig{
 		Report:                         makeChanReporter(reqs),
-		MaxTransactionGroups:           1,
-		MetricsInterval:                time.Microsecond,
+		MaxTransactionGroups:           10,
+		MetricsInterval:                10 * time.Millisecond,
 		HDRHistogramSignificantFigures: 1,
 	})
 	require.NoError(t, err)
 
-	// Record a transaction group so subsequent calls yield immediate metricsets,
-	// and to demonstrate that fractional transaction counts are accumulated.
+	// Demonstrate that fractional transaction counts are accumulated.
 	agg.AggregateTransaction(&model.Transaction{Name: "fnord", RepresentativeCount: 1})
 	agg.AggregateTransaction(&model.Transaction{Name: "fnord", RepresentativeCount: 1.5})
 
 	// For non-positive RepresentativeCounts, no metrics will be accumulated.
 	for _, representativeCount := range []float64{-1, 0} {
-		m := agg.AggregateTransaction(&model.Transaction{
+		agg.AggregateTransaction(&model.Transaction{
 			Name:                "foo",
 			RepresentativeCount: representativeCount,
 		})
-		assert.Nil(t, m)
 	}
 
+	expectedCounts := map[string]int64{
+		// Check the fractional transaction counts for the "fnord" transaction
+		// group were accumulated with some degree of accuracy. i.e. we should
+		// receive round(1+1.5)=3; the fractional values should not have been
+		// truncated.
+		"fnord": 3,
+	}
 	for _, test := range []struct {
 		representativeCount float64
 		expectedCount       int64
@@ -280,40 +433,126 @@ func TestAggregateRepresentativeCount(t *testing.T) {
 		representativeCount: 1.50, // round half away from zero
 		expectedCount:       2,
 	}} {
-		m := agg.AggregateTransaction(&model.Transaction{
-			Name:                "foo",
+		name := fmt.Sprintf("bar-%v", test.representativeCount)
+		agg.AggregateTransaction(&model.Transaction{
+			Name:                name,
 			RepresentativeCount: test.representativeCount,
 		})
-		require.NotNil(t, m)
+		expectedCounts[name] = test.expectedCount
+	}
 
-		m.Timestamp = time.Time{}
-		assert.Equal(t, &model.Metricset{
-			Metadata:             model.Metadata{},
-			TimeseriesInstanceID: ":foo:1db641f187113b17",
-			Transaction: model.MetricsetTransaction{
-				Name: "foo",
-				Root: true,
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	req := expectPublish(t, reqs)
+	counts := make(map[string]int64)
+	for _, tf := range req.Transformables {
+		metricset := tf.(*model.Metricset)
+		require.Len(t, metricset.Samples, 2)
+		require.Len(t, metricset.Samples[0].Counts, 1)
+		counts[metricset.Transaction.Name] = metricset.Samples[0].Counts[0]
+	}
+	assert.Equal(t, expectedCounts, counts)
+}
+
+func TestAggregateExtraDimensions(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+
+	agg, err := txmetrics.NewAggregator(txmetrics.AggregatorConfig{
+		Report:                         makeChanReporter(reqs),
+		MaxTransactionGroups:           1000,
+		MetricsInterval:                10 * time.Millisecond,
+		HDRHistogramSignificantFigures: 1,
+		ExtraDimensions:                []string{"labels.tenant", "cloud.region", "labels.deployment_ring"},
+	})
+	require.NoError(t, err)
+
+	makeTransaction := func(tenant interface{}, region string) *model.Transaction {
+		return &model.Transaction{
+			Metadata: model.Metadata{
+				Cloud:  model.Cloud{Region: region},
+				Labels: common.MapStr{"tenant": "default", "deployment_ring": 1},
 			},
-			Samples: []model.Sample{{
-				Name:   "transaction.duration.histogram",
-				Counts: []int64{test.expectedCount},
-				Values: []float64{0},
-			}},
-		}, m)
+			Labels:              common.MapStr{"tenant": tenant},
+			Name:                "T-1000",
+			RepresentativeCount: 1,
+		}
+	}
+	for _, tx := range []*model.Transaction{
+		makeTransaction("a", "us-east-1"),
+		makeTransaction("a", "us-east-1"),
+		makeTransaction("a", "eu-west-1"),
+		makeTransaction("b", "us-east-1"),
+		makeTransaction(nil, ""),
+	} {
+		agg.AggregateTransaction(tx)
 	}
 
 	go agg.Run()
 	defer agg.Stop(context.Background())
 
-	// Check the fractional transaction counts for the "fnord" transaction
-	// group were accumulated with some degree of accuracy. i.e. we should
-	// receive round(1+1.5)=3; the fractional values should not have been
-	// truncated.
 	req := expectPublish(t, reqs)
-	require.Len(t, req.Transformables, 1)
-	metricset := req.Transformables[0].(*model.Metricset)
-	require.Len(t, metricset.Samples, 1)
-	assert.Equal(t, []int64{3 /*round(1+1.5)*/}, metricset.Samples[0].Counts)
+	type group struct {
+		labels common.MapStr
+		region string
+		count  int64
+	}
+	var groups []group
+	for _, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		require.Len(t, ms.Samples, 2)
+		groups = append(groups, group{
+			labels: ms.Labels,
+			region: ms.Metadata.Cloud.Region,
+			count:  ms.Samples[0].Counts[0],
+		})
+	}
+	// Transaction labels override metadata labels, and label values are
+	// recorded as strings. Nil transaction labels unset metadata labels.
+	assert.ElementsMatch(t, []group{
+		{labels: common.MapStr{"tenant": "a", "deployment_ring": "1"}, region: "us-east-1", count: 2},
+		{labels: common.MapStr{"tenant": "a", "deployment_ring": "1"}, region: "eu-west-1", count: 1},
+		{labels: common.MapStr{"tenant": "b", "deployment_ring": "1"}, region: "us-east-1", count: 1},
+		{labels: common.MapStr{"deployment_ring": "1"}, count: 1},
+	}, groups)
+}
+
+func TestAggregateExemplars(t *testing.T) {
+	for _, enabled := range []bool{false, true} {
+		reqs := make(chan publish.PendingReq, 1)
+		agg, err := txmetrics.NewAggregator(txmetrics.AggregatorConfig{
+			Report:                         makeChanReporter(reqs),
+			MaxTransactionGroups:           10,
+			MetricsInterval:                10 * time.Millisecond,
+			HDRHistogramSignificantFigures: 1,
+			Exemplars:                      enabled,
+		})
+		require.NoError(t, err)
+
+		unsampled := false
+		for _, tx := range []*model.Transaction{
+			{TraceID: "trace1", ID: "tx1", Name: "T", Duration: 1, RepresentativeCount: 1},
+			{TraceID: "trace2", ID: "tx2", Name: "T", Duration: 100, RepresentativeCount: 1},
+			// Unsampled transactions have no trace to refer to.
+			{TraceID: "trace3", ID: "tx3", Name: "T", Duration: 1000, RepresentativeCount: 1, Sampled: &unsampled},
+		} {
+			agg.AggregateTransaction(tx)
+		}
+
+		go agg.Run()
+		req := expectPublish(t, reqs)
+		require.Len(t, req.Transformables, 1)
+		ms := req.Transformables[0].(*model.Metricset)
+		if enabled {
+			assert.Equal(t, []model.MetricsetExemplar{
+				{TraceID: "trace1", TransactionID: "tx1", Duration: time.Millisecond},
+				{TraceID: "trace2", TransactionID: "tx2", Duration: 100 * time.Millisecond},
+			}, ms.Exemplars)
+		} else {
+			assert.Nil(t, ms.Exemplars)
+		}
+		assert.NoError(t, agg.Stop(context.Background()))
+	}
 }
 
 func TestHDRHistogramSignificantFigures(t *testing.T) {
@@ -348,12 +587,11 @@ func testHDRHistogramSignificantFigures(t *testing.T, sigfigs int) {
 			101110 * time.Microsecond,
 			101111 * time.Microsecond,
 		} {
-			metricset := agg.AggregateTransaction(&model.Transaction{
+			agg.AggregateTransaction(&model.Transaction{
 				Name:                "T-1000",
 				Duration:            durationMillis(duration),
 				RepresentativeCount: 1,
 			})
-			require.Nil(t, metricset)
 		}
 
 		go agg.Run()
@@ -363,7 +601,7 @@ func testHDRHistogramSignificantFigures(t *testing.T, sigfigs int) {
 		require.Len(t, req.Transformables, 1)
 
 		metricset := req.Transformables[0].(*model.Metricset)
-		require.Len(t, metricset.Samples, 1)
+		require.Len(t, metricset.Samples, 2)
 		assert.Len(t, metricset.Samples[0].Counts, len(metricset.Samples[0].Values))
 		assert.Len(t, metricset.Samples[0].Counts, sigfigs)
 	})
@@ -412,14 +650,17 @@ func TestAggregationFields(t *testing.T) {
 				Name:   "transaction.duration.histogram",
 				Counts: []int64{expectedCount},
 				Values: []float64{0},
+			}, {
+				Name:  "metricset.period",
+				Value: 100,
 			}},
 		})
 	}
 	for _, field := range inputFields {
 		for _, value := range []string{"something", "anything"} {
 			*field = value
-			assert.Nil(t, agg.AggregateTransaction(&input))
-			assert.Nil(t, agg.AggregateTransaction(&input))
+			agg.AggregateTransaction(&input)
+			agg.AggregateTransaction(&input)
 			addExpectedCount(2)
 		}
 	}
@@ -430,8 +671,8 @@ func TestAggregationFields(t *testing.T) {
 	input.Metadata.System.Kubernetes.PodName = ""
 	for _, value := range []string{"something", "anything"} {
 		input.Metadata.System.DetectedHostname = value
-		assert.Nil(t, agg.AggregateTransaction(&input))
-		assert.Nil(t, agg.AggregateTransaction(&input))
+		agg.AggregateTransaction(&input)
+		agg.AggregateTransaction(&input)
 		addExpectedCount(2)
 	}
 
@@ -439,8 +680,8 @@ func TestAggregationFields(t *testing.T) {
 	// non-root traces.
 	for _, value := range []string{"something", "anything"} {
 		input.ParentID = value
-		assert.Nil(t, agg.AggregateTransaction(&input))
-		assert.Nil(t, agg.AggregateTransaction(&input))
+		agg.AggregateTransaction(&input)
+		agg.AggregateTransaction(&input)
 	}
 	addExpectedCount(4)
 
@@ -0,0 +1,122 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package txmetrics
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/common"
+
+	"github.com/elastic/apm-server/model"
+)
+
+const (
+	labelsPrefix = "labels."
+
+	// dimensionValueSeparator separates the values of extra dimensions
+	// in transactionAggregationKey.extraDimensions.
+	dimensionValueSeparator = "\x00"
+)
+
+// metadataDimensions holds the metadata fields which may be configured
+// as extra aggregation dimensions, in addition to "labels.<key>".
+var metadataDimensions = map[string]struct {
+	get func(*model.Metadata) string
+	set func(*model.Metadata, string)
+}{
+	"cloud.account.id": {
+		get: func(m *model.Metadata) string { return m.Cloud.AccountID },
+		set: func(m *model.Metadata, v string) { m.Cloud.AccountID = v },
+	},
+	"cloud.availability_zone": {
+		get: func(m *model.Metadata) string { return m.Cloud.AvailabilityZone },
+		set: func(m *model.Metadata, v string) { m.Cloud.AvailabilityZone = v },
+	},
+	"cloud.project.id": {
+		get: func(m *model.Metadata) string { return m.Cloud.ProjectID },
+		set: func(m *model.Metadata, v string) { m.Cloud.ProjectID = v },
+	},
+	"cloud.provider": {
+		get: func(m *model.Metadata) string { return m.Cloud.Provider },
+		set: func(m *model.Metadata, v string) { m.Cloud.Provider = v },
+	},
+	"cloud.region": {
+		get: func(m *model.Metadata) string { return m.Cloud.Region },
+		set: func(m *model.Metadata, v string) { m.Cloud.Region = v },
+	},
+	"service.node.name": {
+		get: func(m *model.Metadata) string { return m.Service.Node.Name },
+		set: func(m *model.Metadata, v string) { m.Service.Node.Name = v },
+	},
+}
+
+// validateDimension returns an error if name cannot be used as an extra
+// aggregation dimension.
+func validateDimension(name string) error {
+	if strings.HasPrefix(name, labelsPrefix) {
+		if name == labelsPrefix {
+			return errors.Errorf("invalid dimension %q: missing label key", name)
+		}
+		return nil
+	}
+	if _, ok := metadataDimensions[name]; !ok {
+		return errors.Errorf("unsupported dimension %q", name)
+	}
+	return nil
+}
+
+// extraDimensionValues returns the values of the configured extra dimensions
+// for tx, encoded as a single comparable string.
+func (a *Aggregator) extraDimensionValues(tx *model.Transaction) string {
+	if len(a.config.ExtraDimensions) == 0 {
+		return ""
+	}
+	var values strings.Builder
+	for i, name := range a.config.ExtraDimensions {
+		if i > 0 {
+			values.WriteString(dimensionValueSeparator)
+		}
+		if key := strings.TrimPrefix(name, labelsPrefix); key != name {
+			// Transaction labels override metadata labels.
+			v, ok := tx.Labels[key]
+			if !ok {
+				v = tx.Metadata.Labels[key]
+			}
+			if v != nil {
+				values.WriteString(fmt.Sprint(v))
+			}
+			continue
+		}
+		values.WriteString(metadataDimensions[name].get(&tx.Metadata))
+	}
+	return values.String()
+}
+
+// setExtraDimensions sets the extra dimension fields of the metricset from
+// the values encoded by extraDimensionValues. Empty values are omitted.
+//
+// Label values are always recorded as strings.
+func setExtraDimensions(ms *model.Metricset, names []string, encoded string) {
+	if len(names) == 0 {
+		return
+	}
+	values := strings.Split(encoded, dimensionValueSeparator)
+	for i, name := range names {
+		if i >= len(values) || values[i] == "" {
+			continue
+		}
+		if key := strings.TrimPrefix(name, labelsPrefix); key != name {
+			if ms.Labels == nil {
+				ms.Labels = make(common.MapStr)
+			}
+			ms.Labels[key] = values[i]
+			continue
+		}
+		metadataDimensions[name].set(&ms.Metadata, values[i])
+	}
+}
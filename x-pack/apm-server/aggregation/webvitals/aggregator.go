@@ -0,0 +1,466 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package webvitals
+
+import (
+	"context"
+	"math"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	logs "github.com/elastic/apm-server/log"
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/logp"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+	"github.com/elastic/go-hdrhistogram"
+)
+
+const (
+	// metricsetName is the name of the web vitals metricsets.
+	metricsetName = "web_vitals"
+
+	// pageLoadTransactionType is the type of the RUM transactions
+	// whose web vitals are aggregated.
+	pageLoadTransactionType = "page-load"
+
+	// We scale page load counts in the histograms, which only permit
+	// storing integer counts, to allow for fractional page loads due
+	// to sampling.
+	//
+	// See the txmetrics package for a more detailed explanation.
+	histogramCountScale = 1000
+
+	// tooManyGroupsLoggerRateLimit is the maximum frequency at which
+	// "too many groups" log messages are logged.
+	tooManyGroupsLoggerRateLimit = time.Minute
+
+	// overflowBucketName is the service name and URL pattern recorded
+	// for page loads which are aggregated into the overflow group.
+	overflowBucketName = "_other"
+)
+
+// vital identifies a web vital metric.
+type vital int
+
+const (
+	cumulativeLayoutShift vital = iota
+	firstInputDelay
+	totalBlockingTime
+	longtaskCount
+	longtaskSum
+	numVitals
+)
+
+// vitals describes how each web vital is recorded. Values are multiplied
+// by scale and rounded to integers for recording in the histograms, which
+// track values up to max.
+var vitals = [numVitals]struct {
+	sample string
+	scale  float64
+	max    float64
+}{
+	cumulativeLayoutShift: {sample: "web_vitals.cls.histogram", scale: 10000, max: 100},
+	firstInputDelay:       {sample: "web_vitals.fid.histogram", scale: 1000, max: float64(time.Hour / time.Millisecond)},
+	totalBlockingTime:     {sample: "web_vitals.tbt.histogram", scale: 1000, max: float64(time.Hour / time.Millisecond)},
+	longtaskCount:         {sample: "web_vitals.longtask.count.histogram", scale: 1, max: 1e6},
+	longtaskSum:           {sample: "web_vitals.longtask.sum.histogram", scale: 1000, max: float64(time.Hour / time.Millisecond)},
+}
+
+// AggregatorConfig holds configuration for creating an Aggregator.
+type AggregatorConfig struct {
+	// Report is a publish.Reporter for reporting metrics documents.
+	Report publish.Reporter
+
+	// MaxGroups is the maximum number of distinct web vitals group
+	// metrics to store within an aggregation period. Once this number
+	// of groups is reached, page loads with new aggregation keys are
+	// aggregated into an additional overflow group, with the service
+	// name and URL pattern "_other".
+	MaxGroups int
+
+	// Interval is the interval between publishing of aggregated metrics.
+	Interval time.Duration
+
+	// HDRHistogramSignificantFigures is the number of significant figures
+	// to maintain in the HDR Histograms of web vitals.
+	// HDRHistogramSignificantFigures must be in the range [1,5].
+	HDRHistogramSignificantFigures int
+
+	// Logger is the logger for logging metrics aggregation/publishing.
+	//
+	// If Logger is nil, a new logger will be constructed.
+	Logger *logp.Logger
+}
+
+// Validate validates the aggregator config.
+func (config AggregatorConfig) Validate() error {
+	if config.Report == nil {
+		return errors.New("Report unspecified")
+	}
+	if config.MaxGroups <= 0 {
+		return errors.New("MaxGroups unspecified or negative")
+	}
+	if config.Interval <= 0 {
+		return errors.New("Interval unspecified or negative")
+	}
+	if n := config.HDRHistogramSignificantFigures; n < 1 || n > 5 {
+		return errors.Errorf("HDRHistogramSignificantFigures (%d) outside range [1,5]", n)
+	}
+	return nil
+}
+
+// Aggregator aggregates the Core Web Vitals and longtask metrics of RUM
+// page loads by service, page URL pattern, and user agent family,
+// periodically publishing histogram metrics.
+type Aggregator struct {
+	stopMu   sync.Mutex
+	stopping chan struct{}
+	stopped  chan struct{}
+
+	config              AggregatorConfig
+	metrics             aggregatorMetrics
+	tooManyGroupsLogger *logp.Logger
+
+	mu               sync.RWMutex
+	active, inactive *metricsBuffer
+}
+
+type aggregatorMetrics struct {
+	overflowed int64
+}
+
+// NewAggregator returns a new Aggregator with the given config.
+func NewAggregator(config AggregatorConfig) (*Aggregator, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid aggregator config")
+	}
+	if config.Logger == nil {
+		config.Logger = logp.NewLogger(logs.WebVitals)
+	}
+	return &Aggregator{
+		stopping:            make(chan struct{}),
+		stopped:             make(chan struct{}),
+		config:              config,
+		tooManyGroupsLogger: config.Logger.WithOptions(logs.WithRateLimit(tooManyGroupsLoggerRateLimit)),
+		active:              newMetricsBuffer(config.MaxGroups, config.HDRHistogramSignificantFigures),
+		inactive:            newMetricsBuffer(config.MaxGroups, config.HDRHistogramSignificantFigures),
+	}, nil
+}
+
+// Run runs the Aggregator, periodically publishing and clearing aggregated
+// metrics. Run returns when either a fatal error occurs, or the Aggregator's
+// Stop method is invoked.
+func (a *Aggregator) Run() error {
+	ticker := time.NewTicker(a.config.Interval)
+	defer ticker.Stop()
+	defer func() {
+		a.stopMu.Lock()
+		defer a.stopMu.Unlock()
+		select {
+		case <-a.stopped:
+		default:
+			close(a.stopped)
+		}
+	}()
+	var stop bool
+	for !stop {
+		select {
+		case <-a.stopping:
+			stop = true
+		case <-ticker.C:
+		}
+		if err := a.publish(context.Background()); err != nil {
+			a.config.Logger.With(logp.Error(err)).Warnf(
+				"publishing web vitals metrics failed: %s", err,
+			)
+		}
+	}
+	return nil
+}
+
+// Stop stops the Aggregator if it is running, waiting for it to flush any
+// aggregated metrics and return, or for the context to be cancelled.
+//
+// After Stop has been called the aggregator cannot be reused, as the Run
+// method will always return immediately.
+func (a *Aggregator) Stop(ctx context.Context) error {
+	a.stopMu.Lock()
+	select {
+	case <-a.stopped:
+	case <-a.stopping:
+		// Already stopping/stopped.
+	default:
+		close(a.stopping)
+	}
+	a.stopMu.Unlock()
+
+	select {
+	case <-a.stopped:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return nil
+}
+
+// CollectMonitoring may be called to collect monitoring metrics from the
+// aggregation. It is intended to be used with libbeat/monitoring.NewFunc.
+//
+// The metrics should be added to the "apm-server.aggregation.webvitals" registry.
+func (a *Aggregator) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor) {
+	V.OnRegistryStart()
+	defer V.OnRegistryFinished()
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	m := a.active
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	monitoring.ReportInt(V, "active_groups", int64(m.entries))
+	monitoring.ReportInt(V, "overflowed", atomic.LoadInt64(&a.metrics.overflowed))
+}
+
+func (a *Aggregator) publish(ctx context.Context) error {
+	// We hold a.mu only long enough to swap the metrics. This will
+	// be blocked by metrics updates, which is OK, as we prefer not
+	// to block metrics updaters. After the lock is released nothing
+	// will be accessing a.inactive.
+	a.mu.Lock()
+	a.active, a.inactive = a.inactive, a.active
+	a.mu.Unlock()
+
+	size := a.inactive.entries
+	if size == 0 {
+		a.config.Logger.Debugf("no web vitals metrics to publish")
+		return nil
+	}
+
+	now := time.Now()
+	metricsets := make([]transform.Transformable, 0, size)
+	for key, metrics := range a.inactive.m {
+		metricset := makeMetricset(now, key, metrics, a.config.Interval.Milliseconds())
+		metricsets = append(metricsets, &metricset)
+		delete(a.inactive.m, key)
+	}
+	a.inactive.entries = 0
+	a.config.Logger.Debugf("publishing %d metricsets", len(metricsets))
+	return a.config.Report(ctx, publish.PendingReq{
+		Transformables: metricsets,
+		Trace:          true,
+	})
+}
+
+// ProcessTransformables aggregates the user experience metrics of all
+// page-load transactions contained in "in", returning the input unmodified.
+//
+// This method is expected to be used immediately prior to publishing
+// the events.
+func (a *Aggregator) ProcessTransformables(ctx context.Context, in []transform.Transformable) ([]transform.Transformable, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	for _, tf := range in {
+		if tx, ok := tf.(*model.Transaction); ok {
+			a.processTransaction(tx)
+		}
+	}
+	return in, nil
+}
+
+func (a *Aggregator) processTransaction(tx *model.Transaction) {
+	if tx.Type != pageLoadTransactionType || tx.UserExperience == nil {
+		return
+	}
+	if tx.RepresentativeCount <= 0 {
+		// RepresentativeCount is zero when the sample rate is unknown.
+		// We cannot calculate accurate page load counts without the
+		// sample rate, so we don't calculate any metrics in this case.
+		return
+	}
+	key := aggregationKey{
+		serviceName:        tx.Metadata.Service.Name,
+		serviceEnvironment: tx.Metadata.Service.Environment,
+		urlPattern:         pageURLPattern(tx),
+		userAgentFamily:    userAgentFamily(tx.Metadata.UserAgent),
+	}
+	if a.active.storeOrUpdate(key, tx.RepresentativeCount, vitalValues(tx.UserExperience)) {
+		return
+	}
+	a.tooManyGroupsLogger.Warn(`
+Web vitals group limit reached, aggregating excess web vitals groups into "_other".
+This is typically caused by high cardinality page URLs which are not reduced to a
+common pattern, e.g. URLs with unique identifiers that are not purely numeric or hex.`[1:],
+	)
+	atomic.AddInt64(&a.metrics.overflowed, 1)
+}
+
+// vitalValues returns the values of each web vital recorded in ux.
+// Negative values indicate that the web vital is unknown.
+func vitalValues(ux *model.UserExperience) [numVitals]float64 {
+	var values [numVitals]float64
+	values[cumulativeLayoutShift] = ux.CumulativeLayoutShift
+	values[firstInputDelay] = ux.FirstInputDelay
+	values[totalBlockingTime] = ux.TotalBlockingTime
+	if ux.Longtask.Count >= 0 {
+		values[longtaskCount] = float64(ux.Longtask.Count)
+		values[longtaskSum] = ux.Longtask.Sum
+	} else {
+		values[longtaskCount] = -1
+		values[longtaskSum] = -1
+	}
+	return values
+}
+
+type metricsBuffer struct {
+	maxSize            int
+	significantFigures int
+
+	mu      sync.RWMutex
+	entries int
+	m       map[aggregationKey]*webVitalsMetrics
+	space   []webVitalsMetrics
+}
+
+func newMetricsBuffer(maxSize, significantFigures int) *metricsBuffer {
+	return &metricsBuffer{
+		maxSize:            maxSize,
+		significantFigures: significantFigures,
+		m:                  make(map[aggregationKey]*webVitalsMetrics),
+		// Reserve an additional entry for the overflow group.
+		space: make([]webVitalsMetrics, maxSize+1),
+	}
+}
+
+// storeOrUpdate records count and values in the metrics for key,
+// returning false if they were instead recorded in an overflow group
+// due to the group limit being reached.
+func (mb *metricsBuffer) storeOrUpdate(key aggregationKey, count float64, values [numVitals]float64) bool {
+	mb.mu.Lock()
+	defer mb.mu.Unlock()
+	metrics, ok := mb.m[key]
+	var overflowed bool
+	if !ok {
+		if mb.entries >= mb.maxSize {
+			key = aggregationKey{
+				serviceName: overflowBucketName,
+				urlPattern:  overflowBucketName,
+			}
+			metrics, ok = mb.m[key]
+			overflowed = true
+		}
+		if !ok {
+			// The overflow group uses the space reserved
+			// for it beyond maxSize; see newMetricsBuffer.
+			metrics = mb.newEntryLocked(key)
+		}
+	}
+	metrics.count += count
+	histogramCount := int64(math.Round(count * histogramCountScale))
+	for i, value := range values {
+		if value < 0 {
+			continue
+		}
+		v := vitals[i]
+		metrics.histograms[i].RecordValues(
+			int64(math.Round(math.Min(value, v.max)*v.scale)),
+			histogramCount,
+		)
+	}
+	return !overflowed
+}
+
+// newEntryLocked allocates and records a new entry for key.
+//
+// newEntryLocked must be called with mb.mu held for writing.
+func (mb *metricsBuffer) newEntryLocked(key aggregationKey) *webVitalsMetrics {
+	// Reuse the space and histograms from previous aggregation periods.
+	metrics := &mb.space[mb.entries]
+	for i, h := range metrics.histograms {
+		if h == nil {
+			metrics.histograms[i] = hdrhistogram.New(
+				0, int64(vitals[i].max*vitals[i].scale),
+				mb.significantFigures,
+			)
+		} else {
+			h.Reset()
+		}
+	}
+	metrics.count = 0
+	mb.m[key] = metrics
+	mb.entries++
+	return metrics
+}
+
+type aggregationKey struct {
+	serviceName        string
+	serviceEnvironment string
+	urlPattern         string
+	userAgentFamily    string
+}
+
+type webVitalsMetrics struct {
+	count      float64
+	histograms [numVitals]*hdrhistogram.Histogram
+}
+
+// histogramBuckets returns the non-empty buckets of the histogram
+// for vital v, with values scaled back to the units of the vital.
+func (m *webVitalsMetrics) histogramBuckets(v vital) (counts []int64, values []float64) {
+	// See the txmetrics package for details of the HDR histogram format.
+	distribution := m.histograms[v].Distribution()
+	for _, b := range distribution {
+		if b.Count <= 0 {
+			continue
+		}
+		count := math.Round(float64(b.Count) / histogramCountScale)
+		counts = append(counts, int64(count))
+		values = append(values, float64(b.To)/vitals[v].scale)
+	}
+	return counts, values
+}
+
+func makeMetricset(timestamp time.Time, key aggregationKey, metrics *webVitalsMetrics, interval int64) model.Metricset {
+	out := model.Metricset{
+		Name:      metricsetName,
+		Timestamp: timestamp,
+		Metadata: model.Metadata{
+			Service: model.Service{
+				Name:        key.serviceName,
+				Environment: key.serviceEnvironment,
+			},
+			UserAgent: model.UserAgent{Name: key.userAgentFamily},
+		},
+		URL: model.MetricsetURL{Pattern: key.urlPattern},
+		Samples: []model.Sample{{
+			Name:  "web_vitals.count",
+			Value: math.Round(metrics.count),
+		}},
+	}
+	for v := vital(0); v < numVitals; v++ {
+		counts, values := metrics.histogramBuckets(v)
+		if len(counts) == 0 {
+			// The web vital was not recorded for any page loads.
+			continue
+		}
+		out.Samples = append(out.Samples, model.Sample{
+			Name:   vitals[v].sample,
+			Counts: counts,
+			Values: values,
+		})
+	}
+	if interval > 0 {
+		// Only set metricset.period for a positive interval.
+		out.Samples = append(out.Samples, model.Sample{
+			Name:  "metricset.period",
+			Value: float64(interval),
+		})
+	}
+	return out
+}
@@ -0,0 +1,273 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package webvitals
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/publish"
+	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/beats/v7/libbeat/monitoring"
+)
+
+func TestNewAggregatorConfigInvalid(t *testing.T) {
+	report := makeErrReporter(nil)
+
+	type test struct {
+		config AggregatorConfig
+		err    string
+	}
+
+	for _, test := range []test{{
+		config: AggregatorConfig{},
+		err:    "Report unspecified",
+	}, {
+		config: AggregatorConfig{
+			Report: report,
+		},
+		err: "MaxGroups unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+		},
+		err: "Interval unspecified or negative",
+	}, {
+		config: AggregatorConfig{
+			Report:    report,
+			MaxGroups: 1,
+			Interval:  time.Nanosecond,
+		},
+		err: "HDRHistogramSignificantFigures (0) outside range [1,5]",
+	}} {
+		agg, err := NewAggregator(test.config)
+		require.Error(t, err)
+		require.Nil(t, agg)
+		assert.EqualError(t, err, "invalid aggregator config: "+test.err)
+	}
+}
+
+func TestAggregatorRun(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:                         makeChanReporter(reqs),
+		Interval:                       10 * time.Millisecond,
+		MaxGroups:                      1000,
+		HDRHistogramSignificantFigures: 5,
+	})
+	require.NoError(t, err)
+
+	const chromeUserAgent = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.88 Safari/537.36"
+	input := []transform.Transformable{
+		makeTransaction("frontend", "/products/123", chromeUserAgent, &model.UserExperience{
+			CumulativeLayoutShift: 0.1,
+			FirstInputDelay:       10,
+			TotalBlockingTime:     200,
+			Longtask:              model.LongtaskMetrics{Count: 2, Sum: 150},
+		}),
+		makeTransaction("frontend", "/products/456", chromeUserAgent, &model.UserExperience{
+			CumulativeLayoutShift: 0.25,
+			FirstInputDelay:       -1,
+			TotalBlockingTime:     200,
+			Longtask:              model.LongtaskMetrics{Count: -1},
+		}),
+		makeTransaction("frontend", "/", "", &model.UserExperience{
+			CumulativeLayoutShift: -1,
+			FirstInputDelay:       -1,
+			TotalBlockingTime:     -1,
+			Longtask:              model.LongtaskMetrics{Count: -1},
+		}),
+		// Transactions without user experience metrics are ignored.
+		makeTransaction("frontend", "/", "", nil),
+	}
+	// Transactions other than page loads are ignored.
+	routeChange := makeTransaction("frontend", "/", "", &model.UserExperience{CumulativeLayoutShift: 0.5})
+	routeChange.Type = "route-change"
+	input = append(input, routeChange)
+	out, err := agg.ProcessTransformables(context.Background(), input)
+	require.NoError(t, err)
+	assert.Equal(t, input, out)
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	req := expectPublish(t, reqs)
+	metricsets := make([]*model.Metricset, len(req.Transformables))
+	for i, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		require.NotZero(t, ms.Timestamp)
+		ms.Timestamp = time.Time{}
+		metricsets[i] = ms
+	}
+	assert.ElementsMatch(t, []*model.Metricset{{
+		Name: "web_vitals",
+		Metadata: model.Metadata{
+			Service:   model.Service{Name: "frontend"},
+			UserAgent: model.UserAgent{Name: "Chrome"},
+		},
+		URL: model.MetricsetURL{Pattern: "/products/*"},
+		Samples: []model.Sample{
+			{Name: "web_vitals.count", Value: 2},
+			{Name: "web_vitals.cls.histogram", Counts: []int64{1, 1}, Values: []float64{0.1, 0.25}},
+			{Name: "web_vitals.fid.histogram", Counts: []int64{1}, Values: []float64{10}},
+			{Name: "web_vitals.tbt.histogram", Counts: []int64{2}, Values: []float64{200}},
+			{Name: "web_vitals.longtask.count.histogram", Counts: []int64{1}, Values: []float64{2}},
+			{Name: "web_vitals.longtask.sum.histogram", Counts: []int64{1}, Values: []float64{150}},
+			{Name: "metricset.period", Value: 10},
+		},
+	}, {
+		Name:     "web_vitals",
+		Metadata: model.Metadata{Service: model.Service{Name: "frontend"}},
+		URL:      model.MetricsetURL{Pattern: "/"},
+		Samples: []model.Sample{
+			{Name: "web_vitals.count", Value: 1},
+			{Name: "metricset.period", Value: 10},
+		},
+	}}, metricsets)
+}
+
+func TestAggregatorOverflow(t *testing.T) {
+	reqs := make(chan publish.PendingReq, 1)
+	agg, err := NewAggregator(AggregatorConfig{
+		Report:                         makeChanReporter(reqs),
+		Interval:                       10 * time.Millisecond,
+		MaxGroups:                      3,
+		HDRHistogramSignificantFigures: 2,
+	})
+	require.NoError(t, err)
+
+	ux := &model.UserExperience{CumulativeLayoutShift: 0.1, FirstInputDelay: -1, TotalBlockingTime: -1, Longtask: model.LongtaskMetrics{Count: -1}}
+	input := []transform.Transformable{
+		makeTransaction("service-a", "/a", "", ux),
+		makeTransaction("service-a", "/b", "", ux),
+		makeTransaction("service-b", "/a", "", ux),
+		// Subsequent groups exceed the group limit, and
+		// are aggregated into the overflow group.
+		makeTransaction("service-a", "/c", "", ux),
+		makeTransaction("service-a", "/d", "", ux),
+		makeTransaction("service-b", "/b", "", ux),
+	}
+	_, err = agg.ProcessTransformables(context.Background(), input)
+	require.NoError(t, err)
+
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["webvitals.active_groups"] = 4
+	expectedMonitoring.Ints["webvitals.overflowed"] = 3
+
+	registry := monitoring.NewRegistry()
+	monitoring.NewFunc(registry, "webvitals", agg.CollectMonitoring)
+	assert.Equal(t, expectedMonitoring, monitoring.CollectFlatSnapshot(
+		registry,
+		monitoring.Full,
+		false, // expvar
+	))
+
+	go agg.Run()
+	defer agg.Stop(context.Background())
+
+	type group struct {
+		serviceName string
+		urlPattern  string
+		count       float64
+	}
+	var groups []group
+	req := expectPublish(t, reqs)
+	for _, tf := range req.Transformables {
+		ms := tf.(*model.Metricset)
+		groups = append(groups, group{
+			serviceName: ms.Metadata.Service.Name,
+			urlPattern:  ms.URL.Pattern,
+			count:       ms.Samples[0].Value,
+		})
+	}
+	assert.ElementsMatch(t, []group{
+		{serviceName: "service-a", urlPattern: "/a", count: 1},
+		{serviceName: "service-a", urlPattern: "/b", count: 1},
+		{serviceName: "service-b", urlPattern: "/a", count: 1},
+		{serviceName: "_other", urlPattern: "_other", count: 3},
+	}, groups)
+}
+
+func TestPageURLPattern(t *testing.T) {
+	for path, expected := range map[string]string{
+		"":                      "",
+		"/":                     "/",
+		"/products":             "/products",
+		"/products/123/reviews": "/products/*/reviews",
+		"/orders/6ba7b810-9dad-11d1-80b4-00c04fd430c8": "/orders/*",
+		"/assets/deadbeef": "/assets/deadbeef",
+		"/commits/0cafe1":  "/commits/*",
+		"/api/v2/users":    "/api/v2/users",
+	} {
+		tx := makeTransaction("", path, "", nil)
+		assert.Equal(t, expected, pageURLPattern(tx), path)
+	}
+
+	// Transactions without a page or URL have no pattern.
+	assert.Equal(t, "", pageURLPattern(&model.Transaction{}))
+}
+
+func TestUserAgentFamily(t *testing.T) {
+	for original, expected := range map[string]string{
+		"": "",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.88 Safari/537.36 Edg/87.0.664.66":   "Edge",
+		"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.88 Safari/537.36 OPR/73.0.3856.329": "Opera",
+		"Mozilla/5.0 (X11; Ubuntu; Linux x86_64; rv:84.0) Gecko/20100101 Firefox/84.0":                                                         "Firefox",
+		"Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/87.0.4280.88 Safari/537.36":                             "Chrome",
+		"Mozilla/5.0 (Macintosh; Intel Mac OS X 11_1) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/14.0.2 Safari/605.1.15":                 "Safari",
+		"Mozilla/5.0 (Windows NT 10.0; Trident/7.0; rv:11.0) like Gecko":                                                                       "IE",
+		"curl/7.68.0": "Other",
+	} {
+		assert.Equal(t, expected, userAgentFamily(model.UserAgent{Original: original}), original)
+	}
+
+	// A parsed user agent name takes precedence.
+	assert.Equal(t, "Chrome Mobile", userAgentFamily(model.UserAgent{Name: "Chrome Mobile", Original: "curl/7.68.0"}))
+}
+
+func makeTransaction(serviceName, pagePath, userAgent string, ux *model.UserExperience) *model.Transaction {
+	return &model.Transaction{
+		Metadata: model.Metadata{
+			Service:   model.Service{Name: serviceName},
+			UserAgent: model.UserAgent{Original: userAgent},
+		},
+		Type:                "page-load",
+		Page:                &model.Page{URL: &model.URL{Path: &pagePath}},
+		UserExperience:      ux,
+		RepresentativeCount: 1,
+	}
+}
+
+func makeErrReporter(err error) publish.Reporter {
+	return func(context.Context, publish.PendingReq) error { return err }
+}
+
+func makeChanReporter(ch chan<- publish.PendingReq) publish.Reporter {
+	return func(ctx context.Context, req publish.PendingReq) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case ch <- req:
+			return nil
+		}
+	}
+}
+
+func expectPublish(t *testing.T, ch <-chan publish.PendingReq) publish.PendingReq {
+	t.Helper()
+	select {
+	case req := <-ch:
+		return req
+	case <-time.After(time.Second * 5):
+		t.Fatal("expected publish")
+	}
+	panic("unreachable")
+}
@@ -0,0 +1,90 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package webvitals
+
+import (
+	"strings"
+
+	"github.com/elastic/apm-server/model"
+)
+
+// urlPatternWildcard replaces variable path segments in URL patterns.
+const urlPatternWildcard = "*"
+
+// userAgentFamilies holds the browser families recognised in User-Agent
+// strings, in order of precedence. Many browsers include the tokens of
+// others for compatibility, e.g. Chrome includes "Safari/", so the more
+// specific tokens must be matched first.
+var userAgentFamilies = []struct {
+	token  string
+	family string
+}{
+	{"Edg/", "Edge"},
+	{"Edge/", "Edge"},
+	{"OPR/", "Opera"},
+	{"Opera", "Opera"},
+	{"SamsungBrowser/", "Samsung Internet"},
+	{"Firefox/", "Firefox"},
+	{"FxiOS/", "Firefox"},
+	{"CriOS/", "Chrome"},
+	{"Chrome/", "Chrome"},
+	{"Safari/", "Safari"},
+	{"Trident/", "IE"},
+	{"MSIE ", "IE"},
+}
+
+// pageURLPattern returns the URL path pattern of the page on which the
+// transaction occurred, replacing variable path segments with "*" to
+// bound the number of distinct patterns.
+func pageURLPattern(tx *model.Transaction) string {
+	url := tx.URL
+	if tx.Page != nil && tx.Page.URL != nil {
+		url = tx.Page.URL
+	}
+	if url == nil || url.Path == nil {
+		return ""
+	}
+	segments := strings.Split(*url.Path, "/")
+	for i, segment := range segments {
+		if isVariablePathSegment(segment) {
+			segments[i] = urlPatternWildcard
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// isVariablePathSegment reports whether segment looks like an identifier,
+// such as a numeric ID, a UUID, or a hex-encoded hash.
+func isVariablePathSegment(segment string) bool {
+	var hasDigit bool
+	for _, r := range segment {
+		switch {
+		case r >= '0' && r <= '9':
+			hasDigit = true
+		case r >= 'a' && r <= 'f', r >= 'A' && r <= 'F', r == '-':
+		default:
+			return false
+		}
+	}
+	return hasDigit
+}
+
+// userAgentFamily returns the browser family of the user agent. If the
+// user agent name has been parsed, it is used as is; otherwise the family
+// is determined from well-known tokens in the original User-Agent string.
+func userAgentFamily(userAgent model.UserAgent) string {
+	if userAgent.Name != "" {
+		return userAgent.Name
+	}
+	if userAgent.Original == "" {
+		return ""
+	}
+	for _, f := range userAgentFamilies {
+		if strings.Contains(userAgent.Original, f.token) {
+			return f.family
+		}
+	}
+	return "Other"
+}
@@ -19,5 +19,5 @@ func init() {
 // AssetXPackFields returns asset data.
 // This is the base64 encoded gzipped contents of x-pack/apm-server.
 func AssetXPackFields() string {
-	return "eJyckkFunkAMhfecwso6cAAWlXqASJHafeQOD34rMDO1PU3/21cQSCClv9qyw2N/8zzv1fSMa0ucp9qVo3FwSbGe4CrB6p+Zw3NF5OIjWrr7/PhAX9/76OG1764i6mBBJc/llj5VRERz945KK/WeOHZnB7VlBOklUNaUoS6w+4Wk+F5EJQ40SkA0dNSDvSiMrIQLsZFfQBcxT4PyRL1g7MivGU1FZJek/hRS7GVoybWgotcWa5cLaoo8od2rWuq0IFoaNJW8Vrpr5ElCSz2PhrW4wdbfjdcV5R3sDHcUsh9+2+btZJs/OzkxYPseFTUPg2JgR7d7pdQffNjUWlNVu2RY5tuR+JL5b7IwczaviRVUFiOTzv68zO4qO2ZRs98wtyUpIztiuNI3+AsQSaK5lglx3sWgPyTA/snlVQO8yVBJ3cHqMcXh0D3L/r8wfMgCzCUuD9yssm/G4hT9e1reL1BYTtHw5DKhCalEP8Tgw363hq1MTbE/Tf8KAAD//0/aTyw="
+	return "eJy8WE+P27YTvftTDHz8wRZ+vfpQoE1SIEA2CPKnPS5oaSQRS5HscGjH374gRcqSV1J2t5sCe1ly+Pj45nGG8h4e8HIAYbs9k9BOlCyN3nfIJEu3/25F+bABYMkKD7D97dMdfL3GwV0ft90AVOhKkjYMH+DXDQBAiB6hQkLdgdDV3MTeWSxlLUuwZCwSS3S7iET4t5ckdQNKlqgdVlCjYE/owPmyBeGAW4RWOjYNiQ5qiaoCvlgsNgCuNcT3pdG1bA7A5HEDfYg7xA32oEWHh8wEuZCakU5CxWmISIcg1tlQlcZmjhz+3qeVYE5IcG5l2UZyCRvOSAhklMIKvN0BFk0B21/+320L+GgYHDLUhjIXsP6opGuxAsERSDQNYSOidJlmMTnGSNxEq+ffkPE2jVQXLTpZHqAWymEazJqkfzNe5UmMwObgpnqOFw9JGWby+rmZBVHD3yfCfT47VtfVYOqJnTJbV2w2I4M7K9ad/cWKp1g64AzJEYTgox8NBZudg0lJMAZSwbbo2EXDK8Goywsckc+IGqR2TL5DzViBQzrJEt0LzWqRpMm27LVVRjeT6EB7s5S9VTPceAEdSx3tUCTaKX4OeAH6Fny8AaGzRju8Z9lhURqveRT16Hxri53vCu9eunrOnhlgfm7FvOv2DclZ8i0SGVo17rsQ8QTnRqR560aZg3djjAOLlE0Z3RuHe8s8y6Nx3WbJHc+w3dQHM1lc1P6j745IQeV0NqljGZ0caVIo+nPvO2Hn60TS5U7YtTKRojphAasG53UfSkYuFbk8pOWpAYYU1GS6YRjYhENIGt9HIHTG03PrSMIsWFCD/BrJCrg3uZq2zrV0iS4Wz5CiuRoJPU2s4HgZZCtuCKA+STI6rHwpj3dXiBfSyWRS5H0nbBGc8BoK/+A65LBaSOUJ758YnivQbeEcLZi9KGtNNcn0hL6aIp3vOkGX9fsS0sEtGd+01vMuHzS23uHG5JZr6gzudiBKMs6BUGryaAh6vbT9juw+Z7IFi42NPmDtQFaoWdaXfMoHqasQNmodKTo/HdPZ7pNw21d+C77IUU+qxyNqDs6SWxCwTRtswXguTYfT2nzG4/4kWah5t/2FR/gzTq8Y7YxH6DF+7LFKOiZ59NEjpoY3hnC0y9VqRjcs3MOAaGr4/O0OrGgQlBHV87zlSRVWMCPp5xvr2+cPYAW3kBCyyQIXt+uFPgmS4qjCILfgsAlV7foxlUwokRwQWiXKvt5u/3fjrjMe709XKf6luV7DVFPFJ+jKzbzplt5zi1utvePe+M4rwfKE8EFcjGf40sqa4SSUx0eEaln9bEJ/SHIM77X1DG9RiUuisgOpoZNKSYel0Y/F4iP/bG5fDQsFvytTPoTr9lV2+FR2+br1nwc/m2i4PHowWN67fyQPdlukGHrpf0DQ+W7M7vo1MaU5I+24vqbytcfv2FklaL7MpmYO73LUSrXNBXFAHDocCHCis2rogpN+EJ684bPI9Q/f+DtKBB1FDUFD2Y0/rlxlelbRHRi+RiljEiUWsrpJ+LSAr6T7/duRKuGZaUY/JWWmcMRgs8eX9yrRq1AY9hsB78DQiGIeXiAaMrnENsy9Ls2IeLNLvg8L79ofbvM2rV/TJEgS9k6XLLw0sTS6csXmnwEA+gKybg=="
 }
@@ -17,11 +17,17 @@ import (
 	"github.com/elastic/beats/v7/x-pack/libbeat/licenser"
 
 	"github.com/elastic/apm-server/beater"
+	"github.com/elastic/apm-server/beater/config"
 	"github.com/elastic/apm-server/elasticsearch"
 	"github.com/elastic/apm-server/publish"
 	"github.com/elastic/apm-server/transform"
+	"github.com/elastic/apm-server/x-pack/apm-server/aggregation/breakdownmetrics"
+	"github.com/elastic/apm-server/x-pack/apm-server/aggregation/errormetrics"
+	"github.com/elastic/apm-server/x-pack/apm-server/aggregation/servicemap"
+	"github.com/elastic/apm-server/x-pack/apm-server/aggregation/servicemetrics"
 	"github.com/elastic/apm-server/x-pack/apm-server/aggregation/spanmetrics"
 	"github.com/elastic/apm-server/x-pack/apm-server/aggregation/txmetrics"
+	"github.com/elastic/apm-server/x-pack/apm-server/aggregation/webvitals"
 	"github.com/elastic/apm-server/x-pack/apm-server/cmd"
 	"github.com/elastic/apm-server/x-pack/apm-server/sampling"
 )
@@ -49,14 +55,29 @@ type processor interface {
 // events in sequential order, prior to the events being published.
 func newProcessors(args beater.ServerParams) ([]namedProcessor, error) {
 	var processors []namedProcessor
+	tailSampling := args.Config.Sampling.Tail != nil && args.Config.Sampling.Tail.Enabled
+
+	// Exemplars are chosen before tail-sampling decisions are made,
+	// so they would mostly refer to traces that are then dropped.
+	exemplars := func(name string, enabled bool) bool {
+		if enabled && tailSampling {
+			args.Logger.Warnf("%s exemplars are disabled when tail-based sampling is enabled", name)
+			return false
+		}
+		return enabled
+	}
 	if args.Config.Aggregation.Transactions.Enabled {
 		const name = "transaction metrics aggregation"
 		args.Logger.Infof("creating %s with config: %+v", name, args.Config.Aggregation.Transactions)
 		agg, err := txmetrics.NewAggregator(txmetrics.AggregatorConfig{
 			Report:                         args.Reporter,
 			MaxTransactionGroups:           args.Config.Aggregation.Transactions.MaxTransactionGroups,
+			MaxTransactionGroupsPerService: args.Config.Aggregation.Transactions.MaxTransactionGroupsPerService,
 			MetricsInterval:                args.Config.Aggregation.Transactions.Interval,
 			HDRHistogramSignificantFigures: args.Config.Aggregation.Transactions.HDRHistogramSignificantFigures,
+			ExtraDimensions:                args.Config.Aggregation.Transactions.ExtraDimensions,
+			RollupIntervals:                args.Config.Aggregation.Transactions.RollupIntervals,
+			Exemplars:                      exemplars(name, args.Config.Aggregation.Transactions.Exemplars),
 		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "error creating %s", name)
@@ -68,16 +89,104 @@ func newProcessors(args beater.ServerParams) ([]namedProcessor, error) {
 		const name = "service destinations aggregation"
 		args.Logger.Infof("creating %s with config: %+v", name, args.Config.Aggregation.ServiceDestinations)
 		spanAggregator, err := spanmetrics.NewAggregator(spanmetrics.AggregatorConfig{
-			Report:    args.Reporter,
-			Interval:  args.Config.Aggregation.ServiceDestinations.Interval,
-			MaxGroups: args.Config.Aggregation.ServiceDestinations.MaxGroups,
+			Report:              args.Reporter,
+			Interval:            args.Config.Aggregation.ServiceDestinations.Interval,
+			MaxGroups:           args.Config.Aggregation.ServiceDestinations.MaxGroups,
+			MaxGroupsPerService: args.Config.Aggregation.ServiceDestinations.MaxGroupsPerService,
+			Exemplars:           exemplars(name, args.Config.Aggregation.ServiceDestinations.Exemplars),
+			// The histogram precision is shared with transaction metrics.
+			HDRHistogramSignificantFigures: args.Config.Aggregation.Transactions.HDRHistogramSignificantFigures,
 		})
 		if err != nil {
 			return nil, errors.Wrapf(err, "error creating %s", name)
 		}
 		processors = append(processors, namedProcessor{name: name, processor: spanAggregator})
+		monitoring.NewFunc(aggregationMonitoringRegistry, "spanmetrics", spanAggregator.CollectMonitoring, monitoring.Report)
+	}
+	if args.Config.Aggregation.ServiceSummary.Enabled {
+		const name = "service summary aggregation"
+		args.Logger.Infof("creating %s with config: %+v", name, args.Config.Aggregation.ServiceSummary)
+		serviceAggregator, err := servicemetrics.NewAggregator(servicemetrics.AggregatorConfig{
+			Report:    args.Reporter,
+			Interval:  args.Config.Aggregation.ServiceSummary.Interval,
+			MaxGroups: args.Config.Aggregation.ServiceSummary.MaxGroups,
+			// The histogram precision is shared with transaction metrics.
+			HDRHistogramSignificantFigures: args.Config.Aggregation.Transactions.HDRHistogramSignificantFigures,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating %s", name)
+		}
+		processors = append(processors, namedProcessor{name: name, processor: serviceAggregator})
+		monitoring.NewFunc(aggregationMonitoringRegistry, "servicemetrics", serviceAggregator.CollectMonitoring, monitoring.Report)
+	}
+	if args.Config.Aggregation.Errors.Enabled {
+		const name = "error metrics aggregation"
+		args.Logger.Infof("creating %s with config: %+v", name, args.Config.Aggregation.Errors)
+		errorAggregator, err := errormetrics.NewAggregator(errormetrics.AggregatorConfig{
+			Report:    args.Reporter,
+			Interval:  args.Config.Aggregation.Errors.Interval,
+			MaxGroups: args.Config.Aggregation.Errors.MaxGroups,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating %s", name)
+		}
+		processors = append(processors, namedProcessor{name: name, processor: errorAggregator})
+		monitoring.NewFunc(aggregationMonitoringRegistry, "errormetrics", errorAggregator.CollectMonitoring, monitoring.Report)
+	}
+	if args.Config.Aggregation.ServiceMap.Enabled {
+		const name = "service map aggregation"
+		args.Logger.Infof("creating %s with config: %+v", name, args.Config.Aggregation.ServiceMap)
+		serviceMapAggregator, err := servicemap.NewAggregator(servicemap.AggregatorConfig{
+			Report:           args.Reporter,
+			Interval:         args.Config.Aggregation.ServiceMap.Interval,
+			MaxGroups:        args.Config.Aggregation.ServiceMap.MaxGroups,
+			LookupTTL:        args.Config.Aggregation.ServiceMap.LookupTTL,
+			MaxLookupEntries: args.Config.Aggregation.ServiceMap.MaxLookupEntries,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating %s", name)
+		}
+		processors = append(processors, namedProcessor{name: name, processor: serviceMapAggregator})
+		monitoring.NewFunc(aggregationMonitoringRegistry, "servicemap", serviceMapAggregator.CollectMonitoring, monitoring.Report)
+	}
+	if args.Config.Aggregation.WebVitals.Enabled {
+		const name = "web vitals aggregation"
+		args.Logger.Infof("creating %s with config: %+v", name, args.Config.Aggregation.WebVitals)
+		webVitalsAggregator, err := webvitals.NewAggregator(webvitals.AggregatorConfig{
+			Report:    args.Reporter,
+			Interval:  args.Config.Aggregation.WebVitals.Interval,
+			MaxGroups: args.Config.Aggregation.WebVitals.MaxGroups,
+			// The histogram precision is shared with transaction metrics.
+			HDRHistogramSignificantFigures: args.Config.Aggregation.Transactions.HDRHistogramSignificantFigures,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating %s", name)
+		}
+		processors = append(processors, namedProcessor{name: name, processor: webVitalsAggregator})
+		monitoring.NewFunc(aggregationMonitoringRegistry, "webvitals", webVitalsAggregator.CollectMonitoring, monitoring.Report)
 	}
-	if args.Config.Sampling.Tail != nil && args.Config.Sampling.Tail.Enabled {
+	if args.Config.Aggregation.Breakdown.Enabled {
+		const name = "breakdown metrics aggregation"
+		args.Logger.Infof("creating %s with config: %+v", name, args.Config.Aggregation.Breakdown)
+		breakdownAggregator, err := breakdownmetrics.NewAggregator(breakdownmetrics.AggregatorConfig{
+			Report:      args.Reporter,
+			Interval:    args.Config.Aggregation.Breakdown.Interval,
+			MaxGroups:   args.Config.Aggregation.Breakdown.MaxGroups,
+			TraceWindow: args.Config.Aggregation.Breakdown.TraceWindow,
+			MaxTraces:   args.Config.Aggregation.Breakdown.MaxTraces,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "error creating %s", name)
+		}
+		processors = append(processors, namedProcessor{name: name, processor: breakdownAggregator})
+		monitoring.NewFunc(aggregationMonitoringRegistry, "breakdownmetrics", breakdownAggregator.CollectMonitoring, monitoring.Report)
+	}
+	if tailSampling {
+		// The tail sampler must come after the aggregations, so they
+		// observe every event, whether or not its trace is sampled.
+		// The aggregated metrics therefore reflect true throughput
+		// without the tail sample rate; the tail sampler adjusts the
+		// representative count of only the events it reports.
 		const name = "tail sampler"
 		sampler, err := newTailSamplingProcessor(args)
 		if err != nil {
@@ -89,6 +198,15 @@ func newProcessors(args beater.ServerParams) ([]namedProcessor, error) {
 	return processors, nil
 }
 
+// tailSamplingExplainer adapts sampling.Processor to tailsampling.TraceExplainer.
+type tailSamplingExplainer struct {
+	*sampling.Processor
+}
+
+func (e tailSamplingExplainer) ExplainTrace(traceID string) (interface{}, error) {
+	return e.Processor.ExplainTrace(traceID)
+}
+
 func newTailSamplingProcessor(args beater.ServerParams) (*sampling.Processor, error) {
 	// Tail-based sampling is a Platinum-licensed feature.
 	//
@@ -116,8 +234,15 @@ func newTailSamplingProcessor(args beater.ServerParams) (*sampling.Processor, er
 				ServiceEnvironment: in.Service.Environment,
 				TraceName:          in.Trace.Name,
 				TraceOutcome:       in.Trace.Outcome,
+				TraceDurationMin:   in.Trace.Duration.Min,
+				TraceDurationMax:   in.Trace.Duration.Max,
+				TraceError:         in.Trace.Error,
+				TraceSpanCountMin:  in.Trace.SpanCount.Min,
+				TraceSpanCountMax:  in.Trace.SpanCount.Max,
+				Labels:             in.Labels,
 			},
-			SampleRate: in.SampleRate,
+			SampleRate:         in.SampleRate,
+			MaxTracesPerSecond: in.MaxTracesPerSecond,
 		}
 	}
 	return sampling.NewProcessor(sampling.Config{
@@ -129,16 +254,26 @@ func newTailSamplingProcessor(args beater.ServerParams) (*sampling.Processor, er
 			MaxDynamicServices:    1000,
 			Policies:              policies,
 			IngestRateDecayFactor: tailSamplingConfig.IngestRateDecayFactor,
+			DecisionDelay:         tailSamplingConfig.DecisionDelay,
+			ExplainPolicies:       tailSamplingConfig.ExplainPolicies,
+			LocalRootSampling:     tailSamplingConfig.LocalRootSampling,
 		},
 		RemoteSamplingConfig: sampling.RemoteSamplingConfig{
 			Elasticsearch: es,
 			// TODO(axw) make index name configurable?
 			SampledTracesIndex: "apm-sampled-traces",
+			Peers:              tailSamplingConfig.Peers,
+			PeerListenAddr:     tailSamplingConfig.PeerListenAddr,
+			PeerSecretToken:    tailSamplingConfig.PeerSecretToken,
+			PeerTLS:            tailSamplingConfig.PeerTLS,
+			PeerClientTLS:      tailSamplingConfig.PeerClientTLS,
 		},
 		StorageConfig: sampling.StorageConfig{
-			StorageDir:        paths.Resolve(paths.Data, tailSamplingConfig.StorageDir),
-			StorageGCInterval: tailSamplingConfig.StorageGCInterval,
-			TTL:               tailSamplingConfig.TTL,
+			StorageDir:              paths.Resolve(paths.Data, tailSamplingConfig.StorageDir),
+			StorageGCInterval:       tailSamplingConfig.StorageGCInterval,
+			TTL:                     tailSamplingConfig.TTL,
+			StorageLimit:            int64(tailSamplingConfig.StorageLimit),
+			StorageLimitPassthrough: tailSamplingConfig.StorageLimitAction == config.StorageLimitActionPassthrough,
 		},
 	})
 }
@@ -201,6 +336,11 @@ var rootCmd = cmd.NewXPackRootCommand(beater.NewCreator(beater.CreatorParams{
 			if err != nil {
 				return err
 			}
+			for _, p := range processors {
+				if sampler, ok := p.processor.(*sampling.Processor); ok {
+					args.TailSamplingExplainer = tailSamplingExplainer{sampler}
+				}
+			}
 			return runServerWithProcessors(ctx, runServer, args, processors...)
 		}
 	},
@@ -5,6 +5,7 @@
 package sampling
 
 import (
+	"crypto/tls"
 	"time"
 
 	"github.com/pkg/errors"
@@ -51,10 +52,48 @@ type LocalSamplingConfig struct {
 	// the exponentially weighted moving average (EWMA) ingest rate for each trace
 	// group.
 	IngestRateDecayFactor float64
+
+	// DecisionDelay, if non-zero, holds the amount of time a trace must be
+	// idle, i.e. receive no new events, before a local sampling decision is
+	// made for it. Until then, the trace's events are held in local storage.
+	//
+	// If DecisionDelay is zero, root transactions are matched against policies
+	// as soon as they are received. A non-zero DecisionDelay is required for
+	// policies which consider the whole trace, such as TraceSpanCountMin.
+	DecisionDelay time.Duration
+
+	// ExplainPolicies controls whether the policy matched by each trace's
+	// root transaction is recorded in local storage, so it can be included
+	// in trace explanations. Recording policy matches adds a write to local
+	// storage for every root transaction, so it is disabled by default.
+	ExplainPolicies bool
+
+	// LocalRootSampling controls whether sampling decisions may be made for
+	// "local root" transactions: transactions with a remote parent, such as
+	// server-kind spans received via OpenTelemetry or Jaeger whose trace root
+	// lives in a non-instrumented system.
+	//
+	// If LocalRootSampling is false, sampling decisions are made only for
+	// root transactions, i.e. those without a parent. If true, the first
+	// transaction with a remote parent received for a trace with no local
+	// sampling decision is treated as the trace's root, and subsequent local
+	// roots for the same trace are subject to that transaction's sampling
+	// decision. Only transactions received via Jaeger are known to have a
+	// remote parent; transactions from Elastic APM agents with a parent are
+	// never treated as local roots.
+	LocalRootSampling bool
 }
 
+// MinDecisionDelay is the minimum non-zero LocalSamplingConfig.DecisionDelay.
+// Idle traces are checked at half the decision delay, so very short delays
+// would cause the checks to consume excessive CPU.
+const MinDecisionDelay = time.Second
+
 // RemoteSamplingConfig holds Processor configuration related to publishing and
 // subscribing to remote sampling decisions.
+//
+// Remote sampling decisions are exchanged through Elasticsearch, unless Peers
+// is non-empty, in which case they are exchanged directly with the peers.
 type RemoteSamplingConfig struct {
 	// Elasticsearch holds the Elasticsearch client to use for publishing
 	// and subscribing to remote sampling decisions.
@@ -63,6 +102,29 @@ type RemoteSamplingConfig struct {
 	// SampledTracesIndex holds the name of the Elasticsearch index for
 	// storing and searching sampled trace IDs.
 	SampledTracesIndex string
+
+	// Peers, if non-empty, holds the addresses of other APM Servers to
+	// which sampled trace IDs are published directly over HTTP, instead
+	// of through Elasticsearch.
+	Peers []string
+
+	// PeerListenAddr holds the TCP address on which to listen for sampled
+	// trace IDs published by peers. PeerListenAddr is required if Peers is
+	// non-empty.
+	//
+	// Unless PeerSecretToken is set, the peer listener is unauthenticated,
+	// and PeerListenAddr must only be reachable from a private network.
+	PeerListenAddr string
+
+	// PeerSecretToken, if non-empty, holds a secret shared by all peers,
+	// which is required for publishing sampled trace IDs to this server.
+	PeerSecretToken string
+
+	// PeerTLS, if non-nil, holds the TLS configuration for serving requests
+	// from peers. PeerClientTLS, if non-nil, holds the TLS configuration for
+	// publishing sampled trace IDs to peers.
+	PeerTLS       *tls.Config
+	PeerClientTLS *tls.Config
 }
 
 // StorageConfig holds Processor configuration related to event storage.
@@ -76,6 +138,21 @@ type StorageConfig struct {
 	// TTL holds the amount of time before events and sampling decisions
 	// are expired from local storage.
 	TTL time.Duration
+
+	// StorageLimit, if non-zero, holds the maximum disk usage in bytes of
+	// local storage. Disk usage is measured periodically, so the limit may
+	// be exceeded briefly.
+	//
+	// Once the limit is reached, trace events for which no sampling decision
+	// has been made are no longer written to local storage. Instead they are
+	// either reported immediately or dropped, depending on the value of
+	// StorageLimitPassthrough.
+	StorageLimit int64
+
+	// StorageLimitPassthrough controls whether trace events which cannot be
+	// written to local storage due to StorageLimit are reported immediately,
+	// bypassing tail-sampling (true), or dropped (false).
+	StorageLimitPassthrough bool
 }
 
 // Policy holds a tail-sampling policy: criteria for matching root transactions,
@@ -84,8 +161,19 @@ type Policy struct {
 	PolicyCriteria
 
 	// SampleRate holds the tail-based sample rate to use for traces that
-	// match this policy.
+	// match this policy. A sample rate of 1 will sample all matching traces.
+	//
+	// SampleRate must be zero if MaxTracesPerSecond is non-zero.
 	SampleRate float64
+
+	// MaxTracesPerSecond, if non-zero, holds the maximum number of traces
+	// matching this policy to sample per second, enforced across each flush
+	// interval, and is used instead of SampleRate. Traces are sampled with a fixed-size reservoir, so storage
+	// costs are bounded regardless of the ingest rate.
+	//
+	// For policies without ServiceName specified, the limit applies to
+	// each service separately.
+	MaxTracesPerSecond float64
 }
 
 // PolicyCriteria holds the criteria for matching root transactions to a
@@ -124,6 +212,45 @@ type PolicyCriteria struct {
 	// from the same service) will be grouped together for sampling purposes,
 	// similar to head-based sampling.
 	TraceName string
+
+	// TraceDurationMin, if non-zero, holds the minimum root transaction
+	// duration for which this policy applies.
+	TraceDurationMin time.Duration
+
+	// TraceDurationMax, if non-zero, holds the maximum root transaction
+	// duration for which this policy applies.
+	TraceDurationMax time.Duration
+
+	// TraceError, if non-nil, records whether this policy applies to
+	// traces which contain errors (true), or to traces which do not
+	// contain errors (false).
+	//
+	// If LocalSamplingConfig.DecisionDelay is zero, a trace is considered
+	// to contain errors if an error event for the trace has been received
+	// before its root transaction. Otherwise, a trace is considered to
+	// contain errors if any error event, or any transaction or span with
+	// a "failure" outcome, has been received for the trace.
+	TraceError *bool
+
+	// TraceSpanCountMin, if non-zero, holds the minimum number of spans
+	// a trace must have for this policy to apply.
+	//
+	// TraceSpanCountMin requires a non-zero LocalSamplingConfig.DecisionDelay.
+	TraceSpanCountMin int
+
+	// TraceSpanCountMax, if non-zero, holds the maximum number of spans
+	// a trace may have for this policy to apply.
+	//
+	// TraceSpanCountMax requires a non-zero LocalSamplingConfig.DecisionDelay.
+	TraceSpanCountMax int
+
+	// Labels holds root transaction labels for which this policy applies.
+	//
+	// A root transaction matches if, for each of the labels, it has a
+	// label with the same key and with a value whose string representation
+	// is equal. Labels defined in the root transaction's metadata are
+	// also considered.
+	Labels map[string]string
 }
 
 // Validate validates the configuration.
@@ -160,14 +287,29 @@ func (config LocalSamplingConfig) validate() error {
 		if err := policy.validate(); err != nil {
 			return errors.Wrapf(err, "Policy %d invalid", i)
 		}
+		if config.DecisionDelay == 0 && (policy.TraceSpanCountMin != 0 || policy.TraceSpanCountMax != 0) {
+			return errors.Errorf("Policy %d invalid: TraceSpanCountMin and TraceSpanCountMax require DecisionDelay", i)
+		}
 	}
 	if config.IngestRateDecayFactor <= 0 || config.IngestRateDecayFactor > 1 {
 		return errors.New("IngestRateDecayFactor unspecified or out of range (0,1]")
 	}
+	if config.DecisionDelay < 0 {
+		return errors.New("DecisionDelay negative")
+	}
+	if config.DecisionDelay > 0 && config.DecisionDelay < MinDecisionDelay {
+		return errors.Errorf("DecisionDelay must be zero or at least %s", MinDecisionDelay)
+	}
 	return nil
 }
 
 func (config RemoteSamplingConfig) validate() error {
+	if len(config.Peers) > 0 {
+		if config.PeerListenAddr == "" {
+			return errors.New("PeerListenAddr unspecified")
+		}
+		return nil
+	}
 	if config.Elasticsearch == nil {
 		return errors.New("Elasticsearch unspecified")
 	}
@@ -187,15 +329,33 @@ func (config StorageConfig) validate() error {
 	if config.TTL <= 0 {
 		return errors.New("TTL unspecified or negative")
 	}
+	if config.StorageLimit < 0 {
+		return errors.New("StorageLimit negative")
+	}
 	return nil
 }
 
 func (p Policy) validate() error {
-	// TODO(axw) allow sampling rate of 1.0 (100%), which would
-	// cause the root transaction to be indexed, and a sampling
-	// decision to be written to local storage, immediately.
-	if p.SampleRate < 0 || p.SampleRate >= 1 {
-		return errors.New("SampleRate unspecified or out of range [0,1)")
+	if p.SampleRate < 0 || p.SampleRate > 1 {
+		return errors.New("SampleRate unspecified or out of range [0,1]")
+	}
+	if p.MaxTracesPerSecond < 0 {
+		return errors.New("MaxTracesPerSecond must not be negative")
+	}
+	if p.MaxTracesPerSecond > 0 && p.SampleRate != 0 {
+		return errors.New("SampleRate and MaxTracesPerSecond must not both be specified")
+	}
+	if p.TraceDurationMin < 0 || p.TraceDurationMax < 0 {
+		return errors.New("TraceDurationMin and TraceDurationMax must not be negative")
+	}
+	if p.TraceDurationMax != 0 && p.TraceDurationMax < p.TraceDurationMin {
+		return errors.New("TraceDurationMax must not be less than TraceDurationMin")
+	}
+	if p.TraceSpanCountMin < 0 || p.TraceSpanCountMax < 0 {
+		return errors.New("TraceSpanCountMin and TraceSpanCountMax must not be negative")
+	}
+	if p.TraceSpanCountMax != 0 && p.TraceSpanCountMax < p.TraceSpanCountMin {
+		return errors.New("TraceSpanCountMax must not be less than TraceSpanCountMin")
 	}
 	return nil
 }
@@ -7,6 +7,7 @@ package sampling_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,18 +40,48 @@ func TestNewProcessorConfigInvalid(t *testing.T) {
 
 	assertInvalidConfigError("invalid local sampling config: Policies unspecified")
 	config.Policies = []sampling.Policy{{}}
-	for _, invalid := range []float64{-1, 1.0, 2.0} {
+	for _, invalid := range []float64{-1, 2.0} {
 		config.Policies[0].SampleRate = invalid
-		assertInvalidConfigError("invalid local sampling config: Policy 0 invalid: SampleRate unspecified or out of range [0,1)")
+		assertInvalidConfigError("invalid local sampling config: Policy 0 invalid: SampleRate unspecified or out of range [0,1]")
 	}
 	config.Policies[0].SampleRate = 0.5
 
+	config.Policies[0].MaxTracesPerSecond = -1
+	assertInvalidConfigError("invalid local sampling config: Policy 0 invalid: MaxTracesPerSecond must not be negative")
+	config.Policies[0].MaxTracesPerSecond = 10
+	assertInvalidConfigError("invalid local sampling config: Policy 0 invalid: SampleRate and MaxTracesPerSecond must not both be specified")
+	config.Policies[0].MaxTracesPerSecond = 0
+
+	config.Policies[0].TraceDurationMin = -1
+	assertInvalidConfigError("invalid local sampling config: Policy 0 invalid: TraceDurationMin and TraceDurationMax must not be negative")
+	config.Policies[0].TraceDurationMin = time.Second
+	config.Policies[0].TraceDurationMax = time.Millisecond
+	assertInvalidConfigError("invalid local sampling config: Policy 0 invalid: TraceDurationMax must not be less than TraceDurationMin")
+	config.Policies[0].TraceDurationMax = 0
+
+	config.Policies[0].TraceSpanCountMin = 3
+	config.Policies[0].TraceSpanCountMax = 2
+	assertInvalidConfigError("invalid local sampling config: Policy 0 invalid: TraceSpanCountMax must not be less than TraceSpanCountMin")
+	config.Policies[0].TraceSpanCountMax = 0
+	assertInvalidConfigError("invalid local sampling config: Policy 0 invalid: TraceSpanCountMin and TraceSpanCountMax require DecisionDelay")
+	config.Policies[0].TraceSpanCountMin = 0
+
 	for _, invalid := range []float64{-1, 0, 2.0} {
 		config.IngestRateDecayFactor = invalid
 		assertInvalidConfigError("invalid local sampling config: IngestRateDecayFactor unspecified or out of range (0,1]")
 	}
 	config.IngestRateDecayFactor = 0.5
 
+	config.DecisionDelay = -1
+	assertInvalidConfigError("invalid local sampling config: DecisionDelay negative")
+	config.DecisionDelay = time.Nanosecond
+	assertInvalidConfigError("invalid local sampling config: DecisionDelay must be zero or at least 1s")
+	config.DecisionDelay = 0
+
+	config.Peers = []string{"localhost:8201"}
+	assertInvalidConfigError("invalid remote sampling config: PeerListenAddr unspecified")
+	config.Peers = nil
+
 	assertInvalidConfigError("invalid remote sampling config: Elasticsearch unspecified")
 	var elasticsearchClient struct {
 		elasticsearch.Client
@@ -68,4 +99,8 @@ func TestNewProcessorConfigInvalid(t *testing.T) {
 
 	assertInvalidConfigError("invalid storage config: TTL unspecified or negative")
 	config.TTL = 1
+
+	config.StorageLimit = -1
+	assertInvalidConfigError("invalid storage config: StorageLimit negative")
+	config.StorageLimit = 0
 }
@@ -0,0 +1,406 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package eventstorage
+
+//go:generate go run ./internal/codecgen -o binarycodec_generated.go
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"math"
+	"time"
+
+	jsoniter "github.com/json-iterator/go"
+	"github.com/pkg/errors"
+
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+// binaryCodecVersion is the format version of BinaryCodec-encoded events,
+// stored in the first byte. It must be incremented whenever the encoding
+// changes, including when binarycodec_generated.go is regenerated due to
+// changes in the model types.
+const binaryCodecVersion = 1
+
+// jsonPrefix is the first byte of JSONCodec-encoded events, which
+// can never be a valid BinaryCodec format version.
+const jsonPrefix = '{'
+
+// Tags for interface{} values.
+const (
+	valueNil byte = iota
+	valueString
+	valueBool
+	valueInt
+	valueInt64
+	valueFloat64
+	valueNumber
+	valueSlice
+	valueMap
+	valueMapStr
+	valueStrings
+
+	// valueJSON is used for all other types, which
+	// are encoded as JSON and decoded as by JSONCodec.
+	valueJSON
+)
+
+var errUnexpectedEOF = errors.New("unexpected end of data")
+
+// BinaryCodec is an implementation of Codec, using a compact binary encoding.
+//
+// Encoded events are prefixed with a format version. BinaryCodec can also
+// decode events encoded by JSONCodec, so stored events are not lost when
+// switching codecs.
+type BinaryCodec struct{}
+
+// DecodeSpan decodes data into span.
+func (BinaryCodec) DecodeSpan(data []byte, span *model.Span) error {
+	if len(data) > 0 && data[0] == jsonPrefix {
+		return JSONCodec{}.DecodeSpan(data, span)
+	}
+	d, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	decodeModelSpan(d, span)
+	return d.err
+}
+
+// DecodeTransaction decodes data into tx.
+func (BinaryCodec) DecodeTransaction(data []byte, tx *model.Transaction) error {
+	if len(data) > 0 && data[0] == jsonPrefix {
+		return JSONCodec{}.DecodeTransaction(data, tx)
+	}
+	d, err := newDecoder(data)
+	if err != nil {
+		return err
+	}
+	decodeModelTransaction(d, tx)
+	return d.err
+}
+
+// EncodeSpan encodes span.
+func (BinaryCodec) EncodeSpan(span *model.Span) ([]byte, error) {
+	e := newEncoder()
+	encodeModelSpan(e, span)
+	return e.buf, e.err
+}
+
+// EncodeTransaction encodes tx.
+func (BinaryCodec) EncodeTransaction(tx *model.Transaction) ([]byte, error) {
+	e := newEncoder()
+	encodeModelTransaction(e, tx)
+	return e.buf, e.err
+}
+
+type encoder struct {
+	buf []byte
+	err error
+}
+
+func newEncoder() *encoder {
+	buf := make([]byte, 1, 512)
+	buf[0] = binaryCodecVersion
+	return &encoder{buf: buf}
+}
+
+func (e *encoder) uvarint(v uint64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(buf[:], v)
+	e.buf = append(e.buf, buf[:n]...)
+}
+
+func (e *encoder) varint(v int64) {
+	var buf [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(buf[:], v)
+	e.buf = append(e.buf, buf[:n]...)
+}
+
+func (e *encoder) bool(v bool) {
+	if v {
+		e.buf = append(e.buf, 1)
+	} else {
+		e.buf = append(e.buf, 0)
+	}
+}
+
+func (e *encoder) float32(v float32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], math.Float32bits(v))
+	e.buf = append(e.buf, buf[:]...)
+}
+
+func (e *encoder) float64(v float64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(v))
+	e.buf = append(e.buf, buf[:]...)
+}
+
+func (e *encoder) string(v string) {
+	e.uvarint(uint64(len(v)))
+	e.buf = append(e.buf, v...)
+}
+
+func (e *encoder) bytes(v []byte) {
+	if v == nil {
+		e.uvarint(0)
+		return
+	}
+	e.uvarint(uint64(len(v)) + 1)
+	e.buf = append(e.buf, v...)
+}
+
+// time encodes v with nanosecond precision, and its zone offset.
+// Zone names are not preserved, as with JSON encoding.
+func (e *encoder) time(v time.Time) {
+	_, offset := v.Zone()
+	e.varint(v.Unix())
+	e.uvarint(uint64(v.Nanosecond()))
+	e.varint(int64(offset))
+}
+
+func (e *encoder) value(v interface{}) {
+	switch v := v.(type) {
+	case nil:
+		e.buf = append(e.buf, valueNil)
+	case string:
+		e.buf = append(e.buf, valueString)
+		e.string(v)
+	case bool:
+		e.buf = append(e.buf, valueBool)
+		e.bool(v)
+	case int:
+		e.buf = append(e.buf, valueInt)
+		e.varint(int64(v))
+	case int64:
+		e.buf = append(e.buf, valueInt64)
+		e.varint(v)
+	case float64:
+		e.buf = append(e.buf, valueFloat64)
+		e.float64(v)
+	case json.Number:
+		e.buf = append(e.buf, valueNumber)
+		e.string(string(v))
+	case []interface{}:
+		e.buf = append(e.buf, valueSlice)
+		e.uvarint(uint64(len(v)))
+		for _, v := range v {
+			e.value(v)
+		}
+	case map[string]interface{}:
+		e.buf = append(e.buf, valueMap)
+		e.valueMap(v)
+	case common.MapStr:
+		e.buf = append(e.buf, valueMapStr)
+		e.valueMap(v)
+	case []string:
+		e.buf = append(e.buf, valueStrings)
+		e.uvarint(uint64(len(v)))
+		for _, v := range v {
+			e.string(v)
+		}
+	default:
+		data, err := json.Marshal(v)
+		if err != nil {
+			e.err = err
+			return
+		}
+		e.buf = append(e.buf, valueJSON)
+		e.bytes(data)
+	}
+}
+
+func (e *encoder) valueMap(m map[string]interface{}) {
+	e.uvarint(uint64(len(m)))
+	for k, v := range m {
+		e.string(k)
+		e.value(v)
+	}
+}
+
+// decoder decodes BinaryCodec-encoded data. Once an error occurs, all
+// methods return zero values; the error is recorded in err.
+type decoder struct {
+	data []byte
+	err  error
+}
+
+func newDecoder(data []byte) (*decoder, error) {
+	if len(data) == 0 {
+		return nil, errUnexpectedEOF
+	}
+	if data[0] != binaryCodecVersion {
+		return nil, errors.Errorf("unsupported binary codec version %d", data[0])
+	}
+	return &decoder{data: data[1:]}, nil
+}
+
+func (d *decoder) fail(err error) {
+	if d.err == nil {
+		d.err = err
+	}
+	d.data = nil
+}
+
+func (d *decoder) next(n int) []byte {
+	if n > len(d.data) {
+		d.fail(errUnexpectedEOF)
+		return nil
+	}
+	b := d.data[:n:n]
+	d.data = d.data[n:]
+	return b
+}
+
+func (d *decoder) uvarint() uint64 {
+	v, n := binary.Uvarint(d.data)
+	if n <= 0 {
+		d.fail(errUnexpectedEOF)
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+func (d *decoder) varint() int64 {
+	v, n := binary.Varint(d.data)
+	if n <= 0 {
+		d.fail(errUnexpectedEOF)
+		return 0
+	}
+	d.data = d.data[n:]
+	return v
+}
+
+// length decodes a length, which must be no greater than the
+// number of remaining bytes, as each element is encoded with
+// at least one byte.
+func (d *decoder) length() int {
+	n := d.uvarint()
+	if n > uint64(len(d.data))+1 {
+		d.fail(errUnexpectedEOF)
+		return 0
+	}
+	return int(n)
+}
+
+func (d *decoder) bool() bool {
+	b := d.next(1)
+	return len(b) == 1 && b[0] != 0
+}
+
+func (d *decoder) float32() float32 {
+	b := d.next(4)
+	if b == nil {
+		return 0
+	}
+	return math.Float32frombits(binary.LittleEndian.Uint32(b))
+}
+
+func (d *decoder) float64() float64 {
+	b := d.next(8)
+	if b == nil {
+		return 0
+	}
+	return math.Float64frombits(binary.LittleEndian.Uint64(b))
+}
+
+func (d *decoder) string() string {
+	n := d.uvarint()
+	if n > uint64(len(d.data)) {
+		d.fail(errUnexpectedEOF)
+		return ""
+	}
+	return string(d.next(int(n)))
+}
+
+func (d *decoder) bytes() []byte {
+	n := d.length()
+	if n == 0 {
+		return nil
+	}
+	b := d.next(n - 1)
+	if b == nil {
+		return nil
+	}
+	// Copy the bytes, as data may be reused by the caller.
+	return append([]byte{}, b...)
+}
+
+func (d *decoder) time() time.Time {
+	sec := d.varint()
+	nsec := d.uvarint()
+	offset := d.varint()
+	if d.err != nil {
+		return time.Time{}
+	}
+	t := time.Unix(sec, int64(nsec))
+	if offset == 0 {
+		return t.UTC()
+	}
+	return t.In(time.FixedZone("", int(offset)))
+}
+
+func (d *decoder) value() interface{} {
+	tag := d.next(1)
+	if tag == nil {
+		return nil
+	}
+	switch tag[0] {
+	case valueNil:
+		return nil
+	case valueString:
+		return d.string()
+	case valueBool:
+		return d.bool()
+	case valueInt:
+		return int(d.varint())
+	case valueInt64:
+		return d.varint()
+	case valueFloat64:
+		return d.float64()
+	case valueNumber:
+		return json.Number(d.string())
+	case valueSlice:
+		n := d.length()
+		out := make([]interface{}, n)
+		for i := range out {
+			out[i] = d.value()
+		}
+		return out
+	case valueMap:
+		return d.valueMap()
+	case valueMapStr:
+		return common.MapStr(d.valueMap())
+	case valueStrings:
+		n := d.length()
+		out := make([]string, n)
+		for i := range out {
+			out[i] = d.string()
+		}
+		return out
+	case valueJSON:
+		var out interface{}
+		if err := jsoniter.ConfigFastest.Unmarshal(d.bytes(), &out); err != nil {
+			d.fail(err)
+			return nil
+		}
+		return out
+	}
+	d.fail(errors.Errorf("invalid value tag %d", tag[0]))
+	return nil
+}
+
+func (d *decoder) valueMap() map[string]interface{} {
+	n := d.length()
+	out := make(map[string]interface{}, n)
+	for i := 0; i < n; i++ {
+		k := d.string()
+		out[k] = d.value()
+	}
+	return out
+}
@@ -0,0 +1,1575 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Code generated by codecgen. DO NOT EDIT.
+
+package eventstorage
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func encodeModelTransaction(e *encoder, v *model.Transaction) {
+	encodeModelMetadata(e, &v.Metadata)
+	e.string(v.ID)
+	e.string(v.ParentID)
+	e.string(v.TraceID)
+	e.time(v.Timestamp)
+	e.string(v.Type)
+	e.string(v.Name)
+	e.string(v.Result)
+	e.string(v.Outcome)
+	e.float64(v.Duration)
+	encodeModelTransactionMarks(e, &v.Marks)
+	if v.Message == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelMessage(e, v.Message)
+	}
+	if v.Sampled == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.bool((*v.Sampled))
+	}
+	encodeModelSpanCount(e, &v.SpanCount)
+	if v.Page == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelPage(e, v.Page)
+	}
+	if v.HTTP == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelHttp(e, v.HTTP)
+	}
+	if v.URL == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelURL(e, v.URL)
+	}
+	encodeCommonMapStr(e, &v.Labels)
+	encodeCommonMapStr(e, &v.Custom)
+	if v.UserExperience == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelUserExperience(e, v.UserExperience)
+	}
+	e.value(v.Experimental)
+	e.float64(v.RepresentativeCount)
+}
+
+func decodeModelTransaction(d *decoder, v *model.Transaction) {
+	decodeModelMetadata(d, &v.Metadata)
+	v.ID = d.string()
+	v.ParentID = d.string()
+	v.TraceID = d.string()
+	v.Timestamp = d.time()
+	v.Type = d.string()
+	v.Name = d.string()
+	v.Result = d.string()
+	v.Outcome = d.string()
+	v.Duration = d.float64()
+	decodeModelTransactionMarks(d, &v.Marks)
+	if d.bool() {
+		v.Message = new(model.Message)
+		decodeModelMessage(d, v.Message)
+	} else {
+		v.Message = nil
+	}
+	if d.bool() {
+		v.Sampled = new(bool)
+		(*v.Sampled) = d.bool()
+	} else {
+		v.Sampled = nil
+	}
+	decodeModelSpanCount(d, &v.SpanCount)
+	if d.bool() {
+		v.Page = new(model.Page)
+		decodeModelPage(d, v.Page)
+	} else {
+		v.Page = nil
+	}
+	if d.bool() {
+		v.HTTP = new(model.Http)
+		decodeModelHttp(d, v.HTTP)
+	} else {
+		v.HTTP = nil
+	}
+	if d.bool() {
+		v.URL = new(model.URL)
+		decodeModelURL(d, v.URL)
+	} else {
+		v.URL = nil
+	}
+	decodeCommonMapStr(d, &v.Labels)
+	decodeCommonMapStr(d, &v.Custom)
+	if d.bool() {
+		v.UserExperience = new(model.UserExperience)
+		decodeModelUserExperience(d, v.UserExperience)
+	} else {
+		v.UserExperience = nil
+	}
+	v.Experimental = d.value()
+	v.RepresentativeCount = d.float64()
+}
+
+func encodeModelSpan(e *encoder, v *model.Span) {
+	encodeModelMetadata(e, &v.Metadata)
+	e.string(v.ID)
+	e.string(v.TransactionID)
+	e.string(v.ParentID)
+	if v.ChildIDs == nil {
+		e.uvarint(0)
+	} else {
+		e.uvarint(uint64(len(v.ChildIDs)) + 1)
+		for i0 := range v.ChildIDs {
+			e.string(v.ChildIDs[i0])
+		}
+	}
+	e.string(v.TraceID)
+	e.time(v.Timestamp)
+	if v.Message == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelMessage(e, v.Message)
+	}
+	e.string(v.Name)
+	e.string(v.Outcome)
+	if v.Start == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.float64((*v.Start))
+	}
+	e.float64(v.Duration)
+	if v.Service == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelService(e, v.Service)
+	}
+	encodeModelStacktrace(e, &v.Stacktrace)
+	if v.Sync == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.bool((*v.Sync))
+	}
+	encodeCommonMapStr(e, &v.Labels)
+	e.string(v.Type)
+	if v.Subtype == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Subtype))
+	}
+	if v.Action == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Action))
+	}
+	if v.DB == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelDB(e, v.DB)
+	}
+	if v.HTTP == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelHTTP(e, v.HTTP)
+	}
+	if v.Destination == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelDestination(e, v.Destination)
+	}
+	if v.DestinationService == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelDestinationService(e, v.DestinationService)
+	}
+	e.bool(v.RUM)
+	e.value(v.Experimental)
+	e.float64(v.RepresentativeCount)
+}
+
+func decodeModelSpan(d *decoder, v *model.Span) {
+	decodeModelMetadata(d, &v.Metadata)
+	v.ID = d.string()
+	v.TransactionID = d.string()
+	v.ParentID = d.string()
+	if n0 := d.length(); n0 == 0 {
+		v.ChildIDs = nil
+	} else {
+		v.ChildIDs = make([]string, n0-1)
+		for i0 := range v.ChildIDs {
+			v.ChildIDs[i0] = d.string()
+		}
+	}
+	v.TraceID = d.string()
+	v.Timestamp = d.time()
+	if d.bool() {
+		v.Message = new(model.Message)
+		decodeModelMessage(d, v.Message)
+	} else {
+		v.Message = nil
+	}
+	v.Name = d.string()
+	v.Outcome = d.string()
+	if d.bool() {
+		v.Start = new(float64)
+		(*v.Start) = d.float64()
+	} else {
+		v.Start = nil
+	}
+	v.Duration = d.float64()
+	if d.bool() {
+		v.Service = new(model.Service)
+		decodeModelService(d, v.Service)
+	} else {
+		v.Service = nil
+	}
+	decodeModelStacktrace(d, &v.Stacktrace)
+	if d.bool() {
+		v.Sync = new(bool)
+		(*v.Sync) = d.bool()
+	} else {
+		v.Sync = nil
+	}
+	decodeCommonMapStr(d, &v.Labels)
+	v.Type = d.string()
+	if d.bool() {
+		v.Subtype = new(string)
+		(*v.Subtype) = d.string()
+	} else {
+		v.Subtype = nil
+	}
+	if d.bool() {
+		v.Action = new(string)
+		(*v.Action) = d.string()
+	} else {
+		v.Action = nil
+	}
+	if d.bool() {
+		v.DB = new(model.DB)
+		decodeModelDB(d, v.DB)
+	} else {
+		v.DB = nil
+	}
+	if d.bool() {
+		v.HTTP = new(model.HTTP)
+		decodeModelHTTP(d, v.HTTP)
+	} else {
+		v.HTTP = nil
+	}
+	if d.bool() {
+		v.Destination = new(model.Destination)
+		decodeModelDestination(d, v.Destination)
+	} else {
+		v.Destination = nil
+	}
+	if d.bool() {
+		v.DestinationService = new(model.DestinationService)
+		decodeModelDestinationService(d, v.DestinationService)
+	} else {
+		v.DestinationService = nil
+	}
+	v.RUM = d.bool()
+	v.Experimental = d.value()
+	v.RepresentativeCount = d.float64()
+}
+
+func encodeModelMetadata(e *encoder, v *model.Metadata) {
+	encodeModelService(e, &v.Service)
+	encodeModelProcess(e, &v.Process)
+	encodeModelSystem(e, &v.System)
+	encodeModelUser(e, &v.User)
+	encodeModelUserAgent(e, &v.UserAgent)
+	encodeModelClient(e, &v.Client)
+	encodeModelCloud(e, &v.Cloud)
+	encodeCommonMapStr(e, &v.Labels)
+}
+
+func decodeModelMetadata(d *decoder, v *model.Metadata) {
+	decodeModelService(d, &v.Service)
+	decodeModelProcess(d, &v.Process)
+	decodeModelSystem(d, &v.System)
+	decodeModelUser(d, &v.User)
+	decodeModelUserAgent(d, &v.UserAgent)
+	decodeModelClient(d, &v.Client)
+	decodeModelCloud(d, &v.Cloud)
+	decodeCommonMapStr(d, &v.Labels)
+}
+
+func encodeModelTransactionMarks(e *encoder, v *model.TransactionMarks) {
+	if (*v) == nil {
+		e.uvarint(0)
+	} else {
+		e.uvarint(uint64(len((*v))) + 1)
+		for k0, v0 := range *v {
+			e.string(k0)
+			encodeModelTransactionMark(e, &v0)
+		}
+	}
+}
+
+func decodeModelTransactionMarks(d *decoder, v *model.TransactionMarks) {
+	if n0 := d.length(); n0 == 0 {
+		(*v) = nil
+	} else {
+		(*v) = make(model.TransactionMarks, n0-1)
+		for i0 := 1; i0 < n0; i0++ {
+			k0 := d.string()
+			var v0 model.TransactionMark
+			decodeModelTransactionMark(d, &v0)
+			(*v)[k0] = v0
+		}
+	}
+}
+
+func encodeModelMessage(e *encoder, v *model.Message) {
+	if v.Body == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Body))
+	}
+	encodeHttpHeader(e, &v.Headers)
+	if v.AgeMillis == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.AgeMillis)))
+	}
+	if v.QueueName == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.QueueName))
+	}
+}
+
+func decodeModelMessage(d *decoder, v *model.Message) {
+	if d.bool() {
+		v.Body = new(string)
+		(*v.Body) = d.string()
+	} else {
+		v.Body = nil
+	}
+	decodeHttpHeader(d, &v.Headers)
+	if d.bool() {
+		v.AgeMillis = new(int)
+		(*v.AgeMillis) = int(d.varint())
+	} else {
+		v.AgeMillis = nil
+	}
+	if d.bool() {
+		v.QueueName = new(string)
+		(*v.QueueName) = d.string()
+	} else {
+		v.QueueName = nil
+	}
+}
+
+func encodeModelSpanCount(e *encoder, v *model.SpanCount) {
+	if v.Dropped == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.Dropped)))
+	}
+	if v.Started == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.Started)))
+	}
+}
+
+func decodeModelSpanCount(d *decoder, v *model.SpanCount) {
+	if d.bool() {
+		v.Dropped = new(int)
+		(*v.Dropped) = int(d.varint())
+	} else {
+		v.Dropped = nil
+	}
+	if d.bool() {
+		v.Started = new(int)
+		(*v.Started) = int(d.varint())
+	} else {
+		v.Started = nil
+	}
+}
+
+func encodeModelPage(e *encoder, v *model.Page) {
+	if v.URL == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelURL(e, v.URL)
+	}
+	if v.Referer == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Referer))
+	}
+}
+
+func decodeModelPage(d *decoder, v *model.Page) {
+	if d.bool() {
+		v.URL = new(model.URL)
+		decodeModelURL(d, v.URL)
+	} else {
+		v.URL = nil
+	}
+	if d.bool() {
+		v.Referer = new(string)
+		(*v.Referer) = d.string()
+	} else {
+		v.Referer = nil
+	}
+}
+
+func encodeModelHttp(e *encoder, v *model.Http) {
+	if v.Version == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Version))
+	}
+	if v.Request == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelReq(e, v.Request)
+	}
+	if v.Response == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelResp(e, v.Response)
+	}
+}
+
+func decodeModelHttp(d *decoder, v *model.Http) {
+	if d.bool() {
+		v.Version = new(string)
+		(*v.Version) = d.string()
+	} else {
+		v.Version = nil
+	}
+	if d.bool() {
+		v.Request = new(model.Req)
+		decodeModelReq(d, v.Request)
+	} else {
+		v.Request = nil
+	}
+	if d.bool() {
+		v.Response = new(model.Resp)
+		decodeModelResp(d, v.Response)
+	} else {
+		v.Response = nil
+	}
+}
+
+func encodeModelURL(e *encoder, v *model.URL) {
+	if v.Original == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Original))
+	}
+	if v.Scheme == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Scheme))
+	}
+	if v.Full == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Full))
+	}
+	if v.Domain == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Domain))
+	}
+	if v.Port == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.Port)))
+	}
+	if v.Path == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Path))
+	}
+	if v.Query == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Query))
+	}
+	if v.Fragment == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Fragment))
+	}
+}
+
+func decodeModelURL(d *decoder, v *model.URL) {
+	if d.bool() {
+		v.Original = new(string)
+		(*v.Original) = d.string()
+	} else {
+		v.Original = nil
+	}
+	if d.bool() {
+		v.Scheme = new(string)
+		(*v.Scheme) = d.string()
+	} else {
+		v.Scheme = nil
+	}
+	if d.bool() {
+		v.Full = new(string)
+		(*v.Full) = d.string()
+	} else {
+		v.Full = nil
+	}
+	if d.bool() {
+		v.Domain = new(string)
+		(*v.Domain) = d.string()
+	} else {
+		v.Domain = nil
+	}
+	if d.bool() {
+		v.Port = new(int)
+		(*v.Port) = int(d.varint())
+	} else {
+		v.Port = nil
+	}
+	if d.bool() {
+		v.Path = new(string)
+		(*v.Path) = d.string()
+	} else {
+		v.Path = nil
+	}
+	if d.bool() {
+		v.Query = new(string)
+		(*v.Query) = d.string()
+	} else {
+		v.Query = nil
+	}
+	if d.bool() {
+		v.Fragment = new(string)
+		(*v.Fragment) = d.string()
+	} else {
+		v.Fragment = nil
+	}
+}
+
+func encodeCommonMapStr(e *encoder, v *common.MapStr) {
+	if (*v) == nil {
+		e.uvarint(0)
+	} else {
+		e.uvarint(uint64(len((*v))) + 1)
+		for k0, v0 := range *v {
+			e.string(k0)
+			e.value(v0)
+		}
+	}
+}
+
+func decodeCommonMapStr(d *decoder, v *common.MapStr) {
+	if n0 := d.length(); n0 == 0 {
+		(*v) = nil
+	} else {
+		(*v) = make(common.MapStr, n0-1)
+		for i0 := 1; i0 < n0; i0++ {
+			k0 := d.string()
+			var v0 interface{}
+			v0 = d.value()
+			(*v)[k0] = v0
+		}
+	}
+}
+
+func encodeModelUserExperience(e *encoder, v *model.UserExperience) {
+	e.float64(v.CumulativeLayoutShift)
+	e.float64(v.FirstInputDelay)
+	e.float64(v.TotalBlockingTime)
+	encodeModelLongtaskMetrics(e, &v.Longtask)
+}
+
+func decodeModelUserExperience(d *decoder, v *model.UserExperience) {
+	v.CumulativeLayoutShift = d.float64()
+	v.FirstInputDelay = d.float64()
+	v.TotalBlockingTime = d.float64()
+	decodeModelLongtaskMetrics(d, &v.Longtask)
+}
+
+func encodeModelService(e *encoder, v *model.Service) {
+	e.string(v.Name)
+	e.string(v.Version)
+	e.string(v.Environment)
+	encodeModelLanguage(e, &v.Language)
+	encodeModelRuntime(e, &v.Runtime)
+	encodeModelFramework(e, &v.Framework)
+	encodeModelAgent(e, &v.Agent)
+	encodeModelServiceNode(e, &v.Node)
+}
+
+func decodeModelService(d *decoder, v *model.Service) {
+	v.Name = d.string()
+	v.Version = d.string()
+	v.Environment = d.string()
+	decodeModelLanguage(d, &v.Language)
+	decodeModelRuntime(d, &v.Runtime)
+	decodeModelFramework(d, &v.Framework)
+	decodeModelAgent(d, &v.Agent)
+	decodeModelServiceNode(d, &v.Node)
+}
+
+func encodeModelStacktrace(e *encoder, v *model.Stacktrace) {
+	if (*v) == nil {
+		e.uvarint(0)
+	} else {
+		e.uvarint(uint64(len((*v))) + 1)
+		for i0 := range *v {
+			if (*v)[i0] == nil {
+				e.bool(false)
+			} else {
+				e.bool(true)
+				encodeModelStacktraceFrame(e, (*v)[i0])
+			}
+		}
+	}
+}
+
+func decodeModelStacktrace(d *decoder, v *model.Stacktrace) {
+	if n0 := d.length(); n0 == 0 {
+		(*v) = nil
+	} else {
+		(*v) = make(model.Stacktrace, n0-1)
+		for i0 := range *v {
+			if d.bool() {
+				(*v)[i0] = new(model.StacktraceFrame)
+				decodeModelStacktraceFrame(d, (*v)[i0])
+			} else {
+				(*v)[i0] = nil
+			}
+		}
+	}
+}
+
+func encodeModelDB(e *encoder, v *model.DB) {
+	if v.Instance == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Instance))
+	}
+	if v.Statement == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Statement))
+	}
+	if v.Type == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Type))
+	}
+	if v.UserName == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.UserName))
+	}
+	if v.Link == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Link))
+	}
+	if v.RowsAffected == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.RowsAffected)))
+	}
+}
+
+func decodeModelDB(d *decoder, v *model.DB) {
+	if d.bool() {
+		v.Instance = new(string)
+		(*v.Instance) = d.string()
+	} else {
+		v.Instance = nil
+	}
+	if d.bool() {
+		v.Statement = new(string)
+		(*v.Statement) = d.string()
+	} else {
+		v.Statement = nil
+	}
+	if d.bool() {
+		v.Type = new(string)
+		(*v.Type) = d.string()
+	} else {
+		v.Type = nil
+	}
+	if d.bool() {
+		v.UserName = new(string)
+		(*v.UserName) = d.string()
+	} else {
+		v.UserName = nil
+	}
+	if d.bool() {
+		v.Link = new(string)
+		(*v.Link) = d.string()
+	} else {
+		v.Link = nil
+	}
+	if d.bool() {
+		v.RowsAffected = new(int)
+		(*v.RowsAffected) = int(d.varint())
+	} else {
+		v.RowsAffected = nil
+	}
+}
+
+func encodeModelHTTP(e *encoder, v *model.HTTP) {
+	if v.URL == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.URL))
+	}
+	if v.StatusCode == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.StatusCode)))
+	}
+	if v.Method == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Method))
+	}
+	if v.Response == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelMinimalResp(e, v.Response)
+	}
+}
+
+func decodeModelHTTP(d *decoder, v *model.HTTP) {
+	if d.bool() {
+		v.URL = new(string)
+		(*v.URL) = d.string()
+	} else {
+		v.URL = nil
+	}
+	if d.bool() {
+		v.StatusCode = new(int)
+		(*v.StatusCode) = int(d.varint())
+	} else {
+		v.StatusCode = nil
+	}
+	if d.bool() {
+		v.Method = new(string)
+		(*v.Method) = d.string()
+	} else {
+		v.Method = nil
+	}
+	if d.bool() {
+		v.Response = new(model.MinimalResp)
+		decodeModelMinimalResp(d, v.Response)
+	} else {
+		v.Response = nil
+	}
+}
+
+func encodeModelDestination(e *encoder, v *model.Destination) {
+	if v.Address == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Address))
+	}
+	if v.Port == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.Port)))
+	}
+}
+
+func decodeModelDestination(d *decoder, v *model.Destination) {
+	if d.bool() {
+		v.Address = new(string)
+		(*v.Address) = d.string()
+	} else {
+		v.Address = nil
+	}
+	if d.bool() {
+		v.Port = new(int)
+		(*v.Port) = int(d.varint())
+	} else {
+		v.Port = nil
+	}
+}
+
+func encodeModelDestinationService(e *encoder, v *model.DestinationService) {
+	if v.Type == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Type))
+	}
+	if v.Name == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Name))
+	}
+	if v.Resource == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Resource))
+	}
+}
+
+func decodeModelDestinationService(d *decoder, v *model.DestinationService) {
+	if d.bool() {
+		v.Type = new(string)
+		(*v.Type) = d.string()
+	} else {
+		v.Type = nil
+	}
+	if d.bool() {
+		v.Name = new(string)
+		(*v.Name) = d.string()
+	} else {
+		v.Name = nil
+	}
+	if d.bool() {
+		v.Resource = new(string)
+		(*v.Resource) = d.string()
+	} else {
+		v.Resource = nil
+	}
+}
+
+func encodeModelProcess(e *encoder, v *model.Process) {
+	e.varint(int64(v.Pid))
+	if v.Ppid == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.Ppid)))
+	}
+	e.string(v.Title)
+	if v.Argv == nil {
+		e.uvarint(0)
+	} else {
+		e.uvarint(uint64(len(v.Argv)) + 1)
+		for i0 := range v.Argv {
+			e.string(v.Argv[i0])
+		}
+	}
+}
+
+func decodeModelProcess(d *decoder, v *model.Process) {
+	v.Pid = int(d.varint())
+	if d.bool() {
+		v.Ppid = new(int)
+		(*v.Ppid) = int(d.varint())
+	} else {
+		v.Ppid = nil
+	}
+	v.Title = d.string()
+	if n0 := d.length(); n0 == 0 {
+		v.Argv = nil
+	} else {
+		v.Argv = make([]string, n0-1)
+		for i0 := range v.Argv {
+			v.Argv[i0] = d.string()
+		}
+	}
+}
+
+func encodeModelSystem(e *encoder, v *model.System) {
+	e.string(v.DetectedHostname)
+	e.string(v.ConfiguredHostname)
+	e.string(v.Architecture)
+	e.string(v.Platform)
+	encodeNetIP(e, &v.IP)
+	encodeModelContainer(e, &v.Container)
+	encodeModelKubernetes(e, &v.Kubernetes)
+}
+
+func decodeModelSystem(d *decoder, v *model.System) {
+	v.DetectedHostname = d.string()
+	v.ConfiguredHostname = d.string()
+	v.Architecture = d.string()
+	v.Platform = d.string()
+	decodeNetIP(d, &v.IP)
+	decodeModelContainer(d, &v.Container)
+	decodeModelKubernetes(d, &v.Kubernetes)
+}
+
+func encodeModelUser(e *encoder, v *model.User) {
+	e.string(v.ID)
+	e.string(v.Email)
+	e.string(v.Name)
+}
+
+func decodeModelUser(d *decoder, v *model.User) {
+	v.ID = d.string()
+	v.Email = d.string()
+	v.Name = d.string()
+}
+
+func encodeModelUserAgent(e *encoder, v *model.UserAgent) {
+	e.string(v.Original)
+	e.string(v.Name)
+}
+
+func decodeModelUserAgent(d *decoder, v *model.UserAgent) {
+	v.Original = d.string()
+	v.Name = d.string()
+}
+
+func encodeModelClient(e *encoder, v *model.Client) {
+	encodeNetIP(e, &v.IP)
+}
+
+func decodeModelClient(d *decoder, v *model.Client) {
+	decodeNetIP(d, &v.IP)
+}
+
+func encodeModelCloud(e *encoder, v *model.Cloud) {
+	e.string(v.AccountID)
+	e.string(v.AccountName)
+	e.string(v.AvailabilityZone)
+	e.string(v.InstanceID)
+	e.string(v.InstanceName)
+	e.string(v.MachineType)
+	e.string(v.ProjectID)
+	e.string(v.ProjectName)
+	e.string(v.Provider)
+	e.string(v.Region)
+}
+
+func decodeModelCloud(d *decoder, v *model.Cloud) {
+	v.AccountID = d.string()
+	v.AccountName = d.string()
+	v.AvailabilityZone = d.string()
+	v.InstanceID = d.string()
+	v.InstanceName = d.string()
+	v.MachineType = d.string()
+	v.ProjectID = d.string()
+	v.ProjectName = d.string()
+	v.Provider = d.string()
+	v.Region = d.string()
+}
+
+func encodeModelTransactionMark(e *encoder, v *model.TransactionMark) {
+	if (*v) == nil {
+		e.uvarint(0)
+	} else {
+		e.uvarint(uint64(len((*v))) + 1)
+		for k0, v0 := range *v {
+			e.string(k0)
+			e.float64(v0)
+		}
+	}
+}
+
+func decodeModelTransactionMark(d *decoder, v *model.TransactionMark) {
+	if n0 := d.length(); n0 == 0 {
+		(*v) = nil
+	} else {
+		(*v) = make(model.TransactionMark, n0-1)
+		for i0 := 1; i0 < n0; i0++ {
+			k0 := d.string()
+			var v0 float64
+			v0 = d.float64()
+			(*v)[k0] = v0
+		}
+	}
+}
+
+func encodeHttpHeader(e *encoder, v *http.Header) {
+	if (*v) == nil {
+		e.uvarint(0)
+	} else {
+		e.uvarint(uint64(len((*v))) + 1)
+		for k0, v0 := range *v {
+			e.string(k0)
+			if v0 == nil {
+				e.uvarint(0)
+			} else {
+				e.uvarint(uint64(len(v0)) + 1)
+				for i1 := range v0 {
+					e.string(v0[i1])
+				}
+			}
+		}
+	}
+}
+
+func decodeHttpHeader(d *decoder, v *http.Header) {
+	if n0 := d.length(); n0 == 0 {
+		(*v) = nil
+	} else {
+		(*v) = make(http.Header, n0-1)
+		for i0 := 1; i0 < n0; i0++ {
+			k0 := d.string()
+			var v0 []string
+			if n1 := d.length(); n1 == 0 {
+				v0 = nil
+			} else {
+				v0 = make([]string, n1-1)
+				for i1 := range v0 {
+					v0[i1] = d.string()
+				}
+			}
+			(*v)[k0] = v0
+		}
+	}
+}
+
+func encodeModelReq(e *encoder, v *model.Req) {
+	e.string(v.Method)
+	e.value(v.Body)
+	encodeHttpHeader(e, &v.Headers)
+	e.value(v.Env)
+	if v.Socket == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		encodeModelSocket(e, v.Socket)
+	}
+	e.value(v.Cookies)
+}
+
+func decodeModelReq(d *decoder, v *model.Req) {
+	v.Method = d.string()
+	v.Body = d.value()
+	decodeHttpHeader(d, &v.Headers)
+	v.Env = d.value()
+	if d.bool() {
+		v.Socket = new(model.Socket)
+		decodeModelSocket(d, v.Socket)
+	} else {
+		v.Socket = nil
+	}
+	v.Cookies = d.value()
+}
+
+func encodeModelResp(e *encoder, v *model.Resp) {
+	if v.Finished == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.bool((*v.Finished))
+	}
+	if v.HeadersSent == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.bool((*v.HeadersSent))
+	}
+	encodeModelMinimalResp(e, &v.MinimalResp)
+}
+
+func decodeModelResp(d *decoder, v *model.Resp) {
+	if d.bool() {
+		v.Finished = new(bool)
+		(*v.Finished) = d.bool()
+	} else {
+		v.Finished = nil
+	}
+	if d.bool() {
+		v.HeadersSent = new(bool)
+		(*v.HeadersSent) = d.bool()
+	} else {
+		v.HeadersSent = nil
+	}
+	decodeModelMinimalResp(d, &v.MinimalResp)
+}
+
+func encodeModelLongtaskMetrics(e *encoder, v *model.LongtaskMetrics) {
+	e.varint(int64(v.Count))
+	e.float64(v.Sum)
+	e.float64(v.Max)
+}
+
+func decodeModelLongtaskMetrics(d *decoder, v *model.LongtaskMetrics) {
+	v.Count = int(d.varint())
+	v.Sum = d.float64()
+	v.Max = d.float64()
+}
+
+func encodeModelLanguage(e *encoder, v *model.Language) {
+	e.string(v.Name)
+	e.string(v.Version)
+}
+
+func decodeModelLanguage(d *decoder, v *model.Language) {
+	v.Name = d.string()
+	v.Version = d.string()
+}
+
+func encodeModelRuntime(e *encoder, v *model.Runtime) {
+	e.string(v.Name)
+	e.string(v.Version)
+}
+
+func decodeModelRuntime(d *decoder, v *model.Runtime) {
+	v.Name = d.string()
+	v.Version = d.string()
+}
+
+func encodeModelFramework(e *encoder, v *model.Framework) {
+	e.string(v.Name)
+	e.string(v.Version)
+}
+
+func decodeModelFramework(d *decoder, v *model.Framework) {
+	v.Name = d.string()
+	v.Version = d.string()
+}
+
+func encodeModelAgent(e *encoder, v *model.Agent) {
+	e.string(v.Name)
+	e.string(v.Version)
+	e.string(v.EphemeralID)
+}
+
+func decodeModelAgent(d *decoder, v *model.Agent) {
+	v.Name = d.string()
+	v.Version = d.string()
+	v.EphemeralID = d.string()
+}
+
+func encodeModelServiceNode(e *encoder, v *model.ServiceNode) {
+	e.string(v.Name)
+}
+
+func decodeModelServiceNode(d *decoder, v *model.ServiceNode) {
+	v.Name = d.string()
+}
+
+func encodeModelStacktraceFrame(e *encoder, v *model.StacktraceFrame) {
+	if v.AbsPath == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.AbsPath))
+	}
+	if v.Filename == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Filename))
+	}
+	if v.Classname == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Classname))
+	}
+	if v.Lineno == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.Lineno)))
+	}
+	if v.Colno == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.Colno)))
+	}
+	if v.ContextLine == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.ContextLine))
+	}
+	if v.Module == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Module))
+	}
+	if v.Function == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Function))
+	}
+	if v.LibraryFrame == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.bool((*v.LibraryFrame))
+	}
+	encodeCommonMapStr(e, &v.Vars)
+	if v.PreContext == nil {
+		e.uvarint(0)
+	} else {
+		e.uvarint(uint64(len(v.PreContext)) + 1)
+		for i0 := range v.PreContext {
+			e.string(v.PreContext[i0])
+		}
+	}
+	if v.PostContext == nil {
+		e.uvarint(0)
+	} else {
+		e.uvarint(uint64(len(v.PostContext)) + 1)
+		for i0 := range v.PostContext {
+			e.string(v.PostContext[i0])
+		}
+	}
+	e.bool(v.ExcludeFromGrouping)
+	if v.SourcemapUpdated == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.bool((*v.SourcemapUpdated))
+	}
+	if v.SourcemapError == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.SourcemapError))
+	}
+	encodeModelOriginal(e, &v.Original)
+}
+
+func decodeModelStacktraceFrame(d *decoder, v *model.StacktraceFrame) {
+	if d.bool() {
+		v.AbsPath = new(string)
+		(*v.AbsPath) = d.string()
+	} else {
+		v.AbsPath = nil
+	}
+	if d.bool() {
+		v.Filename = new(string)
+		(*v.Filename) = d.string()
+	} else {
+		v.Filename = nil
+	}
+	if d.bool() {
+		v.Classname = new(string)
+		(*v.Classname) = d.string()
+	} else {
+		v.Classname = nil
+	}
+	if d.bool() {
+		v.Lineno = new(int)
+		(*v.Lineno) = int(d.varint())
+	} else {
+		v.Lineno = nil
+	}
+	if d.bool() {
+		v.Colno = new(int)
+		(*v.Colno) = int(d.varint())
+	} else {
+		v.Colno = nil
+	}
+	if d.bool() {
+		v.ContextLine = new(string)
+		(*v.ContextLine) = d.string()
+	} else {
+		v.ContextLine = nil
+	}
+	if d.bool() {
+		v.Module = new(string)
+		(*v.Module) = d.string()
+	} else {
+		v.Module = nil
+	}
+	if d.bool() {
+		v.Function = new(string)
+		(*v.Function) = d.string()
+	} else {
+		v.Function = nil
+	}
+	if d.bool() {
+		v.LibraryFrame = new(bool)
+		(*v.LibraryFrame) = d.bool()
+	} else {
+		v.LibraryFrame = nil
+	}
+	decodeCommonMapStr(d, &v.Vars)
+	if n0 := d.length(); n0 == 0 {
+		v.PreContext = nil
+	} else {
+		v.PreContext = make([]string, n0-1)
+		for i0 := range v.PreContext {
+			v.PreContext[i0] = d.string()
+		}
+	}
+	if n0 := d.length(); n0 == 0 {
+		v.PostContext = nil
+	} else {
+		v.PostContext = make([]string, n0-1)
+		for i0 := range v.PostContext {
+			v.PostContext[i0] = d.string()
+		}
+	}
+	v.ExcludeFromGrouping = d.bool()
+	if d.bool() {
+		v.SourcemapUpdated = new(bool)
+		(*v.SourcemapUpdated) = d.bool()
+	} else {
+		v.SourcemapUpdated = nil
+	}
+	if d.bool() {
+		v.SourcemapError = new(string)
+		(*v.SourcemapError) = d.string()
+	} else {
+		v.SourcemapError = nil
+	}
+	decodeModelOriginal(d, &v.Original)
+}
+
+func encodeModelMinimalResp(e *encoder, v *model.MinimalResp) {
+	if v.StatusCode == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.StatusCode)))
+	}
+	encodeHttpHeader(e, &v.Headers)
+	if v.TransferSize == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.float64((*v.TransferSize))
+	}
+	if v.EncodedBodySize == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.float64((*v.EncodedBodySize))
+	}
+	if v.DecodedBodySize == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.float64((*v.DecodedBodySize))
+	}
+}
+
+func decodeModelMinimalResp(d *decoder, v *model.MinimalResp) {
+	if d.bool() {
+		v.StatusCode = new(int)
+		(*v.StatusCode) = int(d.varint())
+	} else {
+		v.StatusCode = nil
+	}
+	decodeHttpHeader(d, &v.Headers)
+	if d.bool() {
+		v.TransferSize = new(float64)
+		(*v.TransferSize) = d.float64()
+	} else {
+		v.TransferSize = nil
+	}
+	if d.bool() {
+		v.EncodedBodySize = new(float64)
+		(*v.EncodedBodySize) = d.float64()
+	} else {
+		v.EncodedBodySize = nil
+	}
+	if d.bool() {
+		v.DecodedBodySize = new(float64)
+		(*v.DecodedBodySize) = d.float64()
+	} else {
+		v.DecodedBodySize = nil
+	}
+}
+
+func encodeNetIP(e *encoder, v *net.IP) {
+	e.bytes([]byte((*v)))
+}
+
+func decodeNetIP(d *decoder, v *net.IP) {
+	(*v) = net.IP(d.bytes())
+}
+
+func encodeModelContainer(e *encoder, v *model.Container) {
+	e.string(v.ID)
+}
+
+func decodeModelContainer(d *decoder, v *model.Container) {
+	v.ID = d.string()
+}
+
+func encodeModelKubernetes(e *encoder, v *model.Kubernetes) {
+	e.string(v.Namespace)
+	e.string(v.NodeName)
+	e.string(v.PodName)
+	e.string(v.PodUID)
+}
+
+func decodeModelKubernetes(d *decoder, v *model.Kubernetes) {
+	v.Namespace = d.string()
+	v.NodeName = d.string()
+	v.PodName = d.string()
+	v.PodUID = d.string()
+}
+
+func encodeModelSocket(e *encoder, v *model.Socket) {
+	if v.RemoteAddress == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.RemoteAddress))
+	}
+	if v.Encrypted == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.bool((*v.Encrypted))
+	}
+}
+
+func decodeModelSocket(d *decoder, v *model.Socket) {
+	if d.bool() {
+		v.RemoteAddress = new(string)
+		(*v.RemoteAddress) = d.string()
+	} else {
+		v.RemoteAddress = nil
+	}
+	if d.bool() {
+		v.Encrypted = new(bool)
+		(*v.Encrypted) = d.bool()
+	} else {
+		v.Encrypted = nil
+	}
+}
+
+func encodeModelOriginal(e *encoder, v *model.Original) {
+	if v.AbsPath == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.AbsPath))
+	}
+	if v.Filename == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Filename))
+	}
+	if v.Classname == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Classname))
+	}
+	if v.Lineno == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.Lineno)))
+	}
+	if v.Colno == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.varint(int64((*v.Colno)))
+	}
+	if v.Function == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.string((*v.Function))
+	}
+	if v.LibraryFrame == nil {
+		e.bool(false)
+	} else {
+		e.bool(true)
+		e.bool((*v.LibraryFrame))
+	}
+}
+
+func decodeModelOriginal(d *decoder, v *model.Original) {
+	if d.bool() {
+		v.AbsPath = new(string)
+		(*v.AbsPath) = d.string()
+	} else {
+		v.AbsPath = nil
+	}
+	if d.bool() {
+		v.Filename = new(string)
+		(*v.Filename) = d.string()
+	} else {
+		v.Filename = nil
+	}
+	if d.bool() {
+		v.Classname = new(string)
+		(*v.Classname) = d.string()
+	} else {
+		v.Classname = nil
+	}
+	if d.bool() {
+		v.Lineno = new(int)
+		(*v.Lineno) = int(d.varint())
+	} else {
+		v.Lineno = nil
+	}
+	if d.bool() {
+		v.Colno = new(int)
+		(*v.Colno) = int(d.varint())
+	} else {
+		v.Colno = nil
+	}
+	if d.bool() {
+		v.Function = new(string)
+		(*v.Function) = d.string()
+	} else {
+		v.Function = nil
+	}
+	if d.bool() {
+		v.LibraryFrame = new(bool)
+		(*v.LibraryFrame) = d.bool()
+	} else {
+		v.LibraryFrame = nil
+	}
+}
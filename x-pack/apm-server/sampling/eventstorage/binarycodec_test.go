@@ -0,0 +1,231 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package eventstorage_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/x-pack/apm-server/sampling/eventstorage"
+	"github.com/elastic/beats/v7/libbeat/common"
+)
+
+func TestBinaryCodecTransaction(t *testing.T) {
+	var tx model.Transaction
+	populateStruct(reflect.ValueOf(&tx).Elem())
+
+	codec := eventstorage.BinaryCodec{}
+	data, err := codec.EncodeTransaction(&tx)
+	require.NoError(t, err)
+
+	var decoded model.Transaction
+	require.NoError(t, codec.DecodeTransaction(data, &decoded))
+	assert.Equal(t, tx, decoded)
+}
+
+func TestBinaryCodecSpan(t *testing.T) {
+	var span model.Span
+	populateStruct(reflect.ValueOf(&span).Elem())
+
+	codec := eventstorage.BinaryCodec{}
+	data, err := codec.EncodeSpan(&span)
+	require.NoError(t, err)
+
+	var decoded model.Span
+	require.NoError(t, codec.DecodeSpan(data, &decoded))
+	assert.Equal(t, span, decoded)
+}
+
+func TestBinaryCodecZeroValues(t *testing.T) {
+	codec := eventstorage.BinaryCodec{}
+	data, err := codec.EncodeTransaction(&model.Transaction{})
+	require.NoError(t, err)
+
+	var decoded model.Transaction
+	require.NoError(t, codec.DecodeTransaction(data, &decoded))
+	assert.Equal(t, model.Transaction{}, decoded)
+}
+
+func TestBinaryCodecTimeZone(t *testing.T) {
+	timestamp := time.Date(2020, 10, 19, 1, 2, 3, 456789, time.FixedZone("", 10*3600))
+
+	codec := eventstorage.BinaryCodec{}
+	data, err := codec.EncodeSpan(&model.Span{Timestamp: timestamp})
+	require.NoError(t, err)
+
+	var decoded model.Span
+	require.NoError(t, codec.DecodeSpan(data, &decoded))
+	assert.True(t, timestamp.Equal(decoded.Timestamp))
+	_, offset := decoded.Timestamp.Zone()
+	assert.Equal(t, 10*3600, offset)
+}
+
+func TestBinaryCodecValues(t *testing.T) {
+	type custom struct {
+		A int `json:"a"`
+	}
+	labels := common.MapStr{
+		"string": "value",
+		"bool":   true,
+		"int":    int(-1),
+		"int64":  int64(1 << 40),
+		"float":  1.5,
+		"number": json.Number("123"),
+		"nil":    nil,
+		"slice":  []interface{}{"a", 1.0, nil},
+		"map":    map[string]interface{}{"a": "b"},
+		"mapstr": common.MapStr{"c": "d"},
+		"strs":   []string{"e", "f"},
+	}
+	codec := eventstorage.BinaryCodec{}
+	data, err := codec.EncodeTransaction(&model.Transaction{
+		Labels:       labels,
+		Experimental: custom{A: 1},
+	})
+	require.NoError(t, err)
+
+	var decoded model.Transaction
+	require.NoError(t, codec.DecodeTransaction(data, &decoded))
+	assert.Equal(t, labels, decoded.Labels)
+
+	// Other types are encoded as JSON, and decoded as with JSONCodec.
+	assert.Equal(t, map[string]interface{}{"a": 1.0}, decoded.Experimental)
+}
+
+func TestBinaryCodecDecodeJSON(t *testing.T) {
+	// BinaryCodec can decode events encoded by JSONCodec,
+	// so events stored before switching codecs are not lost.
+	tx := &model.Transaction{TraceID: "trace_id", ID: "transaction_id", Duration: 123}
+	data, err := eventstorage.JSONCodec{}.EncodeTransaction(tx)
+	require.NoError(t, err)
+
+	var decoded model.Transaction
+	require.NoError(t, eventstorage.BinaryCodec{}.DecodeTransaction(data, &decoded))
+	assert.Equal(t, tx.TraceID, decoded.TraceID)
+	assert.Equal(t, tx.ID, decoded.ID)
+	assert.Equal(t, tx.Duration, decoded.Duration)
+}
+
+func TestBinaryCodecDecodeInvalid(t *testing.T) {
+	var tx model.Transaction
+	populateStruct(reflect.ValueOf(&tx).Elem())
+
+	codec := eventstorage.BinaryCodec{}
+	data, err := codec.EncodeTransaction(&tx)
+	require.NoError(t, err)
+
+	// Truncated data must never cause a panic.
+	for i := 0; i < len(data); i++ {
+		var decoded model.Transaction
+		assert.Error(t, codec.DecodeTransaction(data[:i], &decoded), fmt.Sprintf("length %d", i))
+	}
+
+	data[0] = 0xff
+	var decoded model.Transaction
+	err = codec.DecodeTransaction(data, &decoded)
+	assert.EqualError(t, err, "unsupported binary codec version 255")
+}
+
+func BenchmarkBinaryCodec(b *testing.B) {
+	var tx model.Transaction
+	populateStruct(reflect.ValueOf(&tx).Elem())
+
+	bench := func(b *testing.B, codec eventstorage.Codec) {
+		b.Run("encode", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := codec.EncodeTransaction(&tx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+		b.Run("decode", func(b *testing.B) {
+			data, err := codec.EncodeTransaction(&tx)
+			if err != nil {
+				b.Fatal(err)
+			}
+			b.ReportMetric(float64(len(data)), "bytes/event")
+			b.ReportAllocs()
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				var decoded model.Transaction
+				if err := codec.DecodeTransaction(data, &decoded); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+	b.Run("json_codec", func(b *testing.B) {
+		bench(b, eventstorage.JSONCodec{})
+	})
+	b.Run("binary_codec", func(b *testing.B) {
+		bench(b, eventstorage.BinaryCodec{})
+	})
+}
+
+// populateStruct sets all exported fields of the struct v, recursively,
+// to arbitrary non-zero values.
+func populateStruct(v reflect.Value) {
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.CanSet() {
+			populateValue(f, v.Type().Field(i).Name)
+		}
+	}
+}
+
+func populateValue(v reflect.Value, name string) {
+	switch v.Type() {
+	case reflect.TypeOf(time.Time{}):
+		v.Set(reflect.ValueOf(time.Unix(1603069323, 123456789).UTC()))
+		return
+	case reflect.TypeOf(net.IP{}):
+		v.Set(reflect.ValueOf(net.IPv4(10, 1, 2, 3).To4()))
+		return
+	}
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(name)
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(-123)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(123)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.25)
+	case reflect.Interface:
+		v.Set(reflect.ValueOf(map[string]interface{}{
+			"string": name,
+			"slice":  []interface{}{1.5, false, nil},
+		}))
+	case reflect.Ptr:
+		v.Set(reflect.New(v.Type().Elem()))
+		populateValue(v.Elem(), name)
+	case reflect.Slice:
+		v.Set(reflect.MakeSlice(v.Type(), 2, 2))
+		for i := 0; i < v.Len(); i++ {
+			populateValue(v.Index(i), name)
+		}
+	case reflect.Map:
+		v.Set(reflect.MakeMap(v.Type()))
+		key := reflect.New(v.Type().Key()).Elem()
+		populateValue(key, name)
+		elem := reflect.New(v.Type().Elem()).Elem()
+		populateValue(elem, name)
+		v.SetMapIndex(key, elem)
+	case reflect.Struct:
+		populateStruct(v)
+	default:
+		panic(fmt.Errorf("unhandled type %s for %s", v.Type(), name))
+	}
+}
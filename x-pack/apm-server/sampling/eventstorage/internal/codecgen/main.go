@@ -0,0 +1,364 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// codecgen generates the binary encoding and decoding functions used by
+// eventstorage.BinaryCodec, by reflecting on the model types.
+//
+// Each named type reachable from the root types has a pair of generated
+// functions. Structs are encoded as the sequence of their exported fields,
+// in declaration order, without any field names or tags; any change to the
+// model types therefore requires the codec to be regenerated, and the codec
+// version to be incremented.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/elastic/apm-server/model"
+)
+
+const header = `// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Code generated by codecgen. DO NOT EDIT.
+
+`
+
+var (
+	outputFile  = flag.String("o", "binarycodec_generated.go", "output file")
+	packageName = flag.String("pkg", "eventstorage", "package name of the generated code")
+
+	timeType     = reflect.TypeOf(time.Time{})
+	modelPkgPath = reflect.TypeOf(model.Span{}).PkgPath()
+)
+
+func main() {
+	flag.Parse()
+	g := newGenerator()
+	g.add(reflect.TypeOf(model.Transaction{}))
+	g.add(reflect.TypeOf(model.Span{}))
+	code, err := g.generate(*packageName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*outputFile, code, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type generator struct {
+	buf     bytes.Buffer
+	imports map[string]string // package path -> package name
+	queue   []reflect.Type
+	seen    map[reflect.Type]bool
+}
+
+func newGenerator() *generator {
+	return &generator{
+		imports: make(map[string]string),
+		seen:    make(map[reflect.Type]bool),
+	}
+}
+
+// add adds t to the types for which functions will be generated.
+func (g *generator) add(t reflect.Type) {
+	if !g.seen[t] {
+		g.seen[t] = true
+		g.queue = append(g.queue, t)
+	}
+}
+
+func (g *generator) generate(pkg string) ([]byte, error) {
+	var body bytes.Buffer
+	for len(g.queue) > 0 {
+		t := g.queue[0]
+		g.queue = g.queue[1:]
+		g.buf.Reset()
+		g.genEncodeFunc(t)
+		g.genDecodeFunc(t)
+		body.Write(g.buf.Bytes())
+	}
+
+	var out bytes.Buffer
+	out.WriteString(header)
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+	if len(g.imports) > 0 {
+		paths := make([]string, 0, len(g.imports))
+		for path := range g.imports {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		out.WriteString("import (\n")
+		for _, std := range []bool{true, false} {
+			for _, path := range paths {
+				if isStdlib(path) == std {
+					fmt.Fprintf(&out, "\t%q\n", path)
+				}
+			}
+			if std {
+				out.WriteString("\n")
+			}
+		}
+		out.WriteString(")\n\n")
+	}
+	out.Write(body.Bytes())
+	return format.Source(out.Bytes())
+}
+
+func isStdlib(path string) bool {
+	return !strings.Contains(strings.SplitN(path, "/", 2)[0], ".")
+}
+
+func (g *generator) printf(format string, args ...interface{}) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// hasFuncs reports whether t is a named type with its own generated functions.
+// Named types with a basic underlying type are encoded inline, by conversion.
+func hasFuncs(t reflect.Type) bool {
+	if t.PkgPath() == "" || t == timeType {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Ptr:
+		return true
+	}
+	return false
+}
+
+// convert returns expr, of type t, converted to the type named by to.
+// If t is the unnamed type to, then expr is returned unmodified.
+func convert(t reflect.Type, to, expr string) string {
+	if t.PkgPath() == "" && t.String() == to {
+		return expr
+	}
+	return to + "(" + expr + ")"
+}
+
+// convertFrom returns expr, of the type named by from, converted to t.
+func (g *generator) convertFrom(t reflect.Type, from, expr string) string {
+	if t.PkgPath() == "" && t.String() == from {
+		return expr
+	}
+	return g.typeName(t) + "(" + expr + ")"
+}
+
+// funcSuffix returns the suffix of the generated function names for t.
+func funcSuffix(t reflect.Type) string {
+	pkg := t.String()[:strings.IndexRune(t.String(), '.')]
+	r := []rune(pkg)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r) + t.Name()
+}
+
+// typeName returns the Go syntax for t, recording any required imports.
+func (g *generator) typeName(t reflect.Type) string {
+	if t.PkgPath() != "" {
+		name := t.String()
+		g.imports[t.PkgPath()] = name[:strings.IndexRune(name, '.')]
+		return name
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return "*" + g.typeName(t.Elem())
+	case reflect.Slice:
+		return "[]" + g.typeName(t.Elem())
+	case reflect.Map:
+		return "map[" + g.typeName(t.Key()) + "]" + g.typeName(t.Elem())
+	case reflect.Interface:
+		if t.NumMethod() != 0 {
+			log.Fatalf("unsupported interface type %s", t)
+		}
+		return "interface{}"
+	case reflect.Struct:
+		log.Fatalf("unsupported anonymous struct type %s", t)
+	}
+	return t.String()
+}
+
+func (g *generator) genEncodeFunc(t reflect.Type) {
+	g.printf("func encode%s(e *encoder, v *%s) {\n", funcSuffix(t), g.typeName(t))
+	if t.Kind() == reflect.Struct {
+		g.encodeStructFields(t, "v", 0)
+	} else {
+		g.encodeValue(t, "(*v)", 0)
+	}
+	g.printf("}\n\n")
+}
+
+func (g *generator) genDecodeFunc(t reflect.Type) {
+	g.printf("func decode%s(d *decoder, v *%s) {\n", funcSuffix(t), g.typeName(t))
+	if t.Kind() == reflect.Struct {
+		g.decodeStructFields(t, "v", 0)
+	} else {
+		g.decodeValue(t, "(*v)", 0)
+	}
+	g.printf("}\n\n")
+}
+
+func (g *generator) encodeStructFields(t reflect.Type, expr string, depth int) {
+	if t.PkgPath() != "" && t.PkgPath() != modelPkgPath {
+		log.Fatalf("unsupported struct type %s", t)
+	}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			// Unexported fields are not encoded.
+			continue
+		}
+		g.encode(f.Type, expr+"."+f.Name, depth)
+	}
+}
+
+func (g *generator) decodeStructFields(t reflect.Type, expr string, depth int) {
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		g.decode(f.Type, expr+"."+f.Name, depth)
+	}
+}
+
+// encode emits statements encoding the addressable expression expr of type t.
+func (g *generator) encode(t reflect.Type, expr string, depth int) {
+	if hasFuncs(t) {
+		g.add(t)
+		g.printf("encode%s(e, &%s)\n", funcSuffix(t), expr)
+		return
+	}
+	g.encodeValue(t, expr, depth)
+}
+
+func (g *generator) encodeValue(t reflect.Type, expr string, depth int) {
+	switch t.Kind() {
+	case reflect.String:
+		g.printf("e.string(%s)\n", convert(t, "string", expr))
+	case reflect.Bool:
+		g.printf("e.bool(%s)\n", convert(t, "bool", expr))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		g.printf("e.varint(%s)\n", convert(t, "int64", expr))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		g.printf("e.uvarint(%s)\n", convert(t, "uint64", expr))
+	case reflect.Float32:
+		g.printf("e.float32(%s)\n", convert(t, "float32", expr))
+	case reflect.Float64:
+		g.printf("e.float64(%s)\n", convert(t, "float64", expr))
+	case reflect.Interface:
+		g.printf("e.value(%s)\n", expr)
+	case reflect.Struct:
+		if t == timeType {
+			g.printf("e.time(%s)\n", expr)
+			return
+		}
+		g.encodeStructFields(t, expr, depth)
+	case reflect.Ptr:
+		g.printf("if %s == nil {\ne.bool(false)\n} else {\ne.bool(true)\n", expr)
+		if elem := t.Elem(); hasFuncs(elem) {
+			g.add(elem)
+			g.printf("encode%s(e, %s)\n", funcSuffix(elem), expr)
+		} else {
+			g.encode(elem, "(*"+expr+")", depth)
+		}
+		g.printf("}\n")
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			g.printf("e.bytes(%s)\n", convert(t, "[]byte", expr))
+			return
+		}
+		g.printf("if %s == nil {\ne.uvarint(0)\n} else {\n", expr)
+		g.printf("e.uvarint(uint64(len(%s)) + 1)\n", expr)
+		g.printf("for i%d := range %s {\n", depth, expr)
+		g.encode(t.Elem(), fmt.Sprintf("%s[i%d]", expr, depth), depth+1)
+		g.printf("}\n}\n")
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			log.Fatalf("unsupported map key type %s", t.Key())
+		}
+		g.printf("if %s == nil {\ne.uvarint(0)\n} else {\n", expr)
+		g.printf("e.uvarint(uint64(len(%s)) + 1)\n", expr)
+		g.printf("for k%d, v%d := range %s {\n", depth, depth, expr)
+		g.printf("e.string(%s)\n", convert(t.Key(), "string", fmt.Sprintf("k%d", depth)))
+		g.encode(t.Elem(), fmt.Sprintf("v%d", depth), depth+1)
+		g.printf("}\n}\n")
+	default:
+		log.Fatalf("unsupported type %s", t)
+	}
+}
+
+// decode emits statements decoding into the addressable expression expr of type t.
+func (g *generator) decode(t reflect.Type, expr string, depth int) {
+	if hasFuncs(t) {
+		g.add(t)
+		g.printf("decode%s(d, &%s)\n", funcSuffix(t), expr)
+		return
+	}
+	g.decodeValue(t, expr, depth)
+}
+
+func (g *generator) decodeValue(t reflect.Type, expr string, depth int) {
+	switch t.Kind() {
+	case reflect.String:
+		g.printf("%s = %s\n", expr, g.convertFrom(t, "string", "d.string()"))
+	case reflect.Bool:
+		g.printf("%s = %s\n", expr, g.convertFrom(t, "bool", "d.bool()"))
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		g.printf("%s = %s\n", expr, g.convertFrom(t, "int64", "d.varint()"))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		g.printf("%s = %s\n", expr, g.convertFrom(t, "uint64", "d.uvarint()"))
+	case reflect.Float32:
+		g.printf("%s = %s\n", expr, g.convertFrom(t, "float32", "d.float32()"))
+	case reflect.Float64:
+		g.printf("%s = %s\n", expr, g.convertFrom(t, "float64", "d.float64()"))
+	case reflect.Interface:
+		g.printf("%s = d.value()\n", expr)
+	case reflect.Struct:
+		if t == timeType {
+			g.printf("%s = d.time()\n", expr)
+			return
+		}
+		g.decodeStructFields(t, expr, depth)
+	case reflect.Ptr:
+		g.printf("if d.bool() {\n%s = new(%s)\n", expr, g.typeName(t.Elem()))
+		if elem := t.Elem(); hasFuncs(elem) {
+			g.add(elem)
+			g.printf("decode%s(d, %s)\n", funcSuffix(elem), expr)
+		} else {
+			g.decode(elem, "(*"+expr+")", depth)
+		}
+		g.printf("} else {\n%s = nil\n}\n", expr)
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			g.printf("%s = %s\n", expr, g.convertFrom(t, "[]byte", "d.bytes()"))
+			return
+		}
+		g.printf("if n%d := d.length(); n%d == 0 {\n%s = nil\n} else {\n", depth, depth, expr)
+		g.printf("%s = make(%s, n%d-1)\n", expr, g.typeName(t), depth)
+		g.printf("for i%d := range %s {\n", depth, expr)
+		g.decode(t.Elem(), fmt.Sprintf("%s[i%d]", expr, depth), depth+1)
+		g.printf("}\n}\n")
+	case reflect.Map:
+		g.printf("if n%d := d.length(); n%d == 0 {\n%s = nil\n} else {\n", depth, depth, expr)
+		g.printf("%s = make(%s, n%d-1)\n", expr, g.typeName(t), depth)
+		g.printf("for i%d := 1; i%d < n%d; i%d++ {\n", depth, depth, depth, depth)
+		g.printf("k%d := %s\n", depth, g.convertFrom(t.Key(), "string", "d.string()"))
+		g.printf("var v%d %s\n", depth, g.typeName(t.Elem()))
+		g.decode(t.Elem(), fmt.Sprintf("v%d", depth), depth+1)
+		g.printf("%s[k%d] = v%d\n", expr, depth, depth)
+		g.printf("}\n}\n")
+	default:
+		log.Fatalf("unsupported type %s", t)
+	}
+}
@@ -61,6 +61,11 @@ func (s *ShardedReadWriter) WriteTransaction(tx *model.Transaction) error {
 	return s.getWriter(tx.TraceID).WriteTransaction(tx)
 }
 
+// ReadTransaction calls Writer.ReadTransaction, using a sharded, locked, Writer.
+func (s *ShardedReadWriter) ReadTransaction(traceID, transactionID string, out *model.Transaction) error {
+	return s.getWriter(traceID).ReadTransaction(traceID, transactionID, out)
+}
+
 // WriteSpan calls Writer.WriteSpan, using a sharded, locked, Writer.
 func (s *ShardedReadWriter) WriteSpan(span *model.Span) error {
 	return s.getWriter(span.TraceID).WriteSpan(span)
@@ -76,6 +81,46 @@ func (s *ShardedReadWriter) IsTraceSampled(traceID string) (bool, error) {
 	return s.getWriter(traceID).IsTraceSampled(traceID)
 }
 
+// WriteTraceDecision calls Writer.WriteTraceDecision, using a sharded, locked, Writer.
+func (s *ShardedReadWriter) WriteTraceDecision(traceID string, decision TraceDecision) error {
+	return s.getWriter(traceID).WriteTraceDecision(traceID, decision)
+}
+
+// ReadTraceDecision calls Writer.ReadTraceDecision, using a sharded, locked, Writer.
+func (s *ShardedReadWriter) ReadTraceDecision(traceID string) (TraceDecision, error) {
+	return s.getWriter(traceID).ReadTraceDecision(traceID)
+}
+
+// WriteTraceMatch calls Writer.WriteTraceMatch, using a sharded, locked, Writer.
+func (s *ShardedReadWriter) WriteTraceMatch(traceID string, match TraceMatch) error {
+	return s.getWriter(traceID).WriteTraceMatch(traceID, match)
+}
+
+// ReadTraceMatch calls Writer.ReadTraceMatch, using a sharded, locked, Writer.
+func (s *ShardedReadWriter) ReadTraceMatch(traceID string) (TraceMatch, error) {
+	return s.getWriter(traceID).ReadTraceMatch(traceID)
+}
+
+// WriteTraceRoot calls Writer.WriteTraceRoot, using a sharded, locked, Writer.
+func (s *ShardedReadWriter) WriteTraceRoot(traceID string) error {
+	return s.getWriter(traceID).WriteTraceRoot(traceID)
+}
+
+// HasTraceRoot calls Writer.HasTraceRoot, using a sharded, locked, Writer.
+func (s *ShardedReadWriter) HasTraceRoot(traceID string) (bool, error) {
+	return s.getWriter(traceID).HasTraceRoot(traceID)
+}
+
+// WriteTraceError calls Writer.WriteTraceError, using a sharded, locked, Writer.
+func (s *ShardedReadWriter) WriteTraceError(traceID string) error {
+	return s.getWriter(traceID).WriteTraceError(traceID)
+}
+
+// HasTraceError calls Writer.HasTraceError, using a sharded, locked, Writer.
+func (s *ShardedReadWriter) HasTraceError(traceID string) (bool, error) {
+	return s.getWriter(traceID).HasTraceError(traceID)
+}
+
 // DeleteTransaction calls Writer.DeleteTransaction, using a sharded, locked, Writer.
 func (s *ShardedReadWriter) DeleteTransaction(tx *model.Transaction) error {
 	return s.getWriter(tx.TraceID).DeleteTransaction(tx)
@@ -144,6 +189,60 @@ func (rw *lockedReadWriter) IsTraceSampled(traceID string) (bool, error) {
 	return rw.rw.IsTraceSampled(traceID)
 }
 
+func (rw *lockedReadWriter) WriteTraceDecision(traceID string, decision TraceDecision) error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.rw.WriteTraceDecision(traceID, decision)
+}
+
+func (rw *lockedReadWriter) ReadTraceDecision(traceID string) (TraceDecision, error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.rw.ReadTraceDecision(traceID)
+}
+
+func (rw *lockedReadWriter) WriteTraceMatch(traceID string, match TraceMatch) error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.rw.WriteTraceMatch(traceID, match)
+}
+
+func (rw *lockedReadWriter) ReadTraceMatch(traceID string) (TraceMatch, error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.rw.ReadTraceMatch(traceID)
+}
+
+func (rw *lockedReadWriter) ReadTransaction(traceID, transactionID string, out *model.Transaction) error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.rw.ReadTransaction(traceID, transactionID, out)
+}
+
+func (rw *lockedReadWriter) WriteTraceRoot(traceID string) error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.rw.WriteTraceRoot(traceID)
+}
+
+func (rw *lockedReadWriter) HasTraceRoot(traceID string) (bool, error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.rw.HasTraceRoot(traceID)
+}
+
+func (rw *lockedReadWriter) WriteTraceError(traceID string) error {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.rw.WriteTraceError(traceID)
+}
+
+func (rw *lockedReadWriter) HasTraceError(traceID string) (bool, error) {
+	rw.mu.Lock()
+	defer rw.mu.Unlock()
+	return rw.rw.HasTraceError(traceID)
+}
+
 func (rw *lockedReadWriter) DeleteTransaction(tx *model.Transaction) error {
 	rw.mu.Lock()
 	defer rw.mu.Unlock()
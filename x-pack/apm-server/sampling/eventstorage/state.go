@@ -0,0 +1,114 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package eventstorage
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/dgraph-io/badger/v2"
+)
+
+const (
+	// NOTE(axw) this value must remain stable over time, like the
+	// other entry meta values, to avoid misinterpreting historical data.
+	entryMetaSamplingState = 'G'
+
+	// samplingStateKey is the key under which the sampling state is stored.
+	// The key cannot be confused with trace-related keys, which start with
+	// a trace ID.
+	samplingStateKey = "!sampling_state"
+)
+
+// SamplingState records the in-memory state of the tail-sampling processor,
+// so that it may be restored when the processor is restarted.
+type SamplingState struct {
+	// Timestamp holds the time at which the state was recorded.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Groups holds the state of each trace group.
+	Groups []TraceGroupState `json:"groups,omitempty"`
+
+	// PendingTraces holds traces awaiting a sampling decision,
+	// when sampling decisions are delayed until traces are idle.
+	PendingTraces []PendingTraceState `json:"pending_traces,omitempty"`
+}
+
+// TraceGroupState records the state of a trace group.
+type TraceGroupState struct {
+	// ServiceName holds the name of the service for which the group was created.
+	ServiceName string `json:"service_name"`
+
+	// Policy holds the index of the sampling policy from which the group was created.
+	Policy int `json:"policy"`
+
+	// Dynamic records whether the group was created for a service
+	// matched by a policy without a service name.
+	Dynamic bool `json:"dynamic,omitempty"`
+
+	// SampleRate and MaxTraces hold the sampling configuration of the group,
+	// so that state is not restored into a group whose policy has changed.
+	SampleRate float64 `json:"sample_rate"`
+	MaxTraces  int     `json:"max_traces,omitempty"`
+
+	IngestRate    float64 `json:"ingest_rate"`
+	ReservoirSize int     `json:"reservoir_size"`
+	Total         int     `json:"total"`
+	LastTotal     int     `json:"last_total"`
+	LastSampled   int     `json:"last_sampled"`
+
+	// ReservoirKeys and ReservoirTraceIDs hold the random keys and
+	// trace IDs of the root transactions in the sampling reservoir.
+	ReservoirKeys     []float64 `json:"reservoir_keys,omitempty"`
+	ReservoirTraceIDs []string  `json:"reservoir_trace_ids,omitempty"`
+
+	// Sampled holds the trace IDs of all root transactions observed
+	// in the current interval, for groups with a sample rate of 1.
+	Sampled []string `json:"sampled,omitempty"`
+}
+
+// PendingTraceState records the state of a trace awaiting a sampling decision.
+type PendingTraceState struct {
+	TraceID   string    `json:"trace_id"`
+	LastSeen  time.Time `json:"last_seen"`
+	RootID    string    `json:"root_id,omitempty"`
+	HasError  bool      `json:"has_error,omitempty"`
+	SpanCount int       `json:"span_count,omitempty"`
+}
+
+// WriteSamplingState records the sampling state, replacing any previously
+// recorded state. The write is committed before WriteSamplingState returns.
+//
+// The state expires after the storage TTL, like the events it refers to.
+func (s *Storage) WriteSamplingState(state SamplingState) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+	return s.db.Update(func(txn *badger.Txn) error {
+		entry := badger.NewEntry([]byte(samplingStateKey), data).WithMeta(entryMetaSamplingState)
+		return txn.SetEntry(entry.WithTTL(s.ttl))
+	})
+}
+
+// ReadSamplingState returns the most recently recorded sampling state.
+// If no state has been recorded, or it has expired, ReadSamplingState
+// returns ErrNotFound.
+func (s *Storage) ReadSamplingState() (SamplingState, error) {
+	var state SamplingState
+	err := s.db.View(func(txn *badger.Txn) error {
+		item, err := txn.Get([]byte(samplingStateKey))
+		if err != nil {
+			if err == badger.ErrKeyNotFound {
+				return ErrNotFound
+			}
+			return err
+		}
+		return item.Value(func(data []byte) error {
+			return json.Unmarshal(data, &state)
+		})
+	})
+	return state, err
+}
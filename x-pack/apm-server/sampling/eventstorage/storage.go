@@ -5,7 +5,10 @@
 package eventstorage
 
 import (
+	"encoding/binary"
+	"encoding/json"
 	"errors"
+	"math"
 	"time"
 
 	"github.com/dgraph-io/badger/v2"
@@ -20,8 +23,78 @@ const (
 	entryMetaTraceUnsampled = 'u'
 	entryMetaTransaction    = 'T'
 	entryMetaSpan           = 'S'
+	entryMetaTraceError     = 'E'
+	entryMetaTraceMatch     = 'M'
+	entryMetaTraceRoot      = 'R'
+
+	// traceErrorKeySuffix is appended to a trace ID to form the key
+	// recording that the trace contains errors. The suffix is chosen
+	// so that the key does not share the trace's event key prefix.
+	traceErrorKeySuffix = "!error"
+
+	// traceMatchKeySuffix is appended to a trace ID to form the key
+	// recording the sampling policy matched by the trace.
+	traceMatchKeySuffix = "!match"
+
+	// traceRootKeySuffix is appended to a trace ID to form the key
+	// recording that a root of the trace has been admitted to a
+	// sampling reservoir.
+	traceRootKeySuffix = "!root"
+)
+
+// DecisionSource identifies where a sampling decision was made.
+type DecisionSource uint8
+
+const (
+	// DecisionSourceUnknown identifies decisions recorded without a source.
+	DecisionSourceUnknown DecisionSource = iota
+
+	// DecisionSourceLocal identifies decisions made by this server.
+	DecisionSourceLocal
+
+	// DecisionSourceRemote identifies decisions made by another server,
+	// and received by subscribing to sampled trace IDs.
+	DecisionSourceRemote
 )
 
+// String returns "local", "remote", or "unknown".
+func (s DecisionSource) String() string {
+	switch s {
+	case DecisionSourceLocal:
+		return "local"
+	case DecisionSourceRemote:
+		return "remote"
+	}
+	return "unknown"
+}
+
+// TraceDecision records a tail-sampling decision for a trace.
+type TraceDecision struct {
+	// Sampled records whether the trace is sampled.
+	Sampled bool
+
+	// Source records where the decision was made.
+	Source DecisionSource
+
+	// SampleRate records the effective rate at which the trace was
+	// sampled, in the range (0,1]. If SampleRate is zero, the rate
+	// is unknown.
+	SampleRate float64
+}
+
+// TraceMatch records the sampling policy matched by a trace's root transaction.
+type TraceMatch struct {
+	// ServiceName holds the name of the root transaction's service.
+	ServiceName string `json:"service_name"`
+
+	// Policy holds the index of the matched sampling policy.
+	Policy int `json:"policy"`
+
+	// Admitted records whether the root transaction was
+	// admitted to the policy's sampling reservoir.
+	Admitted bool `json:"admitted"`
+}
+
 // ErrNotFound is returned by by the Storage.IsTraceSampled method,
 // for non-existing trace IDs.
 var ErrNotFound = errors.New("key not found")
@@ -109,15 +182,58 @@ func (rw *ReadWriter) Flush() error {
 
 // WriteTraceSampled records the tail-sampling decision for the given trace ID.
 func (rw *ReadWriter) WriteTraceSampled(traceID string, sampled bool) error {
+	return rw.WriteTraceDecision(traceID, TraceDecision{Sampled: sampled})
+}
+
+// WriteTraceDecision records the tail-sampling decision for the given trace ID,
+// along with the source of the decision and the effective sample rate.
+func (rw *ReadWriter) WriteTraceDecision(traceID string, decision TraceDecision) error {
 	key := []byte(traceID)
 	var meta uint8 = entryMetaTraceUnsampled
-	if sampled {
+	if decision.Sampled {
 		meta = entryMetaTraceSampled
 	}
-	entry := badger.NewEntry(key[:], nil).WithMeta(meta)
+	// The value holds the decision source, optionally followed by the
+	// sample rate as a little-endian IEEE 754 binary64 value.
+	var value []byte
+	if decision.Source != DecisionSourceUnknown || decision.SampleRate != 0 {
+		value = []byte{byte(decision.Source)}
+	}
+	if decision.SampleRate != 0 {
+		var buf [8]byte
+		binary.LittleEndian.PutUint64(buf[:], math.Float64bits(decision.SampleRate))
+		value = append(value, buf[:]...)
+	}
+	entry := badger.NewEntry(key[:], value).WithMeta(meta)
 	return rw.writeEntry(entry.WithTTL(rw.s.ttl))
 }
 
+// ReadTraceDecision returns the tail-sampling decision for the given trace ID.
+// If no sampling decision has been recorded, ReadTraceDecision returns ErrNotFound.
+func (rw *ReadWriter) ReadTraceDecision(traceID string) (TraceDecision, error) {
+	rw.readKeyBuf = append(rw.readKeyBuf[:0], traceID...)
+	item, err := rw.txn.Get(rw.readKeyBuf)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return TraceDecision{}, ErrNotFound
+		}
+		return TraceDecision{}, err
+	}
+	decision := TraceDecision{Sampled: item.UserMeta() == entryMetaTraceSampled}
+	if err := item.Value(func(data []byte) error {
+		if len(data) > 0 {
+			decision.Source = DecisionSource(data[0])
+		}
+		if len(data) >= 9 {
+			decision.SampleRate = math.Float64frombits(binary.LittleEndian.Uint64(data[1:9]))
+		}
+		return nil
+	}); err != nil {
+		return TraceDecision{}, err
+	}
+	return decision, nil
+}
+
 // IsTraceSampled reports whether traceID belongs to a trace that is sampled
 // or unsampled. If no sampling decision has been recorded, IsTraceSampled
 // returns ErrNotFound.
@@ -133,6 +249,81 @@ func (rw *ReadWriter) IsTraceSampled(traceID string) (bool, error) {
 	return item.UserMeta() == entryMetaTraceSampled, nil
 }
 
+// WriteTraceError records that the trace with the given trace ID contains errors.
+func (rw *ReadWriter) WriteTraceError(traceID string) error {
+	key := append([]byte(traceID), traceErrorKeySuffix...)
+	entry := badger.NewEntry(key, nil).WithMeta(entryMetaTraceError)
+	return rw.writeEntry(entry.WithTTL(rw.s.ttl))
+}
+
+// HasTraceError reports whether WriteTraceError has been called
+// for the trace with the given trace ID.
+func (rw *ReadWriter) HasTraceError(traceID string) (bool, error) {
+	rw.readKeyBuf = append(append(rw.readKeyBuf[:0], traceID...), traceErrorKeySuffix...)
+	_, err := rw.txn.Get(rw.readKeyBuf)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// WriteTraceRoot records that a root or local root transaction of the trace
+// with the given trace ID has been admitted to a sampling reservoir, so other
+// events of the trace are subject to its sampling decision.
+func (rw *ReadWriter) WriteTraceRoot(traceID string) error {
+	key := append([]byte(traceID), traceRootKeySuffix...)
+	entry := badger.NewEntry(key, nil).WithMeta(entryMetaTraceRoot)
+	return rw.writeEntry(entry.WithTTL(rw.s.ttl))
+}
+
+// HasTraceRoot reports whether WriteTraceRoot has been called
+// for the trace with the given trace ID.
+func (rw *ReadWriter) HasTraceRoot(traceID string) (bool, error) {
+	rw.readKeyBuf = append(append(rw.readKeyBuf[:0], traceID...), traceRootKeySuffix...)
+	_, err := rw.txn.Get(rw.readKeyBuf)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// WriteTraceMatch records the sampling policy matched by the root transaction
+// of the trace with the given trace ID.
+func (rw *ReadWriter) WriteTraceMatch(traceID string, match TraceMatch) error {
+	data, err := json.Marshal(match)
+	if err != nil {
+		return err
+	}
+	key := append([]byte(traceID), traceMatchKeySuffix...)
+	entry := badger.NewEntry(key, data).WithMeta(entryMetaTraceMatch)
+	return rw.writeEntry(entry.WithTTL(rw.s.ttl))
+}
+
+// ReadTraceMatch returns the sampling policy matched by the root transaction
+// of the trace with the given trace ID. If no match has been recorded,
+// ReadTraceMatch returns ErrNotFound.
+func (rw *ReadWriter) ReadTraceMatch(traceID string) (TraceMatch, error) {
+	var match TraceMatch
+	rw.readKeyBuf = append(append(rw.readKeyBuf[:0], traceID...), traceMatchKeySuffix...)
+	item, err := rw.txn.Get(rw.readKeyBuf)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return match, ErrNotFound
+		}
+		return match, err
+	}
+	err = item.Value(func(data []byte) error {
+		return json.Unmarshal(data, &match)
+	})
+	return match, err
+}
+
 // WriteTransaction writes tx to storage.
 //
 // WriteTransaction may return before the write is committed to storage.
@@ -146,6 +337,26 @@ func (rw *ReadWriter) WriteTransaction(tx *model.Transaction) error {
 	return rw.writeEvent(key[:], data, entryMetaTransaction)
 }
 
+// ReadTransaction reads the transaction with the given trace and transaction
+// IDs from storage into out. If the transaction is not found, ReadTransaction
+// returns ErrNotFound.
+func (rw *ReadWriter) ReadTransaction(traceID, transactionID string, out *model.Transaction) error {
+	rw.readKeyBuf = append(append(append(rw.readKeyBuf[:0], traceID...), ':'), transactionID...)
+	item, err := rw.txn.Get(rw.readKeyBuf)
+	if err != nil {
+		if err == badger.ErrKeyNotFound {
+			return ErrNotFound
+		}
+		return err
+	}
+	if item.UserMeta() != entryMetaTransaction {
+		return ErrNotFound
+	}
+	return item.Value(func(data []byte) error {
+		return rw.s.codec.DecodeTransaction(data, out)
+	})
+}
+
 // WriteSpan writes span to storage.
 //
 // WriteSpan may return before the write is committed to storage.
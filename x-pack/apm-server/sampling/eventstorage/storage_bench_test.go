@@ -43,6 +43,9 @@ func BenchmarkWriteTransaction(b *testing.B) {
 	b.Run("json_codec", func(b *testing.B) {
 		test(b, eventstorage.JSONCodec{})
 	})
+	b.Run("binary_codec", func(b *testing.B) {
+		test(b, eventstorage.BinaryCodec{})
+	})
 	b.Run("nop_codec", func(b *testing.B) {
 		// This tests the eventstorage performance without
 		// JSON encoding. This would be the theoretical
@@ -102,6 +105,9 @@ func BenchmarkReadEvents(b *testing.B) {
 	b.Run("json_codec", func(b *testing.B) {
 		test(b, eventstorage.JSONCodec{})
 	})
+	b.Run("binary_codec", func(b *testing.B) {
+		test(b, eventstorage.BinaryCodec{})
+	})
 	b.Run("nop_codec", func(b *testing.B) {
 		// This tests the eventstorage performance without
 		// JSON decoding. This would be the theoretical
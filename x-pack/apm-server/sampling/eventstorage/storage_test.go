@@ -207,6 +207,25 @@ func TestReadEvents(t *testing.T) {
 	assert.Equal(t, []*model.Span{{Name: "span"}}, events.Spans)
 }
 
+func TestReadTransaction(t *testing.T) {
+	db := newBadgerDB(t, badgerOptions)
+	store := eventstorage.New(db, eventstorage.JSONCodec{}, time.Minute)
+	readWriter := store.NewShardedReadWriter()
+	defer readWriter.Close()
+
+	transaction := &model.Transaction{TraceID: "trace_id", ID: "transaction_id", Name: "transaction"}
+	assert.NoError(t, readWriter.WriteTransaction(transaction))
+	assert.NoError(t, readWriter.WriteSpan(&model.Span{TraceID: "trace_id", ID: "span_id"}))
+
+	var out model.Transaction
+	assert.NoError(t, readWriter.ReadTransaction("trace_id", "transaction_id", &out))
+	assert.Equal(t, *transaction, out)
+
+	// Spans are not returned as transactions.
+	assert.Equal(t, eventstorage.ErrNotFound, readWriter.ReadTransaction("trace_id", "span_id", &out))
+	assert.Equal(t, eventstorage.ErrNotFound, readWriter.ReadTransaction("trace_id", "unknown_id", &out))
+}
+
 func TestReadEventsDecodeError(t *testing.T) {
 	db := newBadgerDB(t, badgerOptions)
 	ttl := time.Minute
@@ -260,6 +279,133 @@ func TestIsTraceSampled(t *testing.T) {
 	assert.Equal(t, err, eventstorage.ErrNotFound)
 }
 
+func TestTraceDecision(t *testing.T) {
+	db := newBadgerDB(t, badgerOptions)
+	store := eventstorage.New(db, eventstorage.JSONCodec{}, time.Minute)
+	readWriter := store.NewShardedReadWriter()
+	defer readWriter.Close()
+
+	decisions := map[string]eventstorage.TraceDecision{
+		"local_trace_id":  {Sampled: true, Source: eventstorage.DecisionSourceLocal},
+		"remote_trace_id": {Sampled: true, Source: eventstorage.DecisionSourceRemote},
+		"sample_rate_trace_id": {
+			Sampled:    true,
+			Source:     eventstorage.DecisionSourceLocal,
+			SampleRate: 0.125,
+		},
+		"unknown_source_sample_rate_trace_id": {Sampled: true, SampleRate: 0.5},
+	}
+	for traceID, decision := range decisions {
+		assert.NoError(t, readWriter.WriteTraceDecision(traceID, decision))
+	}
+	assert.NoError(t, readWriter.WriteTraceSampled("unknown_source_trace_id", false))
+	decisions["unknown_source_trace_id"] = eventstorage.TraceDecision{}
+
+	for traceID, expected := range decisions {
+		decision, err := readWriter.ReadTraceDecision(traceID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, decision, traceID)
+
+		// IsTraceSampled is unaffected by the decision source.
+		sampled, err := readWriter.IsTraceSampled(traceID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Sampled, sampled, traceID)
+	}
+
+	_, err := readWriter.ReadTraceDecision("unknown_trace_id")
+	assert.Equal(t, eventstorage.ErrNotFound, err)
+}
+
+func TestTraceMatch(t *testing.T) {
+	db := newBadgerDB(t, badgerOptions)
+	store := eventstorage.New(db, eventstorage.JSONCodec{}, time.Minute)
+	readWriter := store.NewShardedReadWriter()
+	defer readWriter.Close()
+
+	match := eventstorage.TraceMatch{ServiceName: "service", Policy: 2, Admitted: true}
+	assert.NoError(t, readWriter.WriteTraceMatch("trace_id", match))
+	assert.NoError(t, readWriter.WriteTransaction(&model.Transaction{TraceID: "trace_id", ID: "transaction_id"}))
+
+	actual, err := readWriter.ReadTraceMatch("trace_id")
+	assert.NoError(t, err)
+	assert.Equal(t, match, actual)
+
+	// The match is not returned as one of the trace's events,
+	// and does not count as a sampling decision.
+	var batch model.Batch
+	assert.NoError(t, readWriter.ReadEvents("trace_id", &batch))
+	assert.Len(t, batch.Transactions, 1)
+	_, err = readWriter.IsTraceSampled("trace_id")
+	assert.Equal(t, eventstorage.ErrNotFound, err)
+
+	_, err = readWriter.ReadTraceMatch("unknown_trace_id")
+	assert.Equal(t, eventstorage.ErrNotFound, err)
+}
+
+func TestTraceRoot(t *testing.T) {
+	db := newBadgerDB(t, badgerOptions)
+	store := eventstorage.New(db, eventstorage.JSONCodec{}, time.Minute)
+	readWriter := store.NewShardedReadWriter()
+	defer readWriter.Close()
+
+	assert.NoError(t, readWriter.WriteTraceRoot("trace_id"))
+	assert.NoError(t, readWriter.WriteTransaction(&model.Transaction{TraceID: "trace_id", ID: "transaction_id"}))
+
+	hasRoot, err := readWriter.HasTraceRoot("trace_id")
+	assert.NoError(t, err)
+	assert.True(t, hasRoot)
+
+	// The root marker is not returned as one of the trace's
+	// events, and does not count as a sampling decision.
+	var batch model.Batch
+	assert.NoError(t, readWriter.ReadEvents("trace_id", &batch))
+	assert.Len(t, batch.Transactions, 1)
+	_, err = readWriter.IsTraceSampled("trace_id")
+	assert.Equal(t, eventstorage.ErrNotFound, err)
+
+	hasRoot, err = readWriter.HasTraceRoot("unknown_trace_id")
+	assert.NoError(t, err)
+	assert.False(t, hasRoot)
+}
+
+func TestSamplingState(t *testing.T) {
+	db := newBadgerDB(t, badgerOptions)
+	store := eventstorage.New(db, eventstorage.JSONCodec{}, time.Minute)
+
+	_, err := store.ReadSamplingState()
+	assert.Equal(t, eventstorage.ErrNotFound, err)
+
+	state := eventstorage.SamplingState{
+		Timestamp: time.Unix(1603069323, 0).UTC(),
+		Groups: []eventstorage.TraceGroupState{{
+			ServiceName:       "service",
+			Policy:            1,
+			SampleRate:        0.5,
+			IngestRate:        123.5,
+			ReservoirSize:     1000,
+			Total:             2,
+			ReservoirKeys:     []float64{0.25, 0.75},
+			ReservoirTraceIDs: []string{"trace_1", "trace_2"},
+		}},
+		PendingTraces: []eventstorage.PendingTraceState{{
+			TraceID:   "trace_3",
+			LastSeen:  time.Unix(1603069320, 0).UTC(),
+			RootID:    "transaction_3",
+			SpanCount: 3,
+		}},
+	}
+	assert.NoError(t, store.WriteSamplingState(state))
+	actual, err := store.ReadSamplingState()
+	assert.NoError(t, err)
+	assert.Equal(t, state, actual)
+
+	// The state is replaced by subsequent writes.
+	assert.NoError(t, store.WriteSamplingState(eventstorage.SamplingState{}))
+	actual, err = store.ReadSamplingState()
+	assert.NoError(t, err)
+	assert.Zero(t, actual)
+}
+
 func badgerOptions() badger.Options {
 	return badger.DefaultOptions("").WithInMemory(true).WithLogger(nil)
 }
@@ -0,0 +1,139 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sampling
+
+import (
+	"github.com/elastic/apm-server/x-pack/apm-server/sampling/eventstorage"
+)
+
+// TraceExplanation describes the local tail-sampling state of a trace,
+// for diagnosing why a trace was or was not sampled.
+type TraceExplanation struct {
+	TraceID string `json:"trace_id"`
+
+	// Decision holds the sampling decision recorded in local storage,
+	// or nil if no decision has been recorded.
+	Decision *DecisionExplanation `json:"decision,omitempty"`
+
+	// Policy holds the policy matched by the trace's root transaction,
+	// or nil if the root transaction has not been matched to a policy.
+	// Policy matches are recorded only if Config.ExplainPolicies is true.
+	Policy *PolicyExplanation `json:"policy,omitempty"`
+
+	// Pending records whether the trace is awaiting a delayed sampling
+	// decision, i.e. the trace has not yet been idle for the decision delay.
+	Pending bool `json:"pending"`
+}
+
+// DecisionExplanation describes a recorded sampling decision.
+type DecisionExplanation struct {
+	Sampled bool `json:"sampled"`
+
+	// Source holds the source of the decision: "local" if the decision
+	// was made by this server, "remote" if the decision was received from
+	// another server, or "unknown".
+	Source string `json:"source"`
+
+	// SampleRate holds the effective rate at which the trace was sampled,
+	// if known. Sampled events are stamped with this rate.
+	SampleRate float64 `json:"sample_rate,omitempty"`
+}
+
+// PolicyExplanation describes the policy matched by a trace's root transaction.
+type PolicyExplanation struct {
+	// Index holds the index of the policy in the configured policies.
+	Index int `json:"index"`
+
+	// ServiceName holds the name of the root transaction's service,
+	// which together with the policy identifies the trace group.
+	ServiceName string `json:"service_name"`
+
+	SampleRate         float64 `json:"sample_rate"`
+	MaxTracesPerSecond float64 `json:"max_traces_per_second,omitempty"`
+
+	// AdmittedToReservoir records whether the root transaction was admitted
+	// to the trace group's sampling reservoir. Traces admitted to the reservoir
+	// may still be unsampled, if they are evicted before the reservoir is flushed.
+	AdmittedToReservoir bool `json:"admitted_to_reservoir"`
+
+	// Group holds the current state of the trace group, or nil if the
+	// trace group no longer exists.
+	Group *GroupExplanation `json:"group,omitempty"`
+}
+
+// GroupExplanation describes the current state of a trace group.
+type GroupExplanation struct {
+	// Dynamic records whether the group was created for a service
+	// matched by a policy without a service name.
+	Dynamic bool `json:"dynamic"`
+
+	// ReservoirSize holds the current capacity of the group's sampling reservoir.
+	ReservoirSize int `json:"reservoir_size"`
+
+	// IngestRate holds the exponentially weighted moving average number of root
+	// transactions observed for the group per flush interval.
+	IngestRate float64 `json:"ingest_rate"`
+}
+
+// ExplainTrace returns a TraceExplanation describing the local tail-sampling
+// state of the trace with the given trace ID.
+func (p *Processor) ExplainTrace(traceID string) (*TraceExplanation, error) {
+	p.storageMu.RLock()
+	defer p.storageMu.RUnlock()
+	if p.storage == nil {
+		return nil, ErrStopped
+	}
+
+	explanation := TraceExplanation{TraceID: traceID}
+	decision, err := p.storage.ReadTraceDecision(traceID)
+	switch err {
+	case nil:
+		explanation.Decision = &DecisionExplanation{
+			Sampled:    decision.Sampled,
+			Source:     decision.Source.String(),
+			SampleRate: decision.SampleRate,
+		}
+	case eventstorage.ErrNotFound:
+	default:
+		return nil, err
+	}
+
+	match, err := p.storage.ReadTraceMatch(traceID)
+	switch err {
+	case nil:
+		explanation.Policy = p.explainPolicy(match)
+	case eventstorage.ErrNotFound:
+	default:
+		return nil, err
+	}
+
+	if p.pending != nil {
+		explanation.Pending = p.pending.has(traceID)
+	}
+	return &explanation, nil
+}
+
+func (p *Processor) explainPolicy(match eventstorage.TraceMatch) *PolicyExplanation {
+	explanation := PolicyExplanation{
+		Index:               match.Policy,
+		ServiceName:         match.ServiceName,
+		AdmittedToReservoir: match.Admitted,
+	}
+	if match.Policy >= 0 && match.Policy < len(p.config.Policies) {
+		// The policies may have changed since the match was
+		// recorded, if the server has been reconfigured.
+		policy := p.config.Policies[match.Policy]
+		explanation.SampleRate = policy.SampleRate
+		explanation.MaxTracesPerSecond = policy.MaxTracesPerSecond
+	}
+	if stats, ok := p.groups.groupStats(match.ServiceName, match.Policy); ok {
+		explanation.Group = &GroupExplanation{
+			Dynamic:       stats.dynamic,
+			ReservoirSize: stats.reservoirSize,
+			IngestRate:    stats.ingestRate,
+		}
+	}
+	return &explanation
+}
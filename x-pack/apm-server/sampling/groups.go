@@ -6,12 +6,14 @@ package sampling
 
 import (
 	"errors"
+	"fmt"
 	"math"
 	"math/rand"
 	"sync"
 	"time"
 
 	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/x-pack/apm-server/sampling/pubsub"
 )
 
 const minReservoirSize = 1000
@@ -32,9 +34,16 @@ type traceGroups struct {
 	// be created, and events may be dropped.
 	maxDynamicServices int
 
-	// catchallServicePolicies, if non-nil, holds policies that apply to
-	// services which are not explicitly specified.
-	catchallServicePolicies []Policy
+	// flushInterval holds the local sampling flush interval, which is
+	// used for sizing the reservoirs of rate-limited policies.
+	flushInterval time.Duration
+
+	// policies holds the configured sampling policies.
+	policies []Policy
+
+	// catchallServicePolicies, if non-nil, holds the indices of policies
+	// that apply to services which are not explicitly specified.
+	catchallServicePolicies []int
 
 	mu            sync.RWMutex
 	staticGroups  map[string]serviceGroups
@@ -45,9 +54,26 @@ type serviceGroupKey struct {
 	serviceEnvironment string
 	traceOutcome       string
 	traceName          string
+	traceDurationMin   time.Duration
+	traceDurationMax   time.Duration
+	traceError         *bool
+	traceSpanCountMin  int
+	traceSpanCountMax  int
+	labels             map[string]string
+}
+
+// traceStats holds information about a trace, beyond its root transaction,
+// which is considered when matching sampling policies.
+type traceStats struct {
+	// hasError records whether the trace is known to contain errors.
+	hasError bool
+
+	// spanCount holds the number of spans observed for the trace.
+	// This is only known if sampling decisions are delayed.
+	spanCount int
 }
 
-func (k *serviceGroupKey) match(tx *model.Transaction) bool {
+func (k *serviceGroupKey) match(tx *model.Transaction, stats traceStats) bool {
 	if k.serviceEnvironment != "" && k.serviceEnvironment != tx.Metadata.Service.Environment {
 		return false
 	}
@@ -57,6 +83,33 @@ func (k *serviceGroupKey) match(tx *model.Transaction) bool {
 	if k.traceName != "" && k.traceName != tx.Name {
 		return false
 	}
+	if k.traceDurationMin != 0 || k.traceDurationMax != 0 {
+		duration := time.Duration(tx.Duration * float64(time.Millisecond))
+		if duration < k.traceDurationMin {
+			return false
+		}
+		if k.traceDurationMax != 0 && duration > k.traceDurationMax {
+			return false
+		}
+	}
+	if k.traceError != nil && *k.traceError != stats.hasError {
+		return false
+	}
+	if k.traceSpanCountMin != 0 && stats.spanCount < k.traceSpanCountMin {
+		return false
+	}
+	if k.traceSpanCountMax != 0 && stats.spanCount > k.traceSpanCountMax {
+		return false
+	}
+	for key, value := range k.labels {
+		labelValue, ok := tx.Labels[key]
+		if !ok {
+			labelValue, ok = tx.Metadata.Labels[key]
+		}
+		if !ok || fmt.Sprint(labelValue) != value {
+			return false
+		}
+	}
 	return true
 }
 
@@ -65,31 +118,37 @@ type serviceGroups []serviceGroup
 type serviceGroup struct {
 	key serviceGroupKey
 	g   *traceGroup
+
+	// policy holds the index of the policy from which the group was created.
+	policy int
 }
 
 // get returns the traceGroup to which tx should be added based on the
 // defined sampling policies, matching policies in the order given.
-func (sgs serviceGroups) get(tx *model.Transaction) (*traceGroup, bool) {
+func (sgs serviceGroups) get(tx *model.Transaction, stats traceStats) (serviceGroup, bool) {
 	for _, sg := range sgs {
-		if sg.key.match(tx) {
-			return sg.g, true
+		if sg.key.match(tx, stats) {
+			return sg, true
 		}
 	}
-	return nil, false
+	return serviceGroup{}, false
 }
 
 func newTraceGroups(
 	policies []Policy,
 	maxDynamicServices int,
 	ingestRateDecayFactor float64,
+	flushInterval time.Duration,
 ) *traceGroups {
 	groups := &traceGroups{
 		ingestRateDecayFactor: ingestRateDecayFactor,
 		maxDynamicServices:    maxDynamicServices,
+		flushInterval:         flushInterval,
+		policies:              policies,
 		staticGroups:          make(map[string]serviceGroups),
 		dynamicGroups:         make(map[string]serviceGroups),
 	}
-	for _, policy := range policies {
+	for i, policy := range policies {
 		if policy.ServiceName == "" {
 			// ServiceName is a special case; see PolicyCriteria.
 			//
@@ -97,37 +156,69 @@ func newTraceGroups(
 			// can easily keep track how many dynamic services (dynamicGroups) there
 			// are to enforce a limit, and to uphold the invariant that sampling groups
 			// are service-specific, similar to head-based sampling.
-			groups.catchallServicePolicies = append(groups.catchallServicePolicies, policy)
+			groups.catchallServicePolicies = append(groups.catchallServicePolicies, i)
 			continue
 		}
 		serviceGroups := groups.staticGroups[policy.ServiceName]
-		groups.staticGroups[policy.ServiceName] = updateServiceNameGroups(policy, serviceGroups)
+		groups.staticGroups[policy.ServiceName] = groups.updateServiceNameGroups(i, serviceGroups)
 	}
 	return groups
 }
 
-func updateServiceNameGroups(policy Policy, groups serviceGroups) serviceGroups {
+func (g *traceGroups) updateServiceNameGroups(policyIndex int, groups serviceGroups) serviceGroups {
+	policy := g.policies[policyIndex]
 	return append(groups, serviceGroup{
 		key: serviceGroupKey{
 			serviceEnvironment: policy.ServiceEnvironment,
 			traceName:          policy.TraceName,
 			traceOutcome:       policy.TraceOutcome,
+			traceDurationMin:   policy.TraceDurationMin,
+			traceDurationMax:   policy.TraceDurationMax,
+			traceError:         policy.TraceError,
+			traceSpanCountMin:  policy.TraceSpanCountMin,
+			traceSpanCountMax:  policy.TraceSpanCountMax,
+			labels:             policy.Labels,
 		},
-		g: newTraceGroup(policy.SampleRate),
+		g:      newTraceGroup(policy.SampleRate, maxTracesPerInterval(policy.MaxTracesPerSecond, g.flushInterval)),
+		policy: policyIndex,
 	})
 }
 
+// maxTracesPerInterval returns the maximum number of traces to sample
+// per flush interval for the given rate limit, or zero if there is no
+// rate limit.
+func maxTracesPerInterval(maxTracesPerSecond float64, flushInterval time.Duration) int {
+	if maxTracesPerSecond <= 0 {
+		return 0
+	}
+	maxTraces := int(math.Round(maxTracesPerSecond * flushInterval.Seconds()))
+	if maxTraces < 1 {
+		maxTraces = 1
+	}
+	return maxTraces
+}
+
 // traceGroup represents a single trace group, including a measurement of the
 // observed ingest rate, a trace ID weighted random sampling reservoir.
 type traceGroup struct {
 	// samplingFraction holds the configured fraction of traces in this
-	// trace group to sample, as a fraction in the range (0,1).
+	// trace group to sample, as a fraction in the range (0,1].
 	samplingFraction float64
 
+	// maxTraces, if non-zero, holds the maximum number of traces in this
+	// trace group to sample per flush interval. If maxTraces is non-zero,
+	// samplingFraction is ignored and the reservoir has a fixed size.
+	maxTraces int
+
 	mu sync.Mutex
 	// reservoir holds a random sample of root transactions observed
 	// for this trace group, weighted by duration.
 	reservoir *weightedRandomSample
+	// sampled holds the trace IDs of root transactions observed for
+	// this trace group, if samplingFraction is 1. In this case the
+	// reservoir is not used for sampling, but its size bounds the
+	// number of trace IDs held per interval.
+	sampled []string
 	// total holds the total number of root transactions observed for
 	// this trace group, including those that are not added to the
 	// reservoir. This is used to update ingestRate.
@@ -137,30 +228,49 @@ type traceGroup struct {
 	// sampling interval. This is read and written only by the periodic
 	// finalizeSampledTraces calls.
 	ingestRate float64
+
+	// lastTotal and lastSampled hold the number of root transactions
+	// observed and sampled, respectively, in the most recently finalized
+	// interval. These are used for reporting the effective sampling rate.
+	lastTotal   int
+	lastSampled int
 }
 
-func newTraceGroup(samplingFraction float64) *traceGroup {
+func newTraceGroup(samplingFraction float64, maxTraces int) *traceGroup {
+	reservoirSize := minReservoirSize
+	if maxTraces > 0 {
+		reservoirSize = maxTraces
+	}
 	return &traceGroup{
 		samplingFraction: samplingFraction,
+		maxTraces:        maxTraces,
 		reservoir: newWeightedRandomSample(
 			rand.New(rand.NewSource(time.Now().UnixNano())),
-			minReservoirSize,
+			reservoirSize,
 		),
 	}
 }
 
 // sampleTrace will return true if the root transaction is admitted to
-// the in-memory sampling reservoir, and false otherwise.
+// the in-memory sampling reservoir, and false otherwise. The stats
+// parameter holds what is known about the rest of the trace.
 //
 // If the transaction is not admitted due to the transaction group limit
 // having been reached, sampleTrace will return errTooManyTraceGroups.
-func (g *traceGroups) sampleTrace(tx *model.Transaction) (bool, error) {
+func (g *traceGroups) sampleTrace(tx *model.Transaction, stats traceStats) (bool, error) {
+	_, admitted, err := g.sampleTracePolicy(tx, stats)
+	return admitted, err
+}
+
+// sampleTracePolicy is like sampleTrace, additionally returning the
+// index of the policy matched by the root transaction.
+func (g *traceGroups) sampleTracePolicy(tx *model.Transaction, stats traceStats) (policy int, admitted bool, _ error) {
 	byService, ok := g.staticGroups[tx.Metadata.Service.Name]
 	if !ok {
 		// No static group, look for or create a dynamic group
 		// if there are any catch-all policies defined.
 		if len(g.catchallServicePolicies) == 0 {
-			return false, errNoMatchingPolicy
+			return -1, false, errNoMatchingPolicy
 		}
 		// First attempt to locate a dynamic group with a read lock, to
 		// avoid contention in the common case that a group has already
@@ -176,80 +286,100 @@ func (g *traceGroups) sampleTrace(tx *model.Transaction) (bool, error) {
 			byService, ok = g.dynamicGroups[tx.Metadata.Service.Name]
 			if !ok {
 				if len(g.dynamicGroups) == g.maxDynamicServices {
-					return false, errTooManyTraceGroups
+					return -1, false, errTooManyTraceGroups
 				}
 				byService = make(serviceGroups, 0, len(g.catchallServicePolicies))
-				for _, policy := range g.catchallServicePolicies {
-					byService = updateServiceNameGroups(policy, byService)
+				for _, policyIndex := range g.catchallServicePolicies {
+					byService = g.updateServiceNameGroups(policyIndex, byService)
 				}
 				g.dynamicGroups[tx.Metadata.Service.Name] = byService
 			}
 		}
 	}
-	group, ok := byService.get(tx)
+	group, ok := byService.get(tx, stats)
 	if !ok {
-		return false, errNoMatchingPolicy
+		return -1, false, errNoMatchingPolicy
 	}
-	return group.sampleTrace(tx)
+	admitted, err := group.g.sampleTrace(tx)
+	return group.policy, admitted, err
 }
 
 func (g *traceGroup) sampleTrace(tx *model.Transaction) (bool, error) {
-	if g.samplingFraction == 0 {
+	if g.samplingFraction == 0 && g.maxTraces == 0 {
 		return false, nil
 	}
 	g.mu.Lock()
 	defer g.mu.Unlock()
 	g.total++
+	if g.maxTraces == 0 && g.samplingFraction == 1 {
+		if len(g.sampled) >= g.reservoir.Size() {
+			// The group has observed more root transactions in
+			// this interval than the reservoir size allows; drop
+			// the remainder, as with a full reservoir. The size is
+			// adjusted to the ingest rate when finalized.
+			return false, nil
+		}
+		g.sampled = append(g.sampled, tx.TraceID)
+		return true, nil
+	}
 	return g.reservoir.Sample(tx.Duration, tx.TraceID), nil
 }
 
-// finalizeSampledTraces locks the groups, appends their current trace IDs to
-// traceIDs, and returns the extended slice. On return the groups' sampling
+// finalizeSampledTraces locks the groups, appends their current sampled traces
+// to traces, and returns the extended slice. On return the groups' sampling
 // reservoirs will be reset.
 //
 // If the maximum number of groups has been reached, then any dynamically
 // created groups with the minimum reservoir size (low ingest or sampling rate)
 // may be removed. These groups may also be removed if they have seen no
 // activity in this interval.
-func (g *traceGroups) finalizeSampledTraces(traceIDs []string) []string {
+func (g *traceGroups) finalizeSampledTraces(traces []pubsub.SampledTrace) []pubsub.SampledTrace {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 	for _, byService := range g.staticGroups {
-		traceIDs, _, _ = g.finalizeServiceSampledTraces(byService, traceIDs)
+		traces, _, _ = g.finalizeServiceSampledTraces(byService, traces)
 	}
 	maxDynamicServicesReached := len(g.dynamicGroups) == g.maxDynamicServices
 	for serviceName, byService := range g.dynamicGroups {
 		var total int
 		var allMinReservoirSize bool
-		traceIDs, total, allMinReservoirSize = g.finalizeServiceSampledTraces(byService, traceIDs)
+		traces, total, allMinReservoirSize = g.finalizeServiceSampledTraces(byService, traces)
 		if allMinReservoirSize {
 			if maxDynamicServicesReached || total == 0 {
 				delete(g.dynamicGroups, serviceName)
 			}
 		}
 	}
-	return traceIDs
+	return traces
 }
 
 func (g *traceGroups) finalizeServiceSampledTraces(
 	byService serviceGroups,
-	traceIDs []string,
-) (_ []string, total int, allMinReservoirSize bool) {
+	traces []pubsub.SampledTrace,
+) (_ []pubsub.SampledTrace, total int, allMinReservoirSize bool) {
 	allMinReservoirSize = true
 	for _, group := range byService {
 		total += group.g.total
-		traceIDs = group.g.finalizeSampledTraces(traceIDs, g.ingestRateDecayFactor)
+		traces = group.g.finalizeSampledTraces(traces, g.ingestRateDecayFactor)
+		if group.g.maxTraces > 0 {
+			// Rate-limited reservoirs have a fixed size,
+			// so they do not indicate a high ingest rate.
+			continue
+		}
 		if size := group.g.reservoir.Size(); size > minReservoirSize {
 			allMinReservoirSize = false
 		}
 	}
-	return traceIDs, total, allMinReservoirSize
+	return traces, total, allMinReservoirSize
 }
 
-// finalizeSampledTraces appends the group's current trace IDs to traceIDs, and
-// returns the extended slice. On return the groups' sampling reservoirs will be
-// reset.
-func (g *traceGroup) finalizeSampledTraces(traceIDs []string, ingestRateDecayFactor float64) []string {
+// finalizeSampledTraces appends the group's current sampled traces to traces,
+// and returns the extended slice. On return the groups' sampling reservoirs will
+// be reset.
+//
+// Each sampled trace records the group's effective sample rate for the interval:
+// the fraction of root transactions observed by the group which were sampled.
+func (g *traceGroup) finalizeSampledTraces(traces []pubsub.SampledTrace, ingestRateDecayFactor float64) []pubsub.SampledTrace {
 	g.mu.Lock()
 	defer g.mu.Unlock()
 
@@ -260,15 +390,32 @@ func (g *traceGroup) finalizeSampledTraces(traceIDs []string, ingestRateDecayFac
 		g.ingestRate += ingestRateDecayFactor * float64(g.total)
 	}
 	desiredTotal := int(math.Round(g.samplingFraction * float64(g.total)))
+	g.lastTotal = g.total
 	g.total = 0
 
-	for n := g.reservoir.Len(); n > desiredTotal; n-- {
-		// The reservoir is larger than the desired fraction of the
-		// observed total number of traces in this interval. Pop the
-		// lowest weighted traces to limit to the desired total.
-		g.reservoir.Pop()
+	if g.maxTraces > 0 {
+		// The reservoir has a fixed size, holding at most
+		// the maximum number of traces for the interval.
+		g.lastSampled = g.reservoir.Len()
+		traces = g.appendLastSampled(traces, g.reservoir.Values())
+		g.reservoir.Reset()
+		return traces
+	}
+
+	if g.samplingFraction == 1 {
+		g.lastSampled = len(g.sampled)
+		traces = g.appendLastSampled(traces, g.sampled)
+		g.sampled = g.sampled[:0]
+	} else {
+		for n := g.reservoir.Len(); n > desiredTotal; n-- {
+			// The reservoir is larger than the desired fraction of the
+			// observed total number of traces in this interval. Pop the
+			// lowest weighted traces to limit to the desired total.
+			g.reservoir.Pop()
+		}
+		g.lastSampled = g.reservoir.Len()
+		traces = g.appendLastSampled(traces, g.reservoir.Values())
 	}
-	traceIDs = append(traceIDs, g.reservoir.Values()...)
 
 	// Resize the reservoir, so that it can hold the desired fraction of
 	// the observed ingest rate.
@@ -278,5 +425,86 @@ func (g *traceGroup) finalizeSampledTraces(traceIDs []string, ingestRateDecayFac
 	}
 	g.reservoir.Reset()
 	g.reservoir.Resize(newReservoirSize)
-	return traceIDs
+	return traces
+}
+
+// appendLastSampled appends traceIDs to traces, with the effective sample
+// rate of the most recently finalized interval.
+func (g *traceGroup) appendLastSampled(traces []pubsub.SampledTrace, traceIDs []string) []pubsub.SampledTrace {
+	var sampleRate float64
+	if g.lastTotal > 0 {
+		sampleRate = float64(g.lastSampled) / float64(g.lastTotal)
+	}
+	for _, traceID := range traceIDs {
+		traces = append(traces, pubsub.SampledTrace{TraceID: traceID, SampleRate: sampleRate})
+	}
+	return traces
+}
+
+// policyStats holds the number of root transactions observed and sampled
+// for a policy, across all of its trace groups, in the most recently
+// finalized interval.
+type policyStats struct {
+	total   int
+	sampled int
+}
+
+// policyStats returns the number of root transactions observed and sampled
+// for each policy in the most recently finalized interval, indexed by policy.
+func (g *traceGroups) policyStats() []policyStats {
+	stats := make([]policyStats, len(g.policies))
+	add := func(groups serviceGroups) {
+		for _, group := range groups {
+			group.g.mu.Lock()
+			stats[group.policy].total += group.g.lastTotal
+			stats[group.policy].sampled += group.g.lastSampled
+			group.g.mu.Unlock()
+		}
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for _, byService := range g.staticGroups {
+		add(byService)
+	}
+	for _, byService := range g.dynamicGroups {
+		add(byService)
+	}
+	return stats
+}
+
+// traceGroupStats holds information about the current state of a trace group.
+type traceGroupStats struct {
+	// dynamic records whether the group was created for a service
+	// matched by a policy without a service name.
+	dynamic bool
+
+	reservoirSize int
+	ingestRate    float64
+}
+
+// groupStats returns information about the trace group for the given service
+// name and policy index, returning false if the group does not exist.
+func (g *traceGroups) groupStats(serviceName string, policy int) (traceGroupStats, bool) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	byService, ok := g.staticGroups[serviceName]
+	dynamic := !ok
+	if !ok {
+		if byService, ok = g.dynamicGroups[serviceName]; !ok {
+			return traceGroupStats{}, false
+		}
+	}
+	for _, group := range byService {
+		if group.policy != policy {
+			continue
+		}
+		group.g.mu.Lock()
+		defer group.g.mu.Unlock()
+		return traceGroupStats{
+			dynamic:       dynamic,
+			reservoirSize: group.g.reservoir.Size(),
+			ingestRate:    group.g.ingestRate,
+		}, true
+	}
+	return traceGroupStats{}, false
 }
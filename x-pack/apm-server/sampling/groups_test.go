@@ -7,12 +7,14 @@ package sampling
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/elastic/apm-server/model"
+	"github.com/elastic/beats/v7/libbeat/common"
 )
 
 func TestTraceGroupsPolicies(t *testing.T) {
@@ -59,13 +61,13 @@ func TestTraceGroupsPolicies(t *testing.T) {
 		policy.ServiceName = ""
 		policies = append(policies, policy)
 	}
-	groups := newTraceGroups(policies, 1000, 1.0)
+	groups := newTraceGroups(policies, 1000, 1.0, time.Minute)
 
 	assertSampleRate := func(sampleRate float64, serviceName, serviceEnvironment, traceOutcome, traceName string) {
 		tx := makeTransaction(serviceName, serviceEnvironment, traceOutcome, traceName)
 		const N = 1000
 		for i := 0; i < N; i++ {
-			if _, err := groups.sampleTrace(tx); err != nil {
+			if _, err := groups.sampleTrace(tx, traceStats{}); err != nil {
 				t.Fatal(err)
 			}
 		}
@@ -81,13 +83,136 @@ func TestTraceGroupsPolicies(t *testing.T) {
 	}
 }
 
+func TestTraceGroupsPolicyCriteria(t *testing.T) {
+	traceError := true
+	policies := []Policy{{
+		SampleRate:     1.0,
+		PolicyCriteria: PolicyCriteria{TraceError: &traceError},
+	}, {
+		SampleRate:     1.0,
+		PolicyCriteria: PolicyCriteria{TraceDurationMin: time.Second, TraceDurationMax: 2 * time.Second},
+	}, {
+		SampleRate:     1.0,
+		PolicyCriteria: PolicyCriteria{Labels: map[string]string{"tier": "gold", "priority": "1"}},
+	}, {
+		SampleRate:     1.0,
+		PolicyCriteria: PolicyCriteria{TraceSpanCountMin: 2, TraceSpanCountMax: 3},
+	}}
+	groups := newTraceGroups(policies, 1000, 1.0, time.Minute)
+
+	assertMatch := func(expected bool, tx *model.Transaction, stats traceStats) {
+		t.Helper()
+		tx.TraceID = uuid.Must(uuid.NewV4()).String()
+		tx.ID = uuid.Must(uuid.NewV4()).String()
+		admitted, err := groups.sampleTrace(tx, stats)
+		if expected {
+			require.NoError(t, err)
+			assert.True(t, admitted)
+		} else {
+			assert.Equal(t, errNoMatchingPolicy, err)
+			assert.False(t, admitted)
+		}
+	}
+
+	assertMatch(true, &model.Transaction{}, traceStats{hasError: true})
+	assertMatch(false, &model.Transaction{}, traceStats{})
+
+	assertMatch(true, &model.Transaction{Duration: 1000}, traceStats{})
+	assertMatch(true, &model.Transaction{Duration: 2000}, traceStats{})
+	assertMatch(false, &model.Transaction{Duration: 999}, traceStats{})
+	assertMatch(false, &model.Transaction{Duration: 2001}, traceStats{})
+
+	assertMatch(true, &model.Transaction{Labels: common.MapStr{"tier": "gold", "priority": 1}}, traceStats{})
+	assertMatch(true, &model.Transaction{
+		Metadata: model.Metadata{Labels: common.MapStr{"tier": "gold"}},
+		Labels:   common.MapStr{"priority": "1"},
+	}, traceStats{})
+	assertMatch(false, &model.Transaction{Labels: common.MapStr{"tier": "gold"}}, traceStats{})
+	assertMatch(false, &model.Transaction{Labels: common.MapStr{"tier": "silver", "priority": 1}}, traceStats{})
+
+	assertMatch(true, &model.Transaction{}, traceStats{spanCount: 2})
+	assertMatch(true, &model.Transaction{}, traceStats{spanCount: 3})
+	assertMatch(false, &model.Transaction{}, traceStats{spanCount: 1})
+	assertMatch(false, &model.Transaction{}, traceStats{spanCount: 4})
+
+	// All matching traces are sampled, as each policy has a sample rate of 1.
+	sampled := groups.finalizeSampledTraces(nil)
+	assert.Len(t, sampled, 7)
+	assert.Empty(t, groups.finalizeSampledTraces(nil))
+}
+
+func TestTraceGroupsRateLimited(t *testing.T) {
+	policies := []Policy{{MaxTracesPerSecond: 1}}
+	groups := newTraceGroups(policies, 1000, 1.0, 10*time.Second)
+
+	sampleTraces := func(n int) {
+		for i := 0; i < n; i++ {
+			_, err := groups.sampleTrace(&model.Transaction{
+				TraceID:  uuid.Must(uuid.NewV4()).String(),
+				ID:       uuid.Must(uuid.NewV4()).String(),
+				Duration: 1,
+			}, traceStats{})
+			require.NoError(t, err)
+		}
+	}
+
+	// At most 10 traces (1 per second for the 10s flush interval)
+	// are sampled, regardless of the ingest rate.
+	sampleTraces(100)
+	assert.Len(t, groups.finalizeSampledTraces(nil), 10)
+	assert.Equal(t, []policyStats{{total: 100, sampled: 10}}, groups.policyStats())
+
+	sampleTraces(5000)
+	assert.Len(t, groups.finalizeSampledTraces(nil), 10)
+	assert.Equal(t, []policyStats{{total: 5000, sampled: 10}}, groups.policyStats())
+
+	sampleTraces(5)
+	assert.Len(t, groups.finalizeSampledTraces(nil), 5)
+	assert.Equal(t, []policyStats{{total: 5, sampled: 5}}, groups.policyStats())
+}
+
+func TestTraceGroupsEffectiveSampleRate(t *testing.T) {
+	policies := []Policy{
+		{PolicyCriteria: PolicyCriteria{ServiceName: "reservoir"}, SampleRate: 0.2},
+		{PolicyCriteria: PolicyCriteria{ServiceName: "rate_limited"}, MaxTracesPerSecond: 1},
+		{SampleRate: 1},
+	}
+	groups := newTraceGroups(policies, 1000, 1.0, 10*time.Second)
+
+	sampleTraces := func(serviceName string, n int) {
+		for i := 0; i < n; i++ {
+			_, err := groups.sampleTrace(&model.Transaction{
+				Metadata: model.Metadata{Service: model.Service{Name: serviceName}},
+				TraceID:  uuid.Must(uuid.NewV4()).String(),
+				ID:       uuid.Must(uuid.NewV4()).String(),
+				Duration: 1,
+			}, traceStats{})
+			require.NoError(t, err)
+		}
+	}
+	sampleTraces("reservoir", 10000)
+	sampleTraces("rate_limited", 100)
+	sampleTraces("other", 10)
+
+	// Each sampled trace records the effective sample rate of
+	// its group: the fraction of the group's traces sampled.
+	sampleRates := make(map[float64]int)
+	for _, trace := range groups.finalizeSampledTraces(nil) {
+		sampleRates[trace.SampleRate]++
+	}
+	assert.Equal(t, map[float64]int{
+		0.1: 1000 + 10, // initial reservoir size of 1000 for 10000 traces, and 10 of 100 traces
+		1.0: 10,
+	}, sampleRates)
+}
+
 func TestTraceGroupsMax(t *testing.T) {
 	const (
 		maxDynamicServices    = 100
 		ingestRateCoefficient = 1.0
 	)
 	policies := []Policy{{SampleRate: 1.0}}
-	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient)
+	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient, time.Minute)
 
 	for i := 0; i < maxDynamicServices; i++ {
 		serviceName := fmt.Sprintf("service_group_%d", i)
@@ -101,7 +226,7 @@ func TestTraceGroupsMax(t *testing.T) {
 				Name:    "whatever",
 				TraceID: uuid.Must(uuid.NewV4()).String(),
 				ID:      uuid.Must(uuid.NewV4()).String(),
-			})
+			}, traceStats{})
 			require.NoError(t, err)
 			assert.True(t, admitted)
 		}
@@ -111,7 +236,7 @@ func TestTraceGroupsMax(t *testing.T) {
 		Name:    "overflow",
 		TraceID: uuid.Must(uuid.NewV4()).String(),
 		ID:      uuid.Must(uuid.NewV4()).String(),
-	})
+	}, traceStats{})
 	assert.Equal(t, errTooManyTraceGroups, err)
 	assert.False(t, admitted)
 }
@@ -122,14 +247,14 @@ func TestTraceGroupReservoirResize(t *testing.T) {
 		ingestRateCoefficient = 0.75
 	)
 	policies := []Policy{{SampleRate: 0.2}}
-	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient)
+	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient, time.Minute)
 
 	sendTransactions := func(n int) {
 		for i := 0; i < n; i++ {
 			groups.sampleTrace(&model.Transaction{
 				TraceID: "0102030405060708090a0b0c0d0e0f10",
 				ID:      "0102030405060708",
-			})
+			}, traceStats{})
 		}
 	}
 
@@ -154,20 +279,56 @@ func TestTraceGroupReservoirResize(t *testing.T) {
 	}
 }
 
+func TestTraceGroupSampleAllBounded(t *testing.T) {
+	const (
+		maxDynamicServices    = 1
+		ingestRateCoefficient = 1.0
+	)
+	policies := []Policy{{SampleRate: 1.0}}
+	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient, time.Minute)
+
+	sendTransactions := func(n int) (admitted int) {
+		for i := 0; i < n; i++ {
+			ok, err := groups.sampleTrace(&model.Transaction{
+				TraceID: "0102030405060708090a0b0c0d0e0f10",
+				ID:      "0102030405060708",
+			}, traceStats{})
+			require.NoError(t, err)
+			if ok {
+				admitted++
+			}
+		}
+		return admitted
+	}
+
+	// Trace IDs are held up to the initial reservoir size,
+	// even though the sample rate is 1.
+	assert.Equal(t, 1000, sendTransactions(1500))
+	sampled := groups.finalizeSampledTraces(nil)
+	require.Len(t, sampled, 1000)
+	assert.Equal(t, 1000.0/1500.0, sampled[0].SampleRate)
+
+	// The bound follows the observed ingest rate.
+	assert.Equal(t, 1500, sendTransactions(1500))
+	sampled = groups.finalizeSampledTraces(nil)
+	require.Len(t, sampled, 1500)
+	assert.Equal(t, 1.0, sampled[0].SampleRate)
+}
+
 func TestTraceGroupReservoirResizeMinimum(t *testing.T) {
 	const (
 		maxDynamicServices    = 1
 		ingestRateCoefficient = 1.0
 	)
 	policies := []Policy{{SampleRate: 0.1}}
-	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient)
+	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient, time.Minute)
 
 	sendTransactions := func(n int) {
 		for i := 0; i < n; i++ {
 			groups.sampleTrace(&model.Transaction{
 				TraceID: "0102030405060708090a0b0c0d0e0f10",
 				ID:      "0102030405060708",
-			})
+			}, traceStats{})
 		}
 	}
 
@@ -192,29 +353,29 @@ func TestTraceGroupsRemoval(t *testing.T) {
 		{SampleRate: 0.5},
 		{PolicyCriteria: PolicyCriteria{ServiceName: "defined"}, SampleRate: 0.5},
 	}
-	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient)
+	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient, time.Minute)
 
 	for i := 0; i < 10000; i++ {
 		_, err := groups.sampleTrace(&model.Transaction{
 			Metadata: model.Metadata{Service: model.Service{Name: "many"}},
-		})
+		}, traceStats{})
 		assert.NoError(t, err)
 	}
 	_, err := groups.sampleTrace(&model.Transaction{
 		Metadata: model.Metadata{Service: model.Service{Name: "few"}},
-	})
+	}, traceStats{})
 	assert.NoError(t, err)
 
 	_, err = groups.sampleTrace(&model.Transaction{
 		Metadata: model.Metadata{Service: model.Service{Name: "another"}},
-	})
+	}, traceStats{})
 	assert.Equal(t, errTooManyTraceGroups, err)
 
 	// When there is a policy with an explicitly defined service name, that
 	// will not be affected by the limit.
 	_, err = groups.sampleTrace(&model.Transaction{
 		Metadata: model.Metadata{Service: model.Service{Name: "defined"}},
-	})
+	}, traceStats{})
 	assert.NoError(t, err)
 
 	// Finalizing should remove the "few" trace group, since its reservoir
@@ -224,7 +385,7 @@ func TestTraceGroupsRemoval(t *testing.T) {
 	// We should now be able to add another trace group.
 	_, err = groups.sampleTrace(&model.Transaction{
 		Metadata: model.Metadata{Service: model.Service{Name: "another"}},
-	})
+	}, traceStats{})
 	assert.NoError(t, err)
 }
 
@@ -233,8 +394,8 @@ func BenchmarkTraceGroups(b *testing.B) {
 		maxDynamicServices    = 1000
 		ingestRateCoefficient = 1.0
 	)
-	policies := []Policy{{SampleRate: 1.0}}
-	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient)
+	policies := []Policy{{SampleRate: 0.5}}
+	groups := newTraceGroups(policies, maxDynamicServices, ingestRateCoefficient, time.Minute)
 
 	b.RunParallel(func(pb *testing.PB) {
 		// Transaction identifiers are different for each goroutine, simulating
@@ -247,7 +408,7 @@ func BenchmarkTraceGroups(b *testing.B) {
 			Name:     uuid.Must(uuid.NewV4()).String(),
 		}
 		for pb.Next() {
-			groups.sampleTrace(&tx)
+			groups.sampleTrace(&tx, traceStats{})
 			tx.Duration += 1000
 		}
 	})
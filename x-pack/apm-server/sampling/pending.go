@@ -0,0 +1,124 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sampling
+
+import (
+	"sync"
+	"time"
+)
+
+// pendingTraces tracks traces for which no local sampling decision has been
+// made, when sampling decisions are delayed until traces are idle. The trace
+// events themselves, including the root transaction, are held in local storage.
+type pendingTraces struct {
+	mu     sync.Mutex
+	traces map[string]*pendingTrace
+}
+
+// pendingTrace holds information about a trace awaiting a sampling decision.
+type pendingTrace struct {
+	traceStats
+
+	// traceID holds the trace's ID.
+	traceID string
+
+	// rootID holds the ID of the trace's root transaction, if it has been
+	// received. The transaction is read back from local storage when the
+	// sampling decision is made.
+	rootID string
+
+	// lastSeen holds the time at which the most recent event for the
+	// trace was received.
+	lastSeen time.Time
+
+	// deciding records whether the trace has been returned by takeIdle,
+	// and is awaiting removal once its sampling decision has been made.
+	// The trace is no longer updated while deciding.
+	deciding bool
+}
+
+func newPendingTraces() *pendingTraces {
+	return &pendingTraces{traces: make(map[string]*pendingTrace)}
+}
+
+// update records that an event for the given trace ID was received at
+// the given time, calling f with the lock held to update the trace.
+func (p *pendingTraces) update(traceID string, now time.Time, f func(*pendingTrace)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	trace, ok := p.traces[traceID]
+	if !ok {
+		trace = &pendingTrace{traceID: traceID}
+		p.traces[traceID] = trace
+	}
+	if !trace.deciding {
+		trace.lastSeen = now
+		f(trace)
+	}
+}
+
+// updateExisting is like update, but only updates the trace if it is
+// already tracked, returning false otherwise.
+func (p *pendingTraces) updateExisting(traceID string, now time.Time, f func(*pendingTrace)) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	trace, ok := p.traces[traceID]
+	if !ok {
+		return false
+	}
+	if !trace.deciding {
+		trace.lastSeen = now
+		f(trace)
+	}
+	return true
+}
+
+// takeIdle returns traces which have received no events since the given
+// time, appending those whose root transaction has been received to out,
+// and returning the extended slice. The returned traces remain tracked,
+// but are no longer updated, until they are removed by calling remove.
+//
+// Idle traces whose root transaction has not been received are discarded;
+// their events remain in local storage until they expire, or until the root
+// transaction is received and the trace is once again tracked.
+func (p *pendingTraces) takeIdle(since time.Time, out []pendingTrace) []pendingTrace {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for traceID, trace := range p.traces {
+		if trace.deciding || trace.lastSeen.After(since) {
+			continue
+		}
+		if trace.rootID == "" {
+			delete(p.traces, traceID)
+			continue
+		}
+		trace.deciding = true
+		out = append(out, *trace)
+	}
+	return out
+}
+
+// remove stops tracking the trace with the given trace ID.
+func (p *pendingTraces) remove(traceID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.traces, traceID)
+}
+
+// has reports whether the trace with the given trace ID is awaiting
+// a sampling decision.
+func (p *pendingTraces) has(traceID string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	_, ok := p.traces[traceID]
+	return ok
+}
+
+// len returns the number of traces awaiting a sampling decision.
+func (p *pendingTraces) len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.traces)
+}
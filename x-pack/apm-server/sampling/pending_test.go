@@ -0,0 +1,48 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sampling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPendingTracesTakeIdle(t *testing.T) {
+	pending := newPendingTraces()
+	start := time.Now()
+
+	pending.update("a", start, func(trace *pendingTrace) { trace.rootID = "root" })
+	pending.update("a", start.Add(time.Second), func(trace *pendingTrace) { trace.spanCount++ })
+	pending.update("b", start, func(trace *pendingTrace) { trace.spanCount++ })
+	assert.Equal(t, 2, pending.len())
+
+	// Trace "a" received an event after start, so it is not yet idle.
+	// Trace "b" is idle, but is discarded as its root has not been received.
+	idle := pending.takeIdle(start, nil)
+	assert.Empty(t, idle)
+	assert.Equal(t, 1, pending.len())
+
+	idle = pending.takeIdle(start.Add(time.Second), nil)
+	require.Len(t, idle, 1)
+	assert.Equal(t, "a", idle[0].traceID)
+	assert.Equal(t, "root", idle[0].rootID)
+	assert.Equal(t, traceStats{spanCount: 1}, idle[0].traceStats)
+
+	// The idle trace remains tracked until it is removed, but is no
+	// longer updated or returned by takeIdle.
+	assert.True(t, pending.updateExisting("a", start.Add(2*time.Second), func(trace *pendingTrace) {
+		t.Fatal("unexpected update")
+	}))
+	assert.Empty(t, pending.takeIdle(start.Add(time.Hour), nil))
+	assert.True(t, pending.has("a"))
+	pending.remove("a")
+	assert.Equal(t, 0, pending.len())
+	assert.False(t, pending.updateExisting("a", start, func(trace *pendingTrace) {
+		t.Fatal("unexpected update")
+	}))
+}
@@ -6,6 +6,10 @@ package sampling
 
 import (
 	"context"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -16,10 +20,12 @@ import (
 
 	logs "github.com/elastic/apm-server/log"
 	"github.com/elastic/apm-server/model"
+	"github.com/elastic/apm-server/processor/otel"
 	"github.com/elastic/apm-server/publish"
 	"github.com/elastic/apm-server/transform"
 	"github.com/elastic/apm-server/x-pack/apm-server/sampling/eventstorage"
 	"github.com/elastic/apm-server/x-pack/apm-server/sampling/pubsub"
+	"github.com/elastic/apm-server/x-pack/apm-server/sampling/pubsub/peer"
 	"github.com/elastic/beats/v7/libbeat/logp"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
 )
@@ -30,6 +36,15 @@ const (
 	// tooManyGroupsLoggerRateLimit is the maximum frequency at which
 	// "too many groups" log messages are logged.
 	tooManyGroupsLoggerRateLimit = time.Minute
+
+	// storageDiskUsageInterval is the frequency at which the disk
+	// usage of local storage is measured.
+	storageDiskUsageInterval = time.Second
+
+	// samplingStateInterval is the frequency at which the in-memory
+	// sampling state is recorded in local storage, in addition to
+	// after each flush and when the processor is stopped.
+	samplingStateInterval = 10 * time.Second
 )
 
 // ErrStopped is returned when calling ProcessTransformables on a stopped Processor.
@@ -42,10 +57,22 @@ type Processor struct {
 	tooManyGroupsLogger *logp.Logger
 	groups              *traceGroups
 
-	storageMu    sync.RWMutex
-	db           *badger.DB
-	storage      *eventstorage.ShardedReadWriter
-	eventMetrics eventMetrics
+	// trackTraceErrors records whether any policy matches traces
+	// based on whether they contain errors, in which case errors
+	// are recorded in storage until a sampling decision is made.
+	trackTraceErrors bool
+
+	// pending tracks traces awaiting a sampling decision, if decisions
+	// are delayed until traces are idle. If decisions are not delayed,
+	// pending is nil.
+	pending *pendingTraces
+
+	storageMu      sync.RWMutex
+	db             *badger.DB
+	eventStore     *eventstorage.Storage
+	storage        *eventstorage.ShardedReadWriter
+	eventMetrics   eventMetrics
+	storageMetrics storageMetrics
 
 	stopMu   sync.Mutex
 	stopping chan struct{}
@@ -58,6 +85,11 @@ type eventMetrics struct {
 	stored    int64
 }
 
+type storageMetrics struct {
+	diskUsage      int64
+	rejectedWrites int64
+}
+
 // NewProcessor returns a new Processor, for tail-sampling trace events.
 func NewProcessor(config Config) (*Processor, error) {
 	if err := config.Validate(); err != nil {
@@ -73,7 +105,7 @@ func NewProcessor(config Config) (*Processor, error) {
 		return nil, err
 	}
 
-	eventCodec := eventstorage.JSONCodec{}
+	eventCodec := eventstorage.BinaryCodec{}
 	storage := eventstorage.New(db, eventCodec, config.TTL)
 	readWriter := storage.NewShardedReadWriter()
 
@@ -81,15 +113,77 @@ func NewProcessor(config Config) (*Processor, error) {
 		config:              config,
 		logger:              logger,
 		tooManyGroupsLogger: logger.WithOptions(logs.WithRateLimit(tooManyGroupsLoggerRateLimit)),
-		groups:              newTraceGroups(config.Policies, config.MaxDynamicServices, config.IngestRateDecayFactor),
+		groups:              newTraceGroups(config.Policies, config.MaxDynamicServices, config.IngestRateDecayFactor, config.FlushInterval),
+		trackTraceErrors:    trackTraceErrors(config.Policies),
 		db:                  db,
+		eventStore:          storage,
 		storage:             readWriter,
 		stopping:            make(chan struct{}),
 		stopped:             make(chan struct{}),
 	}
+	if config.DecisionDelay > 0 {
+		p.pending = newPendingTraces()
+	}
+	if err := p.updateStorageDiskUsage(); err != nil {
+		readWriter.Close()
+		db.Close()
+		return nil, err
+	}
+	if err := p.restoreSamplingState(); err != nil {
+		// The sampling state is an optimisation for restarts;
+		// failing to restore it should not prevent startup.
+		logger.With(logp.Error(err)).Warn("failed to restore tail-sampling state")
+	}
 	return p, nil
 }
 
+// restoreSamplingState restores the trace groups and pending traces
+// recorded in local storage before the processor was last stopped.
+func (p *Processor) restoreSamplingState() error {
+	state, err := p.eventStore.ReadSamplingState()
+	if err != nil {
+		if err == eventstorage.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	groups := p.groups.restore(state.Groups)
+	var pending int
+	if p.pending != nil {
+		for _, trace := range state.PendingTraces {
+			p.pending.restore(trace)
+			pending++
+		}
+	}
+	p.logger.Infof(
+		"restored tail-sampling state recorded at %s: %d trace groups, %d pending traces",
+		state.Timestamp.Format(time.RFC3339), groups, pending,
+	)
+	return nil
+}
+
+// writeSamplingState records the trace groups and pending traces in local
+// storage, so they may be restored when the processor is restarted.
+func (p *Processor) writeSamplingState() error {
+	state := eventstorage.SamplingState{
+		Timestamp: time.Now(),
+		Groups:    p.groups.state(),
+	}
+	if p.pending != nil {
+		state.PendingTraces = p.pending.state()
+	}
+	return p.eventStore.WriteSamplingState(state)
+}
+
+func trackTraceErrors(policies []Policy) bool {
+	for _, policy := range policies {
+		if policy.TraceError != nil {
+			return true
+		}
+	}
+	return false
+}
+
 // CollectMonitoring may be called to collect monitoring metrics related to
 // tail-sampling. It is intended to be used with libbeat/monitoring.NewFunc.
 //
@@ -110,6 +204,10 @@ func (p *Processor) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor) {
 	numDynamicGroups := len(p.groups.dynamicGroups)
 	p.groups.mu.RUnlock()
 	monitoring.ReportInt(V, "dynamic_service_groups", int64(numDynamicGroups))
+	if p.pending != nil {
+		monitoring.ReportInt(V, "pending_traces", int64(p.pending.len()))
+	}
+	p.collectPolicyMonitoring(V)
 
 	monitoring.ReportNamespace(V, "storage", func() {
 		p.storageMu.RLock()
@@ -117,6 +215,8 @@ func (p *Processor) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor) {
 		lsmSize, valueLogSize := p.db.Size()
 		monitoring.ReportInt(V, "lsm_size", int64(lsmSize))
 		monitoring.ReportInt(V, "value_log_size", int64(valueLogSize))
+		monitoring.ReportInt(V, "disk_usage", atomic.LoadInt64(&p.storageMetrics.diskUsage))
+		monitoring.ReportInt(V, "rejected_writes", atomic.LoadInt64(&p.storageMetrics.rejectedWrites))
 	})
 	monitoring.ReportNamespace(V, "events", func() {
 		monitoring.ReportInt(V, "processed", atomic.LoadInt64(&p.eventMetrics.processed))
@@ -125,6 +225,38 @@ func (p *Processor) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor) {
 	})
 }
 
+// collectPolicyMonitoring reports the effective sampling rate of rate-limited
+// policies, as observed in the most recent flush interval, keyed by policy index.
+func (p *Processor) collectPolicyMonitoring(V monitoring.Visitor) {
+	var rateLimited bool
+	for _, policy := range p.config.Policies {
+		if policy.MaxTracesPerSecond > 0 {
+			rateLimited = true
+			break
+		}
+	}
+	if !rateLimited {
+		return
+	}
+	stats := p.groups.policyStats()
+	monitoring.ReportNamespace(V, "policies", func() {
+		for i, policy := range p.config.Policies {
+			if policy.MaxTracesPerSecond <= 0 {
+				continue
+			}
+			monitoring.ReportNamespace(V, strconv.Itoa(i), func() {
+				var effectiveSampleRate float64
+				if stats[i].total > 0 {
+					effectiveSampleRate = float64(stats[i].sampled) / float64(stats[i].total)
+				}
+				monitoring.ReportFloat(V, "max_traces_per_second", policy.MaxTracesPerSecond)
+				monitoring.ReportFloat(V, "sampled_traces_per_second", float64(stats[i].sampled)/p.config.FlushInterval.Seconds())
+				monitoring.ReportFloat(V, "effective_sample_rate", effectiveSampleRate)
+			})
+		}
+	})
+}
+
 // ProcessTransformables tail-samples transactions and spans.
 //
 // Any events returned by the processor will be published immediately.
@@ -136,6 +268,15 @@ func (p *Processor) CollectMonitoring(_ monitoring.Mode, V monitoring.Visitor) {
 //
 // All other trace events will either be dropped (e.g. known to not
 // be tail-sampled), or stored for possible later publication.
+//
+// If sampling decisions are delayed, all trace events for which no
+// decision has been made are stored, and the trace's root transaction
+// is sampled once no new events have been received for the decision
+// delay.
+//
+// If the storage limit has been reached, trace events for which no
+// decision has been made are reported or dropped according to the
+// configuration, rather than being stored.
 func (p *Processor) ProcessTransformables(ctx context.Context, events []transform.Transformable) ([]transform.Transformable, error) {
 	p.storageMu.RLock()
 	defer p.storageMu.RUnlock()
@@ -152,6 +293,13 @@ func (p *Processor) ProcessTransformables(ctx context.Context, events []transfor
 		case *model.Span:
 			atomic.AddInt64(&p.eventMetrics.processed, 1)
 			report, stored, err = p.processSpan(event)
+		case *model.Error:
+			// Errors are always reported, but may influence
+			// the sampling decision for their trace.
+			if err := p.processError(event); err != nil {
+				return nil, err
+			}
+			continue
 		default:
 			continue
 		}
@@ -190,13 +338,15 @@ func (p *Processor) processTransaction(tx *model.Transaction) (report, stored bo
 		return true, false, nil
 	}
 
-	traceSampled, err := p.storage.IsTraceSampled(tx.TraceID)
+	decision, err := p.storage.ReadTraceDecision(tx.TraceID)
 	switch err {
 	case nil:
 		// Tail-sampling decision has been made: report the transaction
 		// if it was sampled.
-		report := traceSampled
-		return report, false, nil
+		if decision.Sampled {
+			tx.RepresentativeCount = adjustRepresentativeCount(tx.RepresentativeCount, decision.SampleRate)
+		}
+		return decision.Sampled, false, nil
 	case eventstorage.ErrNotFound:
 		// Tail-sampling decision has not yet been made.
 		break
@@ -204,14 +354,265 @@ func (p *Processor) processTransaction(tx *model.Transaction) (report, stored bo
 		return false, false, err
 	}
 
-	if tx.ParentID != "" {
-		// Non-root transaction: write to local storage while we wait
-		// for a sampling decision.
+	if p.storageLimitReached() {
+		// The trace's events cannot be stored, so don't
+		// admit the root transaction to the reservoir.
+		return p.rejectStorageWrite()
+	}
+
+	if p.pending != nil {
+		// Sampling decisions are delayed: write to local storage, and
+		// record the transaction so the trace's sampling decision can
+		// be made once it is idle.
+		if err := p.updatePendingTrace(tx.TraceID, func(trace *pendingTrace) {
+			if tx.ParentID == "" {
+				trace.rootID = tx.ID
+			} else if p.config.LocalRootSampling && trace.rootID == "" && hasRemoteParent(tx) {
+				// The first local root for the trace is used for the
+				// sampling decision, unless the true root is received.
+				trace.rootID = tx.ID
+			}
+			if tx.Outcome == "failure" {
+				trace.hasError = true
+			}
+		}); err != nil {
+			return false, false, err
+		}
 		return false, true, p.storage.WriteTransaction(tx)
 	}
 
+	var localRoot bool
+	if tx.ParentID != "" {
+		if localRoot, err = p.isLocalRoot(tx); err != nil {
+			return false, false, err
+		}
+		if !localRoot {
+			// Non-root transaction: write to local storage while we wait
+			// for a sampling decision.
+			return false, true, p.storage.WriteTransaction(tx)
+		}
+	}
+
 	// Root transaction: apply reservoir sampling.
-	reservoirSampled, err := p.groups.sampleTrace(tx)
+	var stats traceStats
+	if p.trackTraceErrors {
+		if stats.hasError, err = p.storage.HasTraceError(tx.TraceID); err != nil {
+			return false, false, err
+		}
+	}
+	reservoirSampled, err := p.sampleTrace(tx, stats)
+	if err == errNoMatchingPolicy && tx.ParentID != "" {
+		// No policy matches the local root. Store it in case
+		// another local root for the trace matches a policy.
+		return false, true, p.storage.WriteTransaction(tx)
+	} else if err != nil {
+		return false, false, err
+	}
+	if !reservoirSampled {
+		return false, false, nil
+	}
+	if localRoot {
+		// Record the local root, so other local roots of the
+		// trace are subject to its sampling decision.
+		if err := p.storage.WriteTraceRoot(tx.TraceID); err != nil {
+			return false, false, err
+		}
+	}
+
+	// The root transaction was admitted to the sampling reservoir, so we
+	// can proceed to write the transaction to storage; we may index it later,
+	// after finalising the sampling decision.
+	return false, true, p.storage.WriteTransaction(tx)
+}
+
+// isLocalRoot reports whether tx, which has a parent, should be treated as
+// the root of its trace for sampling purposes. This is the case when local
+// root sampling is enabled, tx has a remote parent, and no other local root
+// of the trace has been admitted to a sampling reservoir.
+//
+// If another local root for the trace has been admitted to a sampling
+// reservoir, tx is stored until a sampling decision is made; if it was not
+// admitted, a negative sampling decision will already have been recorded.
+func (p *Processor) isLocalRoot(tx *model.Transaction) (bool, error) {
+	if !p.config.LocalRootSampling || !hasRemoteParent(tx) {
+		return false, nil
+	}
+	hasRoot, err := p.storage.HasTraceRoot(tx.TraceID)
+	if err != nil {
+		return false, err
+	}
+	return !hasRoot, nil
+}
+
+// hasRemoteParent reports whether tx is known to have a parent outside the
+// set of transactions and spans received for the trace.
+//
+// This is the case for transactions received via Jaeger with a parent, as
+// these are created only for server-kind spans, whose parent is a span in
+// the calling process. Transactions from Elastic APM agents may have a parent
+// in another instrumented service, whose events are typically received after
+// those of the child transaction, so they are never treated as local roots.
+func hasRemoteParent(tx *model.Transaction) bool {
+	if tx.ParentID == "" {
+		return false
+	}
+	agentName := tx.Metadata.Service.Agent.Name
+	return agentName == otel.AgentNameJaeger || strings.HasPrefix(agentName, otel.AgentNameJaeger+"/")
+}
+
+func (p *Processor) processSpan(span *model.Span) (report, stored bool, _ error) {
+	decision, err := p.storage.ReadTraceDecision(span.TraceID)
+	if err != nil {
+		if err == eventstorage.ErrNotFound {
+			if p.storageLimitReached() {
+				return p.rejectStorageWrite()
+			}
+			if p.pending != nil {
+				if err := p.updatePendingTrace(span.TraceID, func(trace *pendingTrace) {
+					trace.spanCount++
+					if span.Outcome == "failure" {
+						trace.hasError = true
+					}
+				}); err != nil {
+					return false, false, err
+				}
+			}
+			// Tail-sampling decision has not yet been made, write span to local storage.
+			return false, true, p.storage.WriteSpan(span)
+		}
+		return false, false, err
+	}
+	// Tail-sampling decision has been made, report or drop the event.
+	if !decision.Sampled {
+		return false, false, nil
+	}
+	span.RepresentativeCount = adjustRepresentativeCount(span.RepresentativeCount, decision.SampleRate)
+	return true, false, nil
+}
+
+// updatePendingTrace records an event for a trace without a sampling
+// decision, calling f to update the pending trace.
+//
+// If the trace is not pending, it is tracked again only if no decision
+// has been made for it since the caller checked. This prevents events
+// received while an idle trace's root is being sampled from creating a
+// pending trace without a root: such events are stored, and reported or
+// dropped along with the rest of the trace.
+func (p *Processor) updatePendingTrace(traceID string, f func(*pendingTrace)) error {
+	now := time.Now()
+	if p.pending.updateExisting(traceID, now, f) {
+		return nil
+	}
+	if _, err := p.storage.ReadTraceDecision(traceID); err != eventstorage.ErrNotFound {
+		return err
+	}
+	if hasRoot, err := p.storage.HasTraceRoot(traceID); err != nil || hasRoot {
+		return err
+	}
+	p.pending.update(traceID, now, f)
+	return nil
+}
+
+// adjustRepresentativeCount returns the representative count of an event
+// belonging to a trace that was tail-sampled at the given effective rate,
+// given the event's head-sampling representative count.
+//
+// If either the representative count or the sample rate is unknown (zero),
+// the representative count is returned unmodified.
+//
+// The adjusted representative count is recorded only on the events that
+// are reported, and stored, after tail-sampling. Aggregations processing
+// events before the tail sampler observe every event along with its
+// head-sampling representative count, and so do not need adjusting.
+func adjustRepresentativeCount(representativeCount, sampleRate float64) float64 {
+	if representativeCount <= 0 || sampleRate <= 0 {
+		return representativeCount
+	}
+	return representativeCount / sampleRate
+}
+
+func (p *Processor) processError(e *model.Error) error {
+	if e.TraceID == "" || (p.pending == nil && !p.trackTraceErrors) || p.storageLimitReached() {
+		return nil
+	}
+	if _, err := p.storage.IsTraceSampled(e.TraceID); err != eventstorage.ErrNotFound {
+		// Either the tail-sampling decision has already been made, or there
+		// was an error reading it; in either case the error is not recorded.
+		return err
+	}
+	if p.pending != nil {
+		return p.updatePendingTrace(e.TraceID, func(trace *pendingTrace) {
+			trace.hasError = true
+		})
+	}
+	return p.storage.WriteTraceError(e.TraceID)
+}
+
+// storageLimitReached reports whether the most recently measured disk
+// usage of local storage has reached the configured storage limit.
+func (p *Processor) storageLimitReached() bool {
+	limit := p.config.StorageLimit
+	return limit > 0 && atomic.LoadInt64(&p.storageMetrics.diskUsage) >= limit
+}
+
+// rejectStorageWrite records that a trace event could not be written to
+// local storage due to the storage limit, and returns whether the event
+// should be reported.
+func (p *Processor) rejectStorageWrite() (report, stored bool, _ error) {
+	atomic.AddInt64(&p.storageMetrics.rejectedWrites, 1)
+	return p.config.StorageLimitPassthrough, false, nil
+}
+
+// updateStorageDiskUsage measures the disk usage of local storage, logging
+// when the storage limit is reached, or when usage drops below the limit.
+func (p *Processor) updateStorageDiskUsage() error {
+	usage, err := diskUsage(p.config.StorageDir)
+	if err != nil {
+		return errors.Wrap(err, "failed to measure storage disk usage")
+	}
+	prev := atomic.SwapInt64(&p.storageMetrics.diskUsage, usage)
+	if limit := p.config.StorageLimit; limit > 0 {
+		if usage >= limit && prev < limit {
+			action := "dropping"
+			if p.config.StorageLimitPassthrough {
+				action = "reporting"
+			}
+			p.logger.Warnf(
+				"tail-sampling storage limit reached (%d >= %d bytes), %s trace events without storing them",
+				usage, limit, action,
+			)
+		} else if usage < limit && prev >= limit {
+			p.logger.Infof("tail-sampling storage usage below limit (%d < %d bytes), resuming storage of trace events", usage, limit)
+		}
+	}
+	return nil
+}
+
+// diskUsage returns the total size of the files in dir and its subdirectories.
+func diskUsage(dir string) (int64, error) {
+	var size int64
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if os.IsNotExist(err) {
+				// Files may be removed concurrently, e.g. by
+				// value log garbage collection.
+				return nil
+			}
+			return err
+		}
+		if info.Mode().IsRegular() {
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
+
+// sampleTrace applies reservoir sampling to a root transaction, returning
+// true if it was admitted to the reservoir. If the root transaction is not
+// admitted, its trace is recorded as unsampled in local storage.
+func (p *Processor) sampleTrace(tx *model.Transaction, stats traceStats) (bool, error) {
+	policy, reservoirSampled, err := p.groups.sampleTracePolicy(tx, stats)
 	if err == errTooManyTraceGroups {
 		// Too many trace groups, drop the transaction.
 		p.tooManyGroupsLogger.Warn(`
@@ -219,9 +620,20 @@ Tail-sampling service group limit reached, discarding trace events.
 This is caused by having many unique service names while relying on
 sampling policies without service name specified.
 `[1:])
-		return false, false, nil
+		return false, nil
 	} else if err != nil {
-		return false, false, err
+		return false, err
+	}
+
+	if p.config.ExplainPolicies {
+		// Record the matched policy, so the sampling decision can be explained.
+		if err := p.storage.WriteTraceMatch(tx.TraceID, eventstorage.TraceMatch{
+			ServiceName: tx.Metadata.Service.Name,
+			Policy:      policy,
+			Admitted:    reservoirSampled,
+		}); err != nil {
+			return false, err
+		}
 	}
 
 	if !reservoirSampled {
@@ -231,29 +643,56 @@ sampling policies without service name specified.
 		// This is a local optimisation only. To avoid creating network
 		// traffic and load on Elasticsearch for uninteresting root
 		// transactions, we do not propagate this to other APM Servers.
-		return false, false, p.storage.WriteTraceSampled(tx.TraceID, false)
+		return false, p.storage.WriteTraceDecision(tx.TraceID, eventstorage.TraceDecision{
+			Source: eventstorage.DecisionSourceLocal,
+		})
 	}
+	return true, nil
+}
 
-	// The root transaction was admitted to the sampling reservoir, so we
-	// can proceed to write the transaction to storage; we may index it later,
-	// after finalising the sampling decision.
-	return false, true, p.storage.WriteTransaction(tx)
+// sampleIdleTraces applies reservoir sampling to the root transactions of
+// traces which have been idle for at least the configured decision delay.
+//
+// The root transactions are read back from local storage. Once a trace's
+// root has been sampled, either a negative decision or the admission of
+// the root to a reservoir is recorded in local storage before the trace
+// is removed from the pending traces, so events received in the meantime
+// do not cause the trace to be tracked again.
+func (p *Processor) sampleIdleTraces(now time.Time) error {
+	traces := p.pending.takeIdle(now.Add(-p.config.DecisionDelay), nil)
+	for _, trace := range traces {
+		if err := p.sampleIdleTrace(trace); err != nil {
+			return err
+		}
+		p.pending.remove(trace.traceID)
+	}
+	return nil
 }
 
-func (p *Processor) processSpan(span *model.Span) (report, stored bool, _ error) {
-	traceSampled, err := p.storage.IsTraceSampled(span.TraceID)
-	if err != nil {
+func (p *Processor) sampleIdleTrace(trace pendingTrace) error {
+	var root model.Transaction
+	if err := p.storage.ReadTransaction(trace.traceID, trace.rootID, &root); err != nil {
 		if err == eventstorage.ErrNotFound {
-			// Tail-sampling decision has not yet been made, write span to local storage.
-			return false, true, p.storage.WriteSpan(span)
+			// The root transaction has expired from local storage,
+			// along with the rest of the trace.
+			return nil
 		}
-		return false, false, err
+		return err
 	}
-	// Tail-sampling decision has been made, report or drop the event.
-	if !traceSampled {
-		return false, false, nil
+	admitted, err := p.sampleTrace(&root, trace.traceStats)
+	if err == errNoMatchingPolicy {
+		// The root transaction was accepted long ago, so there is
+		// no request to fail; record the trace as unsampled instead.
+		return p.storage.WriteTraceDecision(trace.traceID, eventstorage.TraceDecision{
+			Source: eventstorage.DecisionSourceLocal,
+		})
+	} else if err != nil {
+		return err
 	}
-	return true, false, nil
+	if admitted {
+		return p.storage.WriteTraceRoot(trace.traceID)
+	}
+	return nil
 }
 
 // Stop stops the processor, flushing and closing the event storage.
@@ -287,6 +726,9 @@ func (p *Processor) Stop(ctx context.Context) error {
 	if err := p.storage.Flush(); err != nil {
 		return err
 	}
+	if err := p.writeSamplingState(); err != nil {
+		return err
+	}
 	p.storage.Close()
 	if err := p.db.Close(); err != nil {
 		return err
@@ -295,9 +737,54 @@ func (p *Processor) Stop(ctx context.Context) error {
 	return nil
 }
 
+// sampledTracesPubsub provides a means of publishing and subscribing
+// to sampled traces, for exchanging sampling decisions with other
+// APM Servers.
+type sampledTracesPubsub interface {
+	PublishSampledTraces(ctx context.Context, traces ...pubsub.SampledTrace) error
+	SubscribeSampledTraces(ctx context.Context, traces chan<- pubsub.SampledTrace) error
+}
+
+// newPubsub returns a sampledTracesPubsub which exchanges sampled
+// traces directly with peers if any are configured, and otherwise
+// through Elasticsearch.
+func (p *Processor) newPubsub(bulkIndexerFlushInterval time.Duration) (sampledTracesPubsub, error) {
+	if len(p.config.Peers) > 0 {
+		return peer.New(peer.Config{
+			BeatID:     p.config.BeatID,
+			ListenAddr: p.config.PeerListenAddr,
+			Peers:      p.config.Peers,
+			Logger:     p.logger,
+
+			SecretToken: p.config.PeerSecretToken,
+			TLS:         p.config.PeerTLS,
+			ClientTLS:   p.config.PeerClientTLS,
+
+			// Peers should receive sampled trace IDs no later
+			// than they would through Elasticsearch.
+			PublishTimeout: bulkIndexerFlushInterval,
+		})
+	}
+	return pubsub.New(pubsub.Config{
+		BeatID: p.config.BeatID,
+		Client: p.config.Elasticsearch,
+		Index:  p.config.SampledTracesIndex,
+		Logger: p.logger,
+
+		// Issue pubsub subscriber search requests at twice the frequency
+		// of publishing, so each server observes each other's sampled
+		// trace IDs soon after they are published.
+		SearchInterval: p.config.FlushInterval / 2,
+		FlushInterval:  bulkIndexerFlushInterval,
+	})
+}
+
 // Run runs the tail-sampling processor. This method is responsible for:
 //
 //  - periodically making, and then publishing, local sampling decisions
+//  - making local sampling decisions for idle traces, if decisions are delayed
+//  - periodically measuring the disk usage of local storage
+//  - periodically recording the sampling state in local storage
 //  - subscribing to remote sampling decisions
 //  - reacting to both local and remote sampling decisions by reading
 //    related events from local storage, and then reporting them
@@ -325,24 +812,13 @@ func (p *Processor) Run() error {
 		bulkIndexerFlushInterval = p.config.FlushInterval
 	}
 
-	pubsub, err := pubsub.New(pubsub.Config{
-		BeatID: p.config.BeatID,
-		Client: p.config.Elasticsearch,
-		Index:  p.config.SampledTracesIndex,
-		Logger: p.logger,
-
-		// Issue pubsub subscriber search requests at twice the frequency
-		// of publishing, so each server observes each other's sampled
-		// trace IDs soon after they are published.
-		SearchInterval: p.config.FlushInterval / 2,
-		FlushInterval:  bulkIndexerFlushInterval,
-	})
+	sampledTraces, err := p.newPubsub(bulkIndexerFlushInterval)
 	if err != nil {
 		return err
 	}
 
-	remoteSampledTraceIDs := make(chan string)
-	localSampledTraceIDs := make(chan string)
+	remoteSampledTraces := make(chan pubsub.SampledTrace)
+	localSampledTraces := make(chan pubsub.SampledTrace)
 	errgroup, ctx := errgroup.WithContext(context.Background())
 	errgroup.Go(func() error {
 		select {
@@ -371,33 +847,81 @@ func (p *Processor) Run() error {
 		}
 	})
 	errgroup.Go(func() error {
-		return pubsub.SubscribeSampledTraceIDs(ctx, remoteSampledTraceIDs)
+		// This goroutine is responsible for periodically measuring the
+		// disk usage of local storage, for enforcing the storage limit.
+		ticker := time.NewTicker(storageDiskUsageInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-ticker.C:
+				if err := p.updateStorageDiskUsage(); err != nil {
+					p.logger.With(logp.Error(err)).Warn("failed to update storage disk usage")
+				}
+			}
+		}
+	})
+	errgroup.Go(func() error {
+		return sampledTraces.SubscribeSampledTraces(ctx, remoteSampledTraces)
 	})
+	if p.pending != nil {
+		errgroup.Go(func() error {
+			// This goroutine is responsible for making sampling decisions
+			// for traces once they are idle. Traces are checked at twice
+			// the frequency of the decision delay, so decisions are made
+			// at most 1.5x the decision delay after a trace's last event.
+			ticker := time.NewTicker(p.config.DecisionDelay / 2)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case now := <-ticker.C:
+					if err := p.sampleIdleTraces(now); err != nil {
+						return err
+					}
+				}
+			}
+		})
+	}
 	errgroup.Go(func() error {
+		// This goroutine is responsible for periodically finalizing
+		// the local sampling reservoirs, and for recording the sampling
+		// state. The state is recorded immediately after finalizing, so
+		// that finalized traces are not restored after a restart.
 		ticker := time.NewTicker(p.config.FlushInterval)
 		defer ticker.Stop()
-		var traceIDs []string
+		stateTicker := time.NewTicker(samplingStateInterval)
+		defer stateTicker.Stop()
+		var traces []pubsub.SampledTrace
 		for {
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
+			case <-stateTicker.C:
+				if err := p.writeSamplingState(); err != nil {
+					p.logger.With(logp.Error(err)).Warn("failed to record tail-sampling state")
+				}
 			case <-ticker.C:
 				p.logger.Debug("finalizing local sampling reservoirs")
-				traceIDs = p.groups.finalizeSampledTraces(traceIDs)
-				if len(traceIDs) == 0 {
-					continue
+				traces = p.groups.finalizeSampledTraces(traces)
+				if err := p.writeSamplingState(); err != nil {
+					p.logger.With(logp.Error(err)).Warn("failed to record tail-sampling state")
 				}
-				if err := pubsub.PublishSampledTraceIDs(ctx, traceIDs...); err != nil {
+				// Publish even if there are no new sampled traces, so
+				// that previously undelivered traces may be retried.
+				if err := sampledTraces.PublishSampledTraces(ctx, traces...); err != nil {
 					return err
 				}
-				for _, traceID := range traceIDs {
+				for _, trace := range traces {
 					select {
 					case <-ctx.Done():
 						return ctx.Err()
-					case localSampledTraceIDs <- traceID:
+					case localSampledTraces <- trace:
 					}
 				}
-				traceIDs = traceIDs[:0]
+				traces = traces[:0]
 			}
 		}
 	})
@@ -409,21 +933,44 @@ func (p *Processor) Run() error {
 		var events model.Batch
 		for {
 			var remoteDecision bool
-			var traceID string
+			var trace pubsub.SampledTrace
 			select {
 			case <-ctx.Done():
 				return ctx.Err()
-			case traceID = <-remoteSampledTraceIDs:
+			case trace = <-remoteSampledTraces:
 				p.logger.Debug("received remotely sampled trace ID")
 				remoteDecision = true
-			case traceID = <-localSampledTraceIDs:
+			case trace = <-localSampledTraces:
+			}
+			// With local root sampling, multiple transactions of a trace
+			// may be sampled, locally and by other servers. Only the first
+			// decision is acted upon, so events are reported at most once.
+			decision, err := p.storage.ReadTraceDecision(trace.TraceID)
+			if err == nil && decision.Sampled {
+				continue
+			} else if err != nil && err != eventstorage.ErrNotFound {
+				return err
 			}
-			if err := p.storage.WriteTraceSampled(traceID, true); err != nil {
+			source := eventstorage.DecisionSourceLocal
+			if remoteDecision {
+				source = eventstorage.DecisionSourceRemote
+			}
+			if err := p.storage.WriteTraceDecision(trace.TraceID, eventstorage.TraceDecision{
+				Sampled:    true,
+				Source:     source,
+				SampleRate: trace.SampleRate,
+			}); err != nil {
 				return err
 			}
-			if err := p.storage.ReadEvents(traceID, &events); err != nil {
+			if err := p.storage.ReadEvents(trace.TraceID, &events); err != nil {
 				return err
 			}
+			for _, tx := range events.Transactions {
+				tx.RepresentativeCount = adjustRepresentativeCount(tx.RepresentativeCount, trace.SampleRate)
+			}
+			for _, span := range events.Spans {
+				span.RepresentativeCount = adjustRepresentativeCount(span.RepresentativeCount, trace.SampleRate)
+			}
 			transformables := events.Transformables()
 			if len(transformables) > 0 {
 				p.logger.Debugf("reporting %d events", len(transformables))
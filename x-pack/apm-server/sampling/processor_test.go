@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"io/ioutil"
+	"net"
 	"os"
 	"path"
 	"sort"
@@ -25,6 +26,8 @@ import (
 	"github.com/elastic/apm-server/transform"
 	"github.com/elastic/apm-server/x-pack/apm-server/sampling"
 	"github.com/elastic/apm-server/x-pack/apm-server/sampling/eventstorage"
+	"github.com/elastic/apm-server/x-pack/apm-server/sampling/pubsub"
+	"github.com/elastic/apm-server/x-pack/apm-server/sampling/pubsub/peer"
 	"github.com/elastic/apm-server/x-pack/apm-server/sampling/pubsub/pubsubtest"
 	"github.com/elastic/beats/v7/libbeat/monitoring"
 )
@@ -56,13 +59,13 @@ func TestProcessAlreadyTailSampled(t *testing.T) {
 	traceID1 := "0102030405060708090a0b0c0d0e0f10"
 	traceID2 := "0102030405060708090a0b0c0d0e0f11"
 	withBadger(t, config.StorageDir, func(db *badger.DB) {
-		storage := eventstorage.New(db, eventstorage.JSONCodec{}, time.Minute)
+		storage := eventstorage.New(db, eventstorage.BinaryCodec{}, time.Minute)
 		writer := storage.NewReadWriter()
 		defer writer.Close()
 		assert.NoError(t, writer.WriteTraceSampled(traceID1, true))
 		assert.NoError(t, writer.Flush())
 
-		storage = eventstorage.New(db, eventstorage.JSONCodec{}, -1) // expire immediately
+		storage = eventstorage.New(db, eventstorage.BinaryCodec{}, -1) // expire immediately
 		writer = storage.NewReadWriter()
 		defer writer.Close()
 		assert.NoError(t, writer.WriteTraceSampled(traceID2, true))
@@ -109,7 +112,7 @@ func TestProcessAlreadyTailSampled(t *testing.T) {
 	// Stop the processor so we can access the database.
 	assert.NoError(t, processor.Stop(context.Background()))
 	withBadger(t, config.StorageDir, func(db *badger.DB) {
-		storage := eventstorage.New(db, eventstorage.JSONCodec{}, time.Minute)
+		storage := eventstorage.New(db, eventstorage.BinaryCodec{}, time.Minute)
 		reader := storage.NewReadWriter()
 		defer reader.Close()
 
@@ -210,7 +213,7 @@ func TestProcessLocalTailSampling(t *testing.T) {
 	// Stop the processor so we can access the database.
 	assert.NoError(t, processor.Stop(context.Background()))
 	withBadger(t, config.StorageDir, func(db *badger.DB) {
-		storage := eventstorage.New(db, eventstorage.JSONCodec{}, time.Minute)
+		storage := eventstorage.New(db, eventstorage.BinaryCodec{}, time.Minute)
 		reader := storage.NewReadWriter()
 		defer reader.Close()
 
@@ -263,7 +266,7 @@ func TestProcessLocalTailSamplingUnsampled(t *testing.T) {
 	// Stop the processor so we can access the database.
 	assert.NoError(t, processor.Stop(context.Background()))
 	withBadger(t, config.StorageDir, func(db *badger.DB) {
-		storage := eventstorage.New(db, eventstorage.JSONCodec{}, time.Minute)
+		storage := eventstorage.New(db, eventstorage.BinaryCodec{}, time.Minute)
 		reader := storage.NewReadWriter()
 		defer reader.Close()
 
@@ -283,6 +286,228 @@ func TestProcessLocalTailSamplingUnsampled(t *testing.T) {
 	})
 }
 
+func TestProcessLocalTailSamplingTraceError(t *testing.T) {
+	traceError := true
+	config := newTempdirConfig(t)
+	config.Policies = []sampling.Policy{
+		{SampleRate: 1, PolicyCriteria: sampling.PolicyCriteria{TraceError: &traceError}},
+		{SampleRate: 0},
+	}
+	config.FlushInterval = 10 * time.Millisecond
+	published := make(chan string)
+	config.Elasticsearch = pubsubtest.Client(pubsubtest.PublisherChan(published), nil)
+
+	processor, err := sampling.NewProcessor(config)
+	require.NoError(t, err)
+
+	traceID1 := "0102030405060708090a0b0c0d0e0f10"
+	traceID2 := "0102030405060708090a0b0c0d0e0f11"
+	errorID := "0102030405060709"
+	errorEvent := &model.Error{TraceID: traceID1, ID: &errorID}
+	out, err := processor.ProcessTransformables(context.Background(), []transform.Transformable{errorEvent})
+	require.NoError(t, err)
+	assert.Equal(t, []transform.Transformable{errorEvent}, out)
+
+	out, err = processor.ProcessTransformables(context.Background(), []transform.Transformable{
+		&model.Transaction{TraceID: traceID1, ID: "0102030405060708", Duration: 123},
+		&model.Transaction{TraceID: traceID2, ID: "0102030405060710", Duration: 456},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	go processor.Run()
+	defer processor.Stop(context.Background())
+
+	// Only the trace containing an error should be sampled.
+	select {
+	case sampledTraceID := <-published:
+		assert.Equal(t, traceID1, sampledTraceID)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for publication")
+	}
+	select {
+	case traceID := <-published:
+		t.Fatalf("unexpected publication of %s", traceID)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// Errors are not counted as processed by the tail-sampling processor.
+	// The root transaction of the trace without errors matches a policy
+	// with a sample rate of zero, so it is dropped immediately.
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["sampling.events.processed"] = 2
+	expectedMonitoring.Ints["sampling.events.stored"] = 1
+	expectedMonitoring.Ints["sampling.events.dropped"] = 1
+	assertMonitoring(t, processor, expectedMonitoring, `sampling.events.*`)
+}
+
+func TestProcessLocalTailSamplingDecisionDelay(t *testing.T) {
+	config := newTempdirConfig(t)
+	config.Policies = []sampling.Policy{
+		{SampleRate: 1, PolicyCriteria: sampling.PolicyCriteria{TraceSpanCountMin: 2}},
+		{SampleRate: 0},
+	}
+	config.FlushInterval = 10 * time.Millisecond
+	config.DecisionDelay = time.Second
+	published := make(chan string)
+	config.Elasticsearch = pubsubtest.Client(pubsubtest.PublisherChan(published), nil)
+
+	processor, err := sampling.NewProcessor(config)
+	require.NoError(t, err)
+	go processor.Run()
+	defer processor.Stop(context.Background())
+
+	traceID1 := "0102030405060708090a0b0c0d0e0f10"
+	traceID2 := "0102030405060708090a0b0c0d0e0f11"
+
+	// Root transactions are received before their spans, so the
+	// number of spans is unknown when the root transaction arrives.
+	out, err := processor.ProcessTransformables(context.Background(), []transform.Transformable{
+		&model.Transaction{TraceID: traceID1, ID: "0102030405060708", Duration: 123},
+		&model.Transaction{TraceID: traceID2, ID: "0102030405060710", Duration: 456},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+	out, err = processor.ProcessTransformables(context.Background(), []transform.Transformable{
+		&model.Span{TraceID: traceID1, ID: "0102030405060709"},
+		&model.Span{TraceID: traceID1, ID: "010203040506070a"},
+		&model.Span{TraceID: traceID2, ID: "0102030405060711"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["sampling.pending_traces"] = 2
+	expectedMonitoring.Ints["sampling.events.processed"] = 5
+	expectedMonitoring.Ints["sampling.events.stored"] = 5
+	expectedMonitoring.Ints["sampling.events.dropped"] = 0
+	assertMonitoring(t, processor, expectedMonitoring, `sampling.pending_traces`, `sampling.events.*`)
+
+	// Only the trace with at least two spans should be sampled,
+	// once it has been idle for the decision delay.
+	select {
+	case sampledTraceID := <-published:
+		assert.Equal(t, traceID1, sampledTraceID)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for publication")
+	}
+	select {
+	case traceID := <-published:
+		t.Fatalf("unexpected publication of %s", traceID)
+	case <-time.After(200 * time.Millisecond):
+	}
+	expectedMonitoring.Ints["sampling.pending_traces"] = 0
+	assertMonitoring(t, processor, expectedMonitoring, `sampling.pending_traces`, `sampling.events.*`)
+
+	// Stop the processor so we can access the database.
+	assert.NoError(t, processor.Stop(context.Background()))
+	withBadger(t, config.StorageDir, func(db *badger.DB) {
+		storage := eventstorage.New(db, eventstorage.BinaryCodec{}, time.Minute)
+		reader := storage.NewReadWriter()
+		defer reader.Close()
+
+		sampled, err := reader.IsTraceSampled(traceID1)
+		assert.NoError(t, err)
+		assert.True(t, sampled)
+
+		sampled, err = reader.IsTraceSampled(traceID2)
+		assert.NoError(t, err)
+		assert.False(t, sampled)
+	})
+}
+
+func TestProcessLocalRootSampling(t *testing.T) {
+	for _, localRootSampling := range []bool{false, true} {
+		t.Run(fmt.Sprint(localRootSampling), func(t *testing.T) {
+			config := newTempdirConfig(t)
+			config.Policies = []sampling.Policy{{SampleRate: 1}}
+			config.FlushInterval = 10 * time.Millisecond
+			config.LocalRootSampling = localRootSampling
+			published := make(chan string, 2)
+			config.Elasticsearch = pubsubtest.Client(pubsubtest.PublisherChan(published), nil)
+			reported := make(chan []transform.Transformable, 2)
+			config.Reporter = func(ctx context.Context, req publish.PendingReq) error {
+				reported <- req.Transformables
+				return nil
+			}
+
+			processor, err := sampling.NewProcessor(config)
+			require.NoError(t, err)
+			go processor.Run()
+			defer processor.Stop(context.Background())
+
+			// The trace's root lives in a non-instrumented system, so each
+			// Jaeger transaction has a remote parent. There are multiple local
+			// roots, but only one sampling decision is made for the trace.
+			jaegerMetadata := func(serviceName string) model.Metadata {
+				return model.Metadata{Service: model.Service{
+					Name:  serviceName,
+					Agent: model.Agent{Name: "Jaeger/Go"},
+				}}
+			}
+			traceID := "0102030405060708090a0b0c0d0e0f10"
+			in := []transform.Transformable{
+				&model.Transaction{
+					Metadata: jaegerMetadata("service_a"),
+					TraceID:  traceID, ID: "0000000000000001", ParentID: "0000000000000000",
+				},
+				&model.Transaction{
+					Metadata: jaegerMetadata("service_b"),
+					TraceID:  traceID, ID: "0000000000000002", ParentID: "0000000000000000",
+				},
+				&model.Span{TraceID: traceID, ID: "0000000000000003", ParentID: "0000000000000001"},
+			}
+			out, err := processor.ProcessTransformables(context.Background(), in)
+			require.NoError(t, err)
+			assert.Empty(t, out)
+
+			// Transactions from Elastic APM agents with a parent are never
+			// local roots, as their parent may be received later; they are
+			// stored until a decision is made for the trace.
+			traceID2 := "0102030405060708090a0b0c0d0e0f11"
+			out, err = processor.ProcessTransformables(context.Background(), []transform.Transformable{
+				&model.Transaction{
+					Metadata: model.Metadata{Service: model.Service{
+						Name:  "service_c",
+						Agent: model.Agent{Name: "go"},
+					}},
+					TraceID: traceID2, ID: "0000000000000004", ParentID: "0000000000000005",
+				},
+			})
+			require.NoError(t, err)
+			assert.Empty(t, out)
+
+			if !localRootSampling {
+				select {
+				case traceID := <-published:
+					t.Fatalf("unexpected publication of %s", traceID)
+				case <-time.After(100 * time.Millisecond):
+				}
+				return
+			}
+			select {
+			case sampledTraceID := <-published:
+				assert.Equal(t, traceID, sampledTraceID)
+			case <-time.After(10 * time.Second):
+				t.Fatal("timed out waiting for publication")
+			}
+			select {
+			case events := <-reported:
+				assert.ElementsMatch(t, in, events)
+			case <-time.After(10 * time.Second):
+				t.Fatal("timed out waiting for reporting")
+			}
+			select {
+			case <-published:
+				t.Fatal("unexpected publication")
+			case <-reported:
+				t.Fatal("unexpected reporting")
+			case <-time.After(100 * time.Millisecond):
+			}
+		})
+	}
+}
+
 func TestProcessRemoteTailSampling(t *testing.T) {
 	config := newTempdirConfig(t)
 	config.Policies = []sampling.Policy{{SampleRate: 0.5}}
@@ -359,7 +584,7 @@ func TestProcessRemoteTailSampling(t *testing.T) {
 	assert.Equal(t, trace1Events.Transformables(), events)
 
 	withBadger(t, config.StorageDir, func(db *badger.DB) {
-		storage := eventstorage.New(db, eventstorage.JSONCodec{}, time.Minute)
+		storage := eventstorage.New(db, eventstorage.BinaryCodec{}, time.Minute)
 		reader := storage.NewReadWriter()
 		defer reader.Close()
 
@@ -383,6 +608,531 @@ func TestProcessRemoteTailSampling(t *testing.T) {
 	})
 }
 
+func TestProcessRemoteTailSamplingPeers(t *testing.T) {
+	// Run two processors which exchange sampled trace IDs directly.
+	listenAddrs := []string{freeAddr(t), freeAddr(t)}
+	processors := make([]*sampling.Processor, len(listenAddrs))
+	reported := make([]chan []transform.Transformable, len(listenAddrs))
+	for i, listenAddr := range listenAddrs {
+		config := newTempdirConfig(t)
+		config.BeatID = fmt.Sprintf("apm-server-%d", i)
+		config.Policies = []sampling.Policy{{SampleRate: 1}}
+		config.FlushInterval = 10 * time.Millisecond
+		config.Elasticsearch = nil
+		config.Peers = listenAddrs
+		config.PeerListenAddr = listenAddr
+
+		reported[i] = make(chan []transform.Transformable, 1)
+		reportedChan := reported[i]
+		config.Reporter = func(ctx context.Context, req publish.PendingReq) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case reportedChan <- req.Transformables:
+				return nil
+			}
+		}
+
+		processor, err := sampling.NewProcessor(config)
+		require.NoError(t, err)
+		go processor.Run()
+		defer processor.Stop(context.Background())
+		processors[i] = processor
+	}
+
+	traceID := "0102030405060708090a0b0c0d0e0f10"
+	span := &model.Span{TraceID: traceID, ID: "0102030405060709", ParentID: "0102030405060708"}
+	transaction := &model.Transaction{TraceID: traceID, ID: "0102030405060708"}
+
+	// The span is received by the second server, and the root
+	// transaction (and so the sampling decision) by the first.
+	out, err := processors[1].ProcessTransformables(context.Background(), []transform.Transformable{span})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+	out, err = processors[0].ProcessTransformables(context.Background(), []transform.Transformable{transaction})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	for i, expected := range [][]transform.Transformable{{transaction}, {span}} {
+		select {
+		case events := <-reported[i]:
+			assert.Equal(t, expected, events)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for processor %d to report events", i)
+		}
+	}
+}
+
+func TestProcessRemoteTailSamplingLocalRoots(t *testing.T) {
+	// Run two processors sharing sampled trace IDs, each of which receives
+	// a local root of the same trace and samples it locally. Each processor
+	// also receives the other's decision, and must not report events twice.
+	subscriberChans := []chan string{make(chan string, 10), make(chan string, 10)}
+	processors := make([]*sampling.Processor, len(subscriberChans))
+	reported := make([]chan []transform.Transformable, len(subscriberChans))
+	for i := range processors {
+		otherSubscriberChan := subscriberChans[(i+1)%len(subscriberChans)]
+		var publisher pubsubtest.PublisherFunc = func(ctx context.Context, traceID string) error {
+			otherSubscriberChan <- traceID
+			return nil
+		}
+
+		config := newTempdirConfig(t)
+		config.BeatID = fmt.Sprintf("apm-server-%d", i)
+		config.Policies = []sampling.Policy{{SampleRate: 1}}
+		config.FlushInterval = 10 * time.Millisecond
+		config.LocalRootSampling = true
+		config.Elasticsearch = pubsubtest.Client(publisher, pubsubtest.SubscriberChan(subscriberChans[i]))
+
+		reported[i] = make(chan []transform.Transformable, 10)
+		reportedChan := reported[i]
+		config.Reporter = func(ctx context.Context, req publish.PendingReq) error {
+			reportedChan <- req.Transformables
+			return nil
+		}
+
+		processor, err := sampling.NewProcessor(config)
+		require.NoError(t, err)
+		go processor.Run()
+		defer processor.Stop(context.Background())
+		processors[i] = processor
+	}
+
+	traceID := "0102030405060708090a0b0c0d0e0f10"
+	jaegerMetadata := func(serviceName string) model.Metadata {
+		return model.Metadata{Service: model.Service{
+			Name:  serviceName,
+			Agent: model.Agent{Name: "Jaeger/Go"},
+		}}
+	}
+	in := [][]transform.Transformable{{
+		&model.Transaction{
+			Metadata: jaegerMetadata("service_a"),
+			TraceID:  traceID, ID: "0000000000000001", ParentID: "0000000000000000",
+		},
+		&model.Span{TraceID: traceID, ID: "0000000000000003", ParentID: "0000000000000001"},
+	}, {
+		&model.Transaction{
+			Metadata: jaegerMetadata("service_b"),
+			TraceID:  traceID, ID: "0000000000000002", ParentID: "0000000000000000",
+		},
+	}}
+	for i, processor := range processors {
+		out, err := processor.ProcessTransformables(context.Background(), in[i])
+		require.NoError(t, err)
+		assert.Empty(t, out)
+	}
+
+	for i := range processors {
+		var events []transform.Transformable
+		timeout := time.After(10 * time.Second)
+		for len(events) < len(in[i]) {
+			select {
+			case reportedEvents := <-reported[i]:
+				events = append(events, reportedEvents...)
+			case <-timeout:
+				t.Fatalf("timed out waiting for processor %d to report events", i)
+			}
+		}
+		assert.ElementsMatch(t, in[i], events)
+	}
+
+	// Wait for both processors to receive each other's decisions,
+	// which must not cause events to be reported again.
+	time.Sleep(100 * time.Millisecond)
+	for i := range processors {
+		select {
+		case events := <-reported[i]:
+			t.Fatalf("processor %d reported events more than once: %v", i, events)
+		default:
+		}
+	}
+}
+
+func TestProcessTailSamplingSampleRate(t *testing.T) {
+	config := newTempdirConfig(t)
+	config.Policies = []sampling.Policy{{SampleRate: 0.5}}
+	config.FlushInterval = 10 * time.Millisecond
+	published := make(chan string)
+	config.Elasticsearch = pubsubtest.Client(pubsubtest.PublisherChan(published), nil)
+
+	reported := make(chan []transform.Transformable)
+	config.Reporter = func(ctx context.Context, req publish.PendingReq) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case reported <- req.Transformables:
+			return nil
+		}
+	}
+
+	processor, err := sampling.NewProcessor(config)
+	require.NoError(t, err)
+
+	// Each transaction and span is head-sampled at a rate of 0.5.
+	traceIDs := []string{"0102030405060708090a0b0c0d0e0f10", "0102030405060708090a0b0c0d0e0f11"}
+	var in []transform.Transformable
+	for i, traceID := range traceIDs {
+		in = append(in,
+			&model.Transaction{TraceID: traceID, ID: fmt.Sprintf("%016x", 2*i), RepresentativeCount: 2},
+			&model.Span{TraceID: traceID, ID: fmt.Sprintf("%016x", 2*i+1), RepresentativeCount: 2},
+		)
+	}
+	out, err := processor.ProcessTransformables(context.Background(), in)
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	go processor.Run()
+	defer processor.Stop(context.Background())
+
+	var sampledTraceID string
+	select {
+	case sampledTraceID = <-published:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for publication")
+	}
+
+	// One of the two traces is tail-sampled, so the effective tail sample
+	// rate is 0.5, and the representative count of each reported event is
+	// doubled: each represents 4 events.
+	select {
+	case events := <-reported:
+		require.Len(t, events, 2)
+		for _, event := range events {
+			switch event := event.(type) {
+			case *model.Transaction:
+				assert.Equal(t, sampledTraceID, event.TraceID)
+				assert.Equal(t, 4.0, event.RepresentativeCount)
+			case *model.Span:
+				assert.Equal(t, sampledTraceID, event.TraceID)
+				assert.Equal(t, 4.0, event.RepresentativeCount)
+			}
+		}
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for reporting")
+	}
+
+	// Events received after the sampling decision is made are
+	// reported immediately, with the same adjustment.
+	span := &model.Span{TraceID: sampledTraceID, ID: "0102030405060708", RepresentativeCount: 1}
+	out, err = processor.ProcessTransformables(context.Background(), []transform.Transformable{span})
+	require.NoError(t, err)
+	assert.Equal(t, []transform.Transformable{span}, out)
+	assert.Equal(t, 2.0, span.RepresentativeCount)
+
+	explanation, err := processor.ExplainTrace(sampledTraceID)
+	require.NoError(t, err)
+	require.NotNil(t, explanation.Decision)
+	assert.Equal(t, 0.5, explanation.Decision.SampleRate)
+}
+
+func TestProcessRemoteTailSamplingSampleRate(t *testing.T) {
+	listenAddr := freeAddr(t)
+	config := newTempdirConfig(t)
+	config.Elasticsearch = nil
+	config.Peers = []string{listenAddr}
+	config.PeerListenAddr = listenAddr
+
+	reported := make(chan []transform.Transformable, 1)
+	config.Reporter = func(ctx context.Context, req publish.PendingReq) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case reported <- req.Transformables:
+			return nil
+		}
+	}
+
+	processor, err := sampling.NewProcessor(config)
+	require.NoError(t, err)
+	go processor.Run()
+	defer processor.Stop(context.Background())
+
+	traceID := "0102030405060708090a0b0c0d0e0f10"
+	span := &model.Span{TraceID: traceID, ID: "0102030405060709", ParentID: "0102030405060708", RepresentativeCount: 1}
+	out, err := processor.ProcessTransformables(context.Background(), []transform.Transformable{span})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	// Simulate another server publishing a sampling decision for the trace,
+	// which was sampled at an effective rate of 0.25.
+	remote, err := peer.New(peer.Config{
+		BeatID:         "remote-apm-server",
+		ListenAddr:     "localhost:0",
+		Peers:          []string{listenAddr},
+		PublishTimeout: 10 * time.Second,
+	})
+	require.NoError(t, err)
+
+	// The processor starts listening for peers when it runs, so keep
+	// publishing until the events are reported. Events are deleted
+	// once reported, so they are reported only once.
+	timeout := time.After(10 * time.Second)
+	for {
+		require.NoError(t, remote.PublishSampledTraces(context.Background(), pubsub.SampledTrace{
+			TraceID:    traceID,
+			SampleRate: 0.25,
+		}))
+		select {
+		case events := <-reported:
+			require.Len(t, events, 1)
+			assert.Equal(t, 4.0, events[0].(*model.Span).RepresentativeCount)
+			return
+		case <-time.After(100 * time.Millisecond):
+		case <-timeout:
+			t.Fatal("timed out waiting for reporting")
+		}
+	}
+}
+
+func TestProcessRestoreSamplingState(t *testing.T) {
+	config := newTempdirConfig(t)
+	config.Policies = []sampling.Policy{{SampleRate: 0.5}}
+	config.FlushInterval = time.Minute
+
+	processor, err := sampling.NewProcessor(config)
+	require.NoError(t, err)
+	go processor.Run()
+
+	traceIDs := []string{"0102030405060708090a0b0c0d0e0f10", "0102030405060708090a0b0c0d0e0f11"}
+	var in []transform.Transformable
+	for i, traceID := range traceIDs {
+		in = append(in, &model.Transaction{TraceID: traceID, ID: fmt.Sprintf("%016x", i)})
+	}
+	out, err := processor.ProcessTransformables(context.Background(), in)
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	// Stop the processor before the reservoir is flushed.
+	// The reservoir is restored when the processor restarts,
+	// and the sampling decision is made as if it had not.
+	require.NoError(t, processor.Stop(context.Background()))
+
+	published := make(chan string)
+	config.Elasticsearch = pubsubtest.Client(pubsubtest.PublisherChan(published), nil)
+	config.FlushInterval = 10 * time.Millisecond
+	reported := make(chan []transform.Transformable, 1)
+	config.Reporter = func(ctx context.Context, req publish.PendingReq) error {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case reported <- req.Transformables:
+			return nil
+		}
+	}
+	processor, err = sampling.NewProcessor(config)
+	require.NoError(t, err)
+	go processor.Run()
+	defer processor.Stop(context.Background())
+
+	var sampledTraceID string
+	select {
+	case sampledTraceID = <-published:
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for publication")
+	}
+	assert.Contains(t, traceIDs, sampledTraceID)
+	select {
+	case events := <-reported:
+		require.Len(t, events, 1)
+		assert.Equal(t, sampledTraceID, events[0].(*model.Transaction).TraceID)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for reporting")
+	}
+}
+
+func TestProcessDecisionDelayLateEvents(t *testing.T) {
+	config := newTempdirConfig(t)
+	config.Policies = []sampling.Policy{{SampleRate: 1}}
+	config.FlushInterval = time.Hour
+	config.DecisionDelay = time.Second
+	config.Elasticsearch = pubsubtest.Client(nil, nil)
+
+	processor, err := sampling.NewProcessor(config)
+	require.NoError(t, err)
+	go processor.Run()
+	defer processor.Stop(context.Background())
+
+	traceID := "0102030405060708090a0b0c0d0e0f10"
+	out, err := processor.ProcessTransformables(context.Background(), []transform.Transformable{
+		&model.Transaction{TraceID: traceID, ID: "0102030405060708"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	// Wait for the trace to become idle, and its root to be admitted
+	// to the reservoir. The decision is not finalized until the flush
+	// interval elapses.
+	deadline := time.Now().Add(10 * time.Second)
+	for collectProcessorMetrics(processor).Ints["sampling.pending_traces"] != 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the trace to become idle")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	// Events received after the root has been admitted are stored with
+	// the rest of the trace, and do not cause the trace to be tracked
+	// again without its root.
+	out, err = processor.ProcessTransformables(context.Background(), []transform.Transformable{
+		&model.Span{TraceID: traceID, ID: "0102030405060709", ParentID: "0102030405060708"},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Ints["sampling.pending_traces"] = 0
+	expectedMonitoring.Ints["sampling.events.processed"] = 2
+	expectedMonitoring.Ints["sampling.events.stored"] = 2
+	expectedMonitoring.Ints["sampling.events.dropped"] = 0
+	assertMonitoring(t, processor, expectedMonitoring, `sampling.pending_traces`, `sampling.events.*`)
+}
+
+func TestProcessRestoreSamplingStateDecisionDelay(t *testing.T) {
+	config := newTempdirConfig(t)
+	config.Policies = []sampling.Policy{{SampleRate: 1}}
+	config.FlushInterval = 10 * time.Millisecond
+	config.DecisionDelay = time.Hour
+	published := make(chan string, 1)
+	config.Elasticsearch = pubsubtest.Client(pubsubtest.PublisherChan(published), nil)
+
+	processor, err := sampling.NewProcessor(config)
+	require.NoError(t, err)
+	go processor.Run()
+
+	traceID := "0102030405060708090a0b0c0d0e0f10"
+	transaction := &model.Transaction{TraceID: traceID, ID: "0102030405060708"}
+	span := &model.Span{TraceID: traceID, ID: "0102030405060709", ParentID: "0102030405060708"}
+	out, err := processor.ProcessTransformables(context.Background(), []transform.Transformable{transaction, span})
+	require.NoError(t, err)
+	assert.Empty(t, out)
+	require.NoError(t, processor.Stop(context.Background()))
+	assert.Empty(t, published)
+
+	// The pending trace is restored when the processor restarts,
+	// and the sampling decision is made once the trace is idle.
+	config.DecisionDelay = time.Second
+	processor, err = sampling.NewProcessor(config)
+	require.NoError(t, err)
+	explanation, err := processor.ExplainTrace(traceID)
+	require.NoError(t, err)
+	assert.True(t, explanation.Pending)
+
+	go processor.Run()
+	defer processor.Stop(context.Background())
+	select {
+	case sampledTraceID := <-published:
+		assert.Equal(t, traceID, sampledTraceID)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for publication")
+	}
+}
+
+func TestExplainTrace(t *testing.T) {
+	config := newTempdirConfig(t)
+	config.Policies = []sampling.Policy{
+		{SampleRate: 0, PolicyCriteria: sampling.PolicyCriteria{ServiceName: "static-service"}},
+		{SampleRate: 0.5},
+	}
+	subscriberChan := make(chan string)
+	config.Elasticsearch = pubsubtest.Client(nil, pubsubtest.SubscriberChan(subscriberChan))
+	config.ExplainPolicies = true
+
+	processor, err := sampling.NewProcessor(config)
+	require.NoError(t, err)
+	go processor.Run()
+	defer processor.Stop(context.Background())
+
+	traceID1 := "0102030405060708090a0b0c0d0e0f10"
+	traceID2 := "0102030405060708090a0b0c0d0e0f11"
+	traceID3 := "0102030405060708090a0b0c0d0e0f12"
+	_, err = processor.ProcessTransformables(context.Background(), []transform.Transformable{
+		&model.Transaction{
+			Metadata: model.Metadata{Service: model.Service{Name: "static-service"}},
+			TraceID:  traceID1,
+			ID:       "0102030405060708",
+			Duration: 123,
+		},
+		&model.Transaction{
+			Metadata: model.Metadata{Service: model.Service{Name: "dynamic-service"}},
+			TraceID:  traceID2,
+			ID:       "0102030405060709",
+			Duration: 123,
+		},
+	})
+	require.NoError(t, err)
+
+	// The first trace matches a policy with a sample rate of zero,
+	// so it is unsampled locally as soon as the root is received.
+	explanation, err := processor.ExplainTrace(traceID1)
+	require.NoError(t, err)
+	assert.Equal(t, &sampling.TraceExplanation{
+		TraceID:  traceID1,
+		Decision: &sampling.DecisionExplanation{Sampled: false, Source: "local"},
+		Policy: &sampling.PolicyExplanation{
+			Index:       0,
+			ServiceName: "static-service",
+			Group:       &sampling.GroupExplanation{ReservoirSize: 1000},
+		},
+	}, explanation)
+
+	// The second trace has been admitted to the reservoir,
+	// but no decision has yet been made.
+	explanation, err = processor.ExplainTrace(traceID2)
+	require.NoError(t, err)
+	assert.Equal(t, &sampling.TraceExplanation{
+		TraceID: traceID2,
+		Policy: &sampling.PolicyExplanation{
+			Index:               1,
+			ServiceName:         "dynamic-service",
+			SampleRate:          0.5,
+			AdmittedToReservoir: true,
+			Group:               &sampling.GroupExplanation{Dynamic: true, ReservoirSize: 1000},
+		},
+	}, explanation)
+
+	// The third trace is sampled by a remote decision; its
+	// root transaction has not been observed by this server.
+	subscriberChan <- traceID3
+	require.Eventually(t, func() bool {
+		explanation, err = processor.ExplainTrace(traceID3)
+		require.NoError(t, err)
+		return explanation.Decision != nil
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.Equal(t, &sampling.TraceExplanation{
+		TraceID:  traceID3,
+		Decision: &sampling.DecisionExplanation{Sampled: true, Source: "remote"},
+	}, explanation)
+
+	assert.NoError(t, processor.Stop(context.Background()))
+	_, err = processor.ExplainTrace(traceID1)
+	assert.Equal(t, sampling.ErrStopped, err)
+}
+
+func TestExplainTraceWithoutPolicies(t *testing.T) {
+	config := newTempdirConfig(t)
+	config.Policies = []sampling.Policy{{SampleRate: 0}}
+	processor, err := sampling.NewProcessor(config)
+	require.NoError(t, err)
+	go processor.Run()
+	defer processor.Stop(context.Background())
+
+	traceID := "0102030405060708090a0b0c0d0e0f10"
+	_, err = processor.ProcessTransformables(context.Background(), []transform.Transformable{
+		&model.Transaction{TraceID: traceID, ID: "0102030405060708"},
+	})
+	require.NoError(t, err)
+
+	// Policy matches are not recorded unless ExplainPolicies is true,
+	// but the sampling decision is still explained.
+	explanation, err := processor.ExplainTrace(traceID)
+	require.NoError(t, err)
+	assert.Equal(t, &sampling.TraceExplanation{
+		TraceID:  traceID,
+		Decision: &sampling.DecisionExplanation{Sampled: false, Source: "local"},
+	}, explanation)
+}
+
 func TestGroupsMonitoring(t *testing.T) {
 	config := newTempdirConfig(t)
 	config.MaxDynamicServices = 5
@@ -414,6 +1164,41 @@ func TestGroupsMonitoring(t *testing.T) {
 	assertMonitoring(t, processor, expectedMonitoring, `sampling.events.*`, `sampling.dynamic_service_groups`)
 }
 
+func TestRateLimitedPolicyMonitoring(t *testing.T) {
+	config := newTempdirConfig(t)
+	config.FlushInterval = time.Second
+	config.Policies = []sampling.Policy{{MaxTracesPerSecond: 1}}
+
+	processor, err := sampling.NewProcessor(config)
+	require.NoError(t, err)
+	for i := 0; i < 4; i++ {
+		_, err := processor.ProcessTransformables(context.Background(), []transform.Transformable{&model.Transaction{
+			TraceID:  uuid.Must(uuid.NewV4()).String(),
+			ID:       "0102030405060709",
+			Duration: 123,
+		}})
+		require.NoError(t, err)
+	}
+	go processor.Run()
+	defer processor.Stop(context.Background())
+
+	// Only one trace may be sampled per one second flush interval.
+	// Wait for the first interval to be finalized.
+	deadline := time.Now().Add(10 * time.Second)
+	for collectProcessorMetrics(processor).Floats["sampling.policies.0.sampled_traces_per_second"] == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for sampling decisions")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	expectedMonitoring := monitoring.MakeFlatSnapshot()
+	expectedMonitoring.Floats["sampling.policies.0.max_traces_per_second"] = 1
+	expectedMonitoring.Floats["sampling.policies.0.sampled_traces_per_second"] = 1
+	expectedMonitoring.Floats["sampling.policies.0.effective_sample_rate"] = 0.25
+	assertMonitoring(t, processor, expectedMonitoring, `sampling.policies.*`)
+}
+
 func TestStorageMonitoring(t *testing.T) {
 	config := newTempdirConfig(t)
 
@@ -444,6 +1229,50 @@ func TestStorageMonitoring(t *testing.T) {
 	assert.NotZero(t, metrics.Ints, "sampling.storage.value_log_size")
 }
 
+func TestStorageLimit(t *testing.T) {
+	for _, passthrough := range []bool{false, true} {
+		t.Run(fmt.Sprintf("passthrough=%t", passthrough), func(t *testing.T) {
+			config := newTempdirConfig(t)
+			// Opening storage creates files, so a limit of one byte
+			// is reached as soon as the processor is created.
+			config.StorageLimit = 1
+			config.StorageLimitPassthrough = passthrough
+
+			processor, err := sampling.NewProcessor(config)
+			require.NoError(t, err)
+			go processor.Run()
+			defer processor.Stop(context.Background())
+
+			transaction := &model.Transaction{
+				TraceID: "0102030405060708090a0b0c0d0e0f10",
+				ID:      "0102030405060708",
+			}
+			span := &model.Span{
+				TraceID: "0102030405060708090a0b0c0d0e0f10",
+				ID:      "0102030405060709",
+			}
+			out, err := processor.ProcessTransformables(context.Background(), []transform.Transformable{transaction, span})
+			require.NoError(t, err)
+
+			expectedMonitoring := monitoring.MakeFlatSnapshot()
+			expectedMonitoring.Ints["sampling.events.processed"] = 2
+			expectedMonitoring.Ints["sampling.events.stored"] = 0
+			expectedMonitoring.Ints["sampling.storage.rejected_writes"] = 2
+			if passthrough {
+				assert.Equal(t, []transform.Transformable{transaction, span}, out)
+				expectedMonitoring.Ints["sampling.events.dropped"] = 0
+			} else {
+				assert.Empty(t, out)
+				expectedMonitoring.Ints["sampling.events.dropped"] = 2
+			}
+			assertMonitoring(t, processor, expectedMonitoring, `sampling.events.*`, `sampling.storage.rejected_writes`)
+
+			metrics := collectProcessorMetrics(processor)
+			assert.NotZero(t, metrics.Ints["sampling.storage.disk_usage"])
+		})
+	}
+}
+
 func TestStorageGC(t *testing.T) {
 	if testing.Short() {
 		t.Skip("skipping slow test")
@@ -518,6 +1347,14 @@ func withBadger(tb testing.TB, storageDir string, f func(db *badger.DB)) {
 	assert.NoError(tb, db.Close())
 }
 
+// freeAddr returns a local TCP address which is not in use.
+func freeAddr(tb testing.TB) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(tb, err)
+	defer listener.Close()
+	return listener.Addr().String()
+}
+
 func newTempdirConfig(tb testing.TB) sampling.Config {
 	tempdir, err := ioutil.TempDir("", "samplingtest")
 	require.NoError(tb, err)
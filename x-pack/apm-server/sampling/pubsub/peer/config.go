@@ -0,0 +1,76 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package peer
+
+import (
+	"crypto/tls"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+)
+
+// Config holds configuration for Pubsub.
+type Config struct {
+	// BeatID holds the APM Server's unique ID, used for identifying the
+	// publisher of sampled trace IDs, and for ignoring trace IDs published
+	// by this server, e.g. if it is included in its own list of peers.
+	BeatID string
+
+	// ListenAddr holds the TCP address on which to listen for sampled
+	// trace IDs published by peers.
+	ListenAddr string
+
+	// Peers holds the addresses of the peers to which sampled trace IDs
+	// are published, either in the form "host:port", or as a URL with
+	// the scheme "http" or "https". Addresses without a scheme use "https"
+	// if ClientTLS is non-nil, and "http" otherwise.
+	Peers []string
+
+	// SecretToken, if non-empty, holds a secret shared by all peers. The
+	// secret is sent with published trace IDs, and requests from peers
+	// that do not present it are rejected.
+	//
+	// If SecretToken is empty, anyone able to connect to ListenAddr may
+	// publish sampled trace IDs, so ListenAddr must only be reachable
+	// from a private network.
+	SecretToken string
+
+	// TLS, if non-nil, holds the TLS configuration for serving requests
+	// from peers.
+	TLS *tls.Config
+
+	// ClientTLS, if non-nil, holds the TLS configuration for publishing
+	// sampled trace IDs to peers.
+	ClientTLS *tls.Config
+
+	// PublishTimeout holds the maximum amount of time to wait for each
+	// peer to receive published trace IDs.
+	PublishTimeout time.Duration
+
+	// Logger is used for logging publish and subscribe operations -- particularly
+	// errors that occur when communicating with peers.
+	//
+	// If Logger is nil, a new logger will be constructed.
+	Logger *logp.Logger
+}
+
+// Validate validates the configuration.
+func (config Config) Validate() error {
+	if config.BeatID == "" {
+		return errors.New("BeatID unspecified")
+	}
+	if config.ListenAddr == "" {
+		return errors.New("ListenAddr unspecified")
+	}
+	if len(config.Peers) == 0 {
+		return errors.New("Peers unspecified")
+	}
+	if config.PublishTimeout <= 0 {
+		return errors.New("PublishTimeout unspecified or negative")
+	}
+	return nil
+}
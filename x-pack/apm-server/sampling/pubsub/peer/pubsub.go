@@ -0,0 +1,286 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+// Package peer provides a means of publishing and subscribing to sampled
+// trace IDs by exchanging them directly between APM Servers over HTTP,
+// without using Elasticsearch.
+package peer
+
+import (
+	"bytes"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/elastic/beats/v7/libbeat/logp"
+
+	logs "github.com/elastic/apm-server/log"
+	"github.com/elastic/apm-server/x-pack/apm-server/sampling/pubsub"
+)
+
+// SampledTraceIDsPath is the HTTP path on which sampled trace IDs are
+// received from peers.
+const SampledTraceIDsPath = "/sampling/v1/sampled_trace_ids"
+
+// maxRequestBodySize is the maximum size of a request body received from
+// a peer, which is enough for tens of thousands of trace IDs.
+const maxRequestBodySize = 10 * 1024 * 1024
+
+// maxUndeliveredTraces is the maximum number of sampled traces retained
+// for each peer while it is unavailable. This is kept well within the
+// maximum request body size.
+const maxUndeliveredTraces = 50000
+
+// Pubsub provides a means of publishing and subscribing to sampled trace IDs,
+// by sending them to a static list of peers over HTTP.
+//
+// Requests from peers are authenticated with a shared secret token, if one
+// is configured, and may be served over TLS. Without a secret token, anyone
+// able to reach the listener can cause events to be published by sending
+// trace IDs, so the listener must only be bound to a private network.
+//
+// Published trace IDs are sent to each peer immediately. If a peer is
+// unavailable, e.g. while it is restarting, trace IDs published while it
+// is unavailable are retained and sent along with subsequently published
+// trace IDs. Delivery is best-effort: at most maxUndeliveredTraces are
+// retained for each peer.
+type Pubsub struct {
+	config   Config
+	client   *http.Client
+	peerURLs []string
+	listener net.Listener
+
+	mu          sync.Mutex
+	undelivered map[string][]sampledTrace
+}
+
+// New returns a new Pubsub which can publish and subscribe sampled trace IDs,
+// exchanging them directly with the configured peers.
+//
+// New listens on the configured address immediately. Requests from peers
+// are served while SubscribeSampledTraces is running; the listener is
+// closed when SubscribeSampledTraces returns.
+func New(config Config) (*Pubsub, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid pubsub config")
+	}
+	if config.Logger == nil {
+		config.Logger = logp.NewLogger(logs.Sampling)
+	}
+	defaultScheme := "http://"
+	if config.ClientTLS != nil {
+		defaultScheme = "https://"
+	}
+	peerURLs := make([]string, len(config.Peers))
+	for i, peer := range config.Peers {
+		if !strings.Contains(peer, "://") {
+			peer = defaultScheme + peer
+		}
+		peerURLs[i] = strings.TrimRight(peer, "/") + SampledTraceIDsPath
+	}
+	listener, err := net.Listen("tcp", config.ListenAddr)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to listen for peers")
+	}
+	if config.TLS != nil {
+		listener = tls.NewListener(listener, config.TLS)
+	}
+	if config.SecretToken == "" {
+		config.Logger.Warnf(
+			"peer listener on %s is unauthenticated, and must only be reachable from a private network",
+			listener.Addr(),
+		)
+	}
+	client := &http.Client{Timeout: config.PublishTimeout}
+	if config.ClientTLS != nil {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = config.ClientTLS
+		client.Transport = transport
+	}
+	return &Pubsub{
+		config:      config,
+		client:      client,
+		peerURLs:    peerURLs,
+		listener:    listener,
+		undelivered: make(map[string][]sampledTrace),
+	}, nil
+}
+
+// Addr returns the network address on which the Pubsub is listening
+// for sampled trace IDs published by peers.
+func (p *Pubsub) Addr() net.Addr {
+	return p.listener.Addr()
+}
+
+// PublishSampledTraces sends traces to each of the peers concurrently,
+// along with any traces previously undelivered to each peer, returning
+// once all peers have received them or the publish timeout has elapsed.
+//
+// Failures to send to a peer are logged, and not returned.
+func (p *Pubsub) PublishSampledTraces(ctx context.Context, traces ...pubsub.SampledTrace) error {
+	published := make([]sampledTrace, len(traces))
+	for i, trace := range traces {
+		published[i] = sampledTrace{ID: trace.TraceID, SampleRate: trace.SampleRate}
+	}
+
+	var wg sync.WaitGroup
+	for _, peerURL := range p.peerURLs {
+		p.mu.Lock()
+		undelivered := p.undelivered[peerURL]
+		delete(p.undelivered, peerURL)
+		p.mu.Unlock()
+		if len(undelivered)+len(published) == 0 {
+			continue
+		}
+		peerTraces := make([]sampledTrace, 0, len(undelivered)+len(published))
+		peerTraces = append(peerTraces, undelivered...)
+		peerTraces = append(peerTraces, published...)
+
+		wg.Add(1)
+		go func(peerURL string, traces []sampledTrace) {
+			defer wg.Done()
+			if err := p.publish(ctx, peerURL, traces); err != nil {
+				p.config.Logger.With(logp.Error(err)).Debugf("publishing sampled trace IDs to %s failed", peerURL)
+				if n := len(traces) - maxUndeliveredTraces; n > 0 {
+					// Discard the oldest traces.
+					traces = traces[n:]
+				}
+				p.mu.Lock()
+				p.undelivered[peerURL] = traces
+				p.mu.Unlock()
+			}
+		}(peerURL, peerTraces)
+	}
+	wg.Wait()
+	return nil
+}
+
+func (p *Pubsub) publish(ctx context.Context, peerURL string, traces []sampledTrace) error {
+	var msg sampledTracesMessage
+	msg.Observer.ID = p.config.BeatID
+	msg.Traces = traces
+	body, err := json.Marshal(&msg)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, peerURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if p.config.SecretToken != "" {
+		req.Header.Set("Authorization", "Bearer "+p.config.SecretToken)
+	}
+	resp, err := p.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("request failed with status %d: %s", resp.StatusCode, message)
+	}
+	return nil
+}
+
+// SubscribeSampledTraces serves requests from peers, sending the sampled
+// traces they publish to the traces channel, until ctx is cancelled.
+//
+// SubscribeSampledTraces closes the listener before returning, and so
+// must be called at most once.
+func (p *Pubsub) SubscribeSampledTraces(ctx context.Context, traces chan<- pubsub.SampledTrace) error {
+	mux := http.NewServeMux()
+	mux.Handle(SampledTraceIDsPath, p.sampledTracesHandler(ctx, traces))
+	srv := &http.Server{Handler: mux}
+
+	errs := make(chan error, 1)
+	go func() { errs <- srv.Serve(p.listener) }()
+	select {
+	case <-ctx.Done():
+		srv.Close()
+		<-errs
+		return ctx.Err()
+	case err := <-errs:
+		return err
+	}
+}
+
+func (p *Pubsub) sampledTracesHandler(ctx context.Context, out chan<- pubsub.SampledTrace) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "only POST requests are supported", http.StatusMethodNotAllowed)
+			return
+		}
+		if !p.authorized(r) {
+			http.Error(w, "missing or invalid secret token", http.StatusUnauthorized)
+			return
+		}
+		var msg sampledTracesMessage
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestBodySize)).Decode(&msg); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if msg.Observer.ID == p.config.BeatID {
+			// Ignore local observations.
+			return
+		}
+		for _, trace := range msg.Traces {
+			select {
+			case <-ctx.Done():
+				http.Error(w, "subscriber stopped", http.StatusServiceUnavailable)
+				return
+			case <-r.Context().Done():
+				return
+			case out <- pubsub.SampledTrace{TraceID: trace.ID, SampleRate: trace.SampleRate}:
+			}
+		}
+	})
+}
+
+// authorized reports whether r presents the configured secret token,
+// or true if there is no secret token configured.
+func (p *Pubsub) authorized(r *http.Request) bool {
+	if p.config.SecretToken == "" {
+		return true
+	}
+	const prefix = "Bearer "
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, prefix) {
+		return false
+	}
+	token := header[len(prefix):]
+	return subtle.ConstantTimeCompare([]byte(token), []byte(p.config.SecretToken)) == 1
+}
+
+// sampledTracesMessage is the body of requests sent to peers.
+type sampledTracesMessage struct {
+	// Observer identifies the APM Server that sampled the traces.
+	Observer struct {
+		// ID holds the unique ID of the observer.
+		ID string `json:"id"`
+	} `json:"observer"`
+
+	// Traces holds the sampled traces.
+	Traces []sampledTrace `json:"traces"`
+}
+
+type sampledTrace struct {
+	// ID holds the unique ID of the trace.
+	ID string `json:"id"`
+
+	// SampleRate holds the effective rate at which the trace was
+	// sampled, if known.
+	SampleRate float64 `json:"sample_rate,omitempty"`
+}
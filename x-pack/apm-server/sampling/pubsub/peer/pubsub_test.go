@@ -0,0 +1,277 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package peer_test
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/x-pack/apm-server/sampling/pubsub"
+	"github.com/elastic/apm-server/x-pack/apm-server/sampling/pubsub/peer"
+)
+
+func TestConfigInvalid(t *testing.T) {
+	type test struct {
+		config peer.Config
+		err    string
+	}
+
+	for _, test := range []test{{
+		config: peer.Config{},
+		err:    "BeatID unspecified",
+	}, {
+		config: peer.Config{
+			BeatID: "beat_id",
+		},
+		err: "ListenAddr unspecified",
+	}, {
+		config: peer.Config{
+			BeatID:     "beat_id",
+			ListenAddr: "localhost:0",
+		},
+		err: "Peers unspecified",
+	}, {
+		config: peer.Config{
+			BeatID:     "beat_id",
+			ListenAddr: "localhost:0",
+			Peers:      []string{"localhost:1234"},
+		},
+		err: "PublishTimeout unspecified or negative",
+	}} {
+		ps, err := peer.New(test.config)
+		require.Error(t, err)
+		require.Nil(t, ps)
+		assert.EqualError(t, err, "invalid pubsub config: "+test.err)
+	}
+}
+
+func TestExchangeSampledTraces(t *testing.T) {
+	// Each server is configured with all servers as peers,
+	// including itself, whose trace IDs should be ignored.
+	listenAddrs := []string{freeAddr(t), freeAddr(t), freeAddr(t)}
+	peers := []string{listenAddrs[0], listenAddrs[1], "http://" + listenAddrs[2]}
+	pubsubs := make([]*peer.Pubsub, len(listenAddrs))
+	subscribed := make([]chan pubsub.SampledTrace, len(listenAddrs))
+	for i, listenAddr := range listenAddrs {
+		ps, err := peer.New(peer.Config{
+			BeatID:         fmt.Sprintf("beat_%d", i),
+			ListenAddr:     listenAddr,
+			Peers:          peers,
+			PublishTimeout: 10 * time.Second,
+		})
+		require.NoError(t, err)
+		pubsubs[i] = ps
+		subscribed[i] = make(chan pubsub.SampledTrace)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for i, ps := range pubsubs {
+		go ps.SubscribeSampledTraces(ctx, subscribed[i])
+	}
+
+	traces := []pubsub.SampledTrace{
+		{TraceID: "trace_1"},
+		{TraceID: "trace_2", SampleRate: 0.5},
+	}
+	published := make(chan error, 1)
+	go func() {
+		published <- pubsubs[0].PublishSampledTraces(ctx, traces...)
+	}()
+
+	for _, i := range []int{1, 2} {
+		for _, expected := range traces {
+			select {
+			case trace := <-subscribed[i]:
+				assert.Equal(t, expected, trace)
+			case <-time.After(10 * time.Second):
+				t.Fatalf("timed out waiting for peer %d to receive %s", i, expected.TraceID)
+			}
+		}
+	}
+	select {
+	case err := <-published:
+		assert.NoError(t, err)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for publishing to complete")
+	}
+	select {
+	case trace := <-subscribed[0]:
+		t.Fatalf("unexpected local trace ID %s", trace.TraceID)
+	default:
+	}
+}
+
+func TestPublishPeerUnavailable(t *testing.T) {
+	peerAddr := freeAddr(t)
+	ps, err := peer.New(peer.Config{
+		BeatID:         "beat_id",
+		ListenAddr:     "localhost:0",
+		Peers:          []string{peerAddr},
+		PublishTimeout: 10 * time.Second,
+	})
+	require.NoError(t, err)
+
+	// Failures to publish to peers are logged, and not returned.
+	err = ps.PublishSampledTraces(context.Background(), pubsub.SampledTrace{TraceID: "trace_1"})
+	assert.NoError(t, err)
+
+	// Once the peer becomes available, previously undelivered
+	// traces are sent along with newly published traces.
+	unavailable, err := peer.New(peer.Config{
+		BeatID:         "peer_beat_id",
+		ListenAddr:     peerAddr,
+		Peers:          []string{peerAddr},
+		PublishTimeout: 10 * time.Second,
+	})
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	subscribed := make(chan pubsub.SampledTrace)
+	go unavailable.SubscribeSampledTraces(ctx, subscribed)
+
+	published := make(chan error, 1)
+	go func() {
+		published <- ps.PublishSampledTraces(ctx, pubsub.SampledTrace{TraceID: "trace_2"})
+	}()
+	for _, expected := range []string{"trace_1", "trace_2"} {
+		select {
+		case trace := <-subscribed:
+			assert.Equal(t, expected, trace.TraceID)
+		case <-time.After(10 * time.Second):
+			t.Fatalf("timed out waiting for peer to receive %s", expected)
+		}
+	}
+	assert.NoError(t, <-published)
+
+	// Delivered traces are not sent again.
+	go func() {
+		published <- ps.PublishSampledTraces(ctx)
+	}()
+	assert.NoError(t, <-published)
+	select {
+	case trace := <-subscribed:
+		t.Fatalf("unexpected trace %s", trace.TraceID)
+	default:
+	}
+}
+
+func TestSubscribeMethodNotAllowed(t *testing.T) {
+	ps, err := peer.New(peer.Config{
+		BeatID:         "beat_id",
+		ListenAddr:     "localhost:0",
+		Peers:          []string{"localhost:1234"},
+		PublishTimeout: 10 * time.Second,
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	subscribed := make(chan error, 1)
+	go func() {
+		subscribed <- ps.SubscribeSampledTraces(ctx, make(chan pubsub.SampledTrace))
+	}()
+
+	resp, err := http.Get("http://" + ps.Addr().String() + peer.SampledTraceIDsPath)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	cancel()
+	assert.Equal(t, context.Canceled, <-subscribed)
+}
+
+func TestExchangeSampledTracesTLS(t *testing.T) {
+	// Use the certificate generated by httptest, which is valid for 127.0.0.1.
+	srv := httptest.NewTLSServer(http.NotFoundHandler())
+	serverTLS := &tls.Config{Certificates: srv.TLS.Certificates}
+	clientTLS := srv.Client().Transport.(*http.Transport).TLSClientConfig
+	srv.Close()
+
+	newPubsub := func(beatID, listenAddr, peerAddr string) *peer.Pubsub {
+		ps, err := peer.New(peer.Config{
+			BeatID:         beatID,
+			ListenAddr:     listenAddr,
+			Peers:          []string{peerAddr},
+			PublishTimeout: 10 * time.Second,
+			SecretToken:    "abc123",
+			TLS:            serverTLS,
+			ClientTLS:      clientTLS,
+		})
+		require.NoError(t, err)
+		return ps
+	}
+	subscriber := newPubsub("subscriber", "127.0.0.1:0", "127.0.0.1:1234")
+	publisher := newPubsub("publisher", "127.0.0.1:0", subscriber.Addr().String())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	subscribed := make(chan pubsub.SampledTrace)
+	go subscriber.SubscribeSampledTraces(ctx, subscribed)
+
+	published := make(chan error, 1)
+	go func() {
+		published <- publisher.PublishSampledTraces(ctx, pubsub.SampledTrace{TraceID: "trace_1"})
+	}()
+	select {
+	case trace := <-subscribed:
+		assert.Equal(t, "trace_1", trace.TraceID)
+	case <-time.After(10 * time.Second):
+		t.Fatal("timed out waiting for peer to receive trace_1")
+	}
+	assert.NoError(t, <-published)
+}
+
+func TestSubscribeUnauthorized(t *testing.T) {
+	ps, err := peer.New(peer.Config{
+		BeatID:         "beat_id",
+		ListenAddr:     "localhost:0",
+		Peers:          []string{"localhost:1234"},
+		PublishTimeout: 10 * time.Second,
+		SecretToken:    "abc123",
+	})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	subscribed := make(chan pubsub.SampledTrace, 1)
+	go ps.SubscribeSampledTraces(ctx, subscribed)
+
+	for _, authorization := range []string{"", "Bearer wrong", "abc123"} {
+		req, err := http.NewRequest(
+			http.MethodPost, "http://"+ps.Addr().String()+peer.SampledTraceIDsPath,
+			strings.NewReader(`{"observer":{"id":"other"},"traces":[{"id":"trace_1"}]}`),
+		)
+		require.NoError(t, err)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	}
+	select {
+	case trace := <-subscribed:
+		t.Fatalf("unexpected trace %s", trace.TraceID)
+	default:
+	}
+}
+
+// freeAddr returns a local TCP address which is not in use.
+func freeAddr(t testing.TB) string {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	defer listener.Close()
+	return listener.Addr().String()
+}
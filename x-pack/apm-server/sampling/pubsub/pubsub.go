@@ -24,6 +24,17 @@ import (
 	logs "github.com/elastic/apm-server/log"
 )
 
+// SampledTrace identifies a sampled trace, and the effective rate at which
+// it was sampled.
+type SampledTrace struct {
+	// TraceID holds the unique ID of the trace.
+	TraceID string
+
+	// SampleRate holds the effective rate at which the trace was sampled,
+	// in the range (0,1]. If SampleRate is zero, the rate is unknown.
+	SampleRate float64
+}
+
 // Pubsub provides a means of publishing and subscribing to sampled trace IDs,
 // using Elasticsearch for temporary storage.
 //
@@ -59,12 +70,13 @@ func New(config Config) (*Pubsub, error) {
 	return &Pubsub{config: config, indexer: indexer}, nil
 }
 
-// PublishSampledTraceIDs bulk indexes traceIDs into Elasticsearch.
-func (p *Pubsub) PublishSampledTraceIDs(ctx context.Context, traceID ...string) error {
-	for _, id := range traceID {
+// PublishSampledTraces bulk indexes traces into Elasticsearch.
+func (p *Pubsub) PublishSampledTraces(ctx context.Context, traces ...SampledTrace) error {
+	for _, trace := range traces {
 		var doc traceIDDocument
 		doc.Observer.ID = p.config.BeatID
-		doc.Trace.ID = id
+		doc.Trace.ID = trace.TraceID
+		doc.Trace.SampleRate = trace.SampleRate
 
 		var json fastjson.Writer
 		if err := doc.MarshalFastJSON(&json); err != nil {
@@ -85,9 +97,13 @@ func (p *Pubsub) onBulkIndexerItemFailure(ctx context.Context, item elasticsearc
 	p.config.Logger.With(logp.Error(err)).Debug("publishing sampled trace ID failed")
 }
 
-// SubscribeSampledTraceIDs subscribes to new sampled trace IDs, sending them to the
-// traceIDs channel.
-func (p *Pubsub) SubscribeSampledTraceIDs(ctx context.Context, traceIDs chan<- string) error {
+// SubscribeSampledTraces subscribes to new sampled traces, sending them to the
+// traces channel.
+//
+// Sampled traces published before SubscribeSampledTraces is called, which have
+// not yet been reaped, are also sent. This ensures that sampling decisions made
+// while the server was stopped, e.g. during a rolling restart, are not missed.
+func (p *Pubsub) SubscribeSampledTraces(ctx context.Context, traces chan<- SampledTrace) error {
 	ticker := time.NewTicker(p.config.SearchInterval)
 	defer ticker.Stop()
 
@@ -104,7 +120,7 @@ func (p *Pubsub) SubscribeSampledTraceIDs(ctx context.Context, traceIDs chan<- s
 		}
 		for {
 			// Keep searching until there are no more new trace IDs.
-			n, err := p.searchTraceIDs(ctx, traceIDs, &lastSeqNo, &lastPrimaryTerm)
+			n, err := p.searchTraceIDs(ctx, traces, &lastSeqNo, &lastPrimaryTerm)
 			if err != nil {
 				// Errors may occur due to rate limiting, or while the index is
 				// still being created, so just log and continue.
@@ -121,7 +137,7 @@ func (p *Pubsub) SubscribeSampledTraceIDs(ctx context.Context, traceIDs chan<- s
 
 // searchTraceIDs searches for new sampled trace IDs (after lastPrimaryTerm and lastSeqNo),
 // sending them to the out channel and returning the number of trace IDs sent.
-func (p *Pubsub) searchTraceIDs(ctx context.Context, out chan<- string, lastSeqNo, lastPrimaryTerm *int64) (int, error) {
+func (p *Pubsub) searchTraceIDs(ctx context.Context, out chan<- SampledTrace, lastSeqNo, lastPrimaryTerm *int64) (int, error) {
 	searchBody := map[string]interface{}{
 		"size":                1000,
 		"seq_no_primary_term": true,
@@ -188,7 +204,7 @@ func (p *Pubsub) searchTraceIDs(ctx context.Context, out chan<- string, lastSeqN
 		select {
 		case <-ctx.Done():
 			return n, ctx.Err()
-		case out <- hit.Source.Trace.ID:
+		case out <- SampledTrace{TraceID: hit.Source.Trace.ID, SampleRate: hit.Source.Trace.SampleRate}:
 			n++
 		}
 		if hit.PrimaryTerm > maxPrimaryTerm {
@@ -214,6 +230,10 @@ type traceIDDocument struct {
 	Trace struct {
 		// ID holds the unique ID of the trace.
 		ID string `json:"id"`
+
+		// SampleRate holds the effective rate at which the trace was
+		// sampled, if known.
+		SampleRate float64 `json:"sample_rate,omitempty"`
 	} `json:"trace"`
 }
 
@@ -223,6 +243,10 @@ func (d *traceIDDocument) MarshalFastJSON(w *fastjson.Writer) error {
 	w.RawString(`},`)
 	w.RawString(`"trace":{"id":`)
 	w.String(d.Trace.ID)
+	if d.Trace.SampleRate != 0 {
+		w.RawString(`,"sample_rate":`)
+		w.Float64(d.Trace.SampleRate)
+	}
 	w.RawString(`}}`)
 	return nil
 }
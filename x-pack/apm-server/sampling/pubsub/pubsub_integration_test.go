@@ -32,7 +32,7 @@ const (
 	defaultElasticsearchPass = "changeme"
 )
 
-func TestElasticsearchIntegration_PublishSampledTraceIDs(t *testing.T) {
+func TestElasticsearchIntegration_PublishSampledTraces(t *testing.T) {
 	const (
 		localBeatID = "local_beat_id"
 		indexName   = "apm-testing-sampled-traces"
@@ -41,9 +41,12 @@ func TestElasticsearchIntegration_PublishSampledTraceIDs(t *testing.T) {
 	client := newElasticsearchClient(t)
 	recreateIndex(t, client, indexName)
 
-	var input []string
+	var input []pubsub.SampledTrace
 	for i := 0; i < 50; i++ {
-		input = append(input, uuid.Must(uuid.NewV4()).String())
+		input = append(input, pubsub.SampledTrace{
+			TraceID:    uuid.Must(uuid.NewV4()).String(),
+			SampleRate: 0.5,
+		})
 	}
 
 	es, err := pubsub.New(pubsub.Config{
@@ -55,7 +58,7 @@ func TestElasticsearchIntegration_PublishSampledTraceIDs(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	err = es.PublishSampledTraceIDs(context.Background(), input...)
+	err = es.PublishSampledTraces(context.Background(), input...)
 	assert.NoError(t, err)
 
 	var result struct {
@@ -66,7 +69,8 @@ func TestElasticsearchIntegration_PublishSampledTraceIDs(t *testing.T) {
 						ID string
 					}
 					Trace struct {
-						ID string
+						ID         string
+						SampleRate float64 `json:"sample_rate"`
 					}
 				} `json:"_source"`
 			}
@@ -93,15 +97,18 @@ func TestElasticsearchIntegration_PublishSampledTraceIDs(t *testing.T) {
 		}
 	}
 
-	output := make([]string, len(input))
+	output := make([]pubsub.SampledTrace, len(input))
 	for i, hit := range result.Hits.Hits {
 		assert.Equal(t, localBeatID, hit.Source.Observer.ID)
-		output[i] = hit.Source.Trace.ID
+		output[i] = pubsub.SampledTrace{
+			TraceID:    hit.Source.Trace.ID,
+			SampleRate: hit.Source.Trace.SampleRate,
+		}
 	}
 	assert.ElementsMatch(t, input, output)
 }
 
-func TestElasticsearchIntegration_SubscribeSampledTraceIDs(t *testing.T) {
+func TestElasticsearchIntegration_SubscribeSampledTraces(t *testing.T) {
 	const (
 		localBeatID  = "local_observer_id"
 		remoteBeatID = "remote_observer_id"
@@ -121,11 +128,11 @@ func TestElasticsearchIntegration_SubscribeSampledTraceIDs(t *testing.T) {
 	require.NoError(t, err)
 
 	var g errgroup.Group
-	out := make(chan string)
+	out := make(chan pubsub.SampledTrace)
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
 	g.Go(func() error {
-		return es.SubscribeSampledTraceIDs(ctx, out)
+		return es.SubscribeSampledTraces(ctx, out)
 	})
 	assert.NoError(t, err)
 
@@ -182,7 +189,7 @@ func TestElasticsearchIntegration_SubscribeSampledTraceIDs(t *testing.T) {
 
 		output := make([]string, len(input))
 		for i := range input {
-			output[i] = expectValue(t, out)
+			output[i] = expectValue(t, out).TraceID
 		}
 		assert.Equal(t, input, output)
 	}
@@ -26,7 +26,7 @@ import (
 	"github.com/elastic/apm-server/x-pack/apm-server/sampling/pubsub"
 )
 
-func TestPublishSampledTraceIDs(t *testing.T) {
+func TestPublishSampledTraces(t *testing.T) {
 	const (
 		indexName = "trace-ids"
 		beatID    = "beat_id"
@@ -61,24 +61,28 @@ func TestPublishSampledTraceIDs(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	var ids []string
+	var traces []pubsub.SampledTrace
 	for i := 0; i < 20; i++ {
-		ids = append(ids, uuid.Must(uuid.NewV4()).String())
+		trace := pubsub.SampledTrace{TraceID: uuid.Must(uuid.NewV4()).String()}
+		if i%2 == 0 {
+			trace.SampleRate = 0.25
+		}
+		traces = append(traces, trace)
 	}
 
 	// Publish in a separate goroutine, as it may get blocked if we don't
 	// service bulk requests.
 	go func() {
-		for i := 0; i < len(ids); i += 2 {
-			err = pub.PublishSampledTraceIDs(context.Background(), ids[i], ids[i+1])
+		for i := 0; i < len(traces); i += 2 {
+			err = pub.PublishSampledTraces(context.Background(), traces[i], traces[i+1])
 			assert.NoError(t, err)
 			time.Sleep(10 * time.Millisecond) // sleep to force a new request
 		}
 	}()
 
-	var received []string
+	var received []pubsub.SampledTrace
 	deadlineTimer := time.NewTimer(10 * time.Second)
-	for len(received) < len(ids) {
+	for len(received) < len(traces) {
 		select {
 		case <-deadlineTimer.C:
 			t.Fatal("timed out waiting for events to be received by server")
@@ -101,8 +105,10 @@ func TestPublishSampledTraceIDs(t *testing.T) {
 
 				trace := doc["trace"].(map[string]interface{})
 				traceID := trace["id"].(string)
-				received = append(received, traceID)
+				sampleRate, _ := trace["sample_rate"].(float64) // omitted if unknown
+				received = append(received, pubsub.SampledTrace{TraceID: traceID, SampleRate: sampleRate})
 				delete(trace, "id")
+				delete(trace, "sample_rate")
 				assert.Empty(t, trace) // no other fields in "trace"
 
 				delete(doc, "observer")
@@ -114,10 +120,10 @@ func TestPublishSampledTraceIDs(t *testing.T) {
 
 	// The publisher uses an esutil.BulkIndexer, which may index items out
 	// of order due to having multiple goroutines picking items off a queue.
-	assert.ElementsMatch(t, ids, received)
+	assert.ElementsMatch(t, traces, received)
 }
 
-func TestSubscribeSampledTraceIDs(t *testing.T) {
+func TestSubscribeSampledTraces(t *testing.T) {
 	const (
 		indexName = "trace-ids"
 		beatID    = "beat_id"
@@ -155,11 +161,11 @@ func TestSubscribeSampledTraceIDs(t *testing.T) {
 	})
 	require.NoError(t, err)
 
-	ids := make(chan string)
+	traces := make(chan pubsub.SampledTrace)
 	ctx, cancel := context.WithCancel(context.Background())
 	g, ctx := errgroup.WithContext(ctx)
 	go g.Go(func() error {
-		return sub.SubscribeSampledTraceIDs(ctx, ids)
+		return sub.SubscribeSampledTraces(ctx, traces)
 	})
 	defer g.Wait()
 	defer cancel()
@@ -175,14 +181,14 @@ func TestSubscribeSampledTraceIDs(t *testing.T) {
 	      {
 	        "_seq_no": 2,
 	        "_primary_term": 2,
-		"_source": {"trace": {"id": "trace_2"}, "observer": {"id": "another_beat_id"}}
+		"_source": {"trace": {"id": "trace_2", "sample_rate": 0.5}, "observer": {"id": "another_beat_id"}}
 	      }
 	    ]
 	  }
 	}`
 
-	assert.Equal(t, "trace_1", expectValue(t, ids))
-	assert.Equal(t, "trace_2", expectValue(t, ids))
+	assert.Equal(t, pubsub.SampledTrace{TraceID: "trace_1"}, expectValue(t, traces))
+	assert.Equal(t, pubsub.SampledTrace{TraceID: "trace_2", SampleRate: 0.5}, expectValue(t, traces))
 
 	responses <- "nonsense" // bad response, subscriber continues
 
@@ -210,11 +216,11 @@ func TestSubscribeSampledTraceIDs(t *testing.T) {
 	  }
 	}`
 
-	assert.Equal(t, "trace_2b", expectValue(t, ids))
-	assert.Equal(t, "trace_99", expectValue(t, ids))
+	assert.Equal(t, pubsub.SampledTrace{TraceID: "trace_2b"}, expectValue(t, traces))
+	assert.Equal(t, pubsub.SampledTrace{TraceID: "trace_99"}, expectValue(t, traces))
 
 	responses <- `{"hits":{"hits":[]}}` // no hits
-	expectNone(t, ids)
+	expectNone(t, traces)
 
 	cancel() // stop subscriber
 	srv.Close()
@@ -241,7 +247,7 @@ func TestSubscribeSampledTraceIDs(t *testing.T) {
 	}, bodies)
 }
 
-func expectValue(t testing.TB, ch <-chan string) string {
+func expectValue(t testing.TB, ch <-chan pubsub.SampledTrace) pubsub.SampledTrace {
 	select {
 	case <-time.After(10 * time.Second):
 		t.Fatalf("timed out waiting for trace ID to be sent")
@@ -252,10 +258,10 @@ func expectValue(t testing.TB, ch <-chan string) string {
 	}
 }
 
-func expectNone(t testing.TB, ch <-chan string) {
+func expectNone(t testing.TB, ch <-chan pubsub.SampledTrace) {
 	select {
 	case <-time.After(500 * time.Millisecond):
 	case v := <-ch:
-		t.Errorf("unexpected send on channel: %q", v)
+		t.Errorf("unexpected send on channel: %+v", v)
 	}
 }
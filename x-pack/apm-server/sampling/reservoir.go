@@ -35,11 +35,17 @@ func newWeightedRandomSample(rng *rand.Rand, reservoirSize int) *weightedRandomS
 // the given weight in the range [0, math.MaxFloat64].
 func (s *weightedRandomSample) Sample(weight float64, traceID string) bool {
 	k := math.Pow(s.rng.Float64(), 1/weight)
+	return s.add(k, traceID)
+}
+
+// add records a trace ID with the given random key, if the reservoir is not
+// full or the key is greater than the lowest key in the reservoir.
+func (s *weightedRandomSample) add(k float64, traceID string) bool {
 	if len(s.values) < cap(s.values) {
 		heap.Push(&s.itemheap, item{key: k, value: traceID})
 		return true
 	}
-	if k > s.keys[0] {
+	if len(s.keys) > 0 && k > s.keys[0] {
 		s.keys[0] = k
 		s.values[0] = traceID
 		heap.Fix(&s.itemheap, 0)
@@ -48,6 +54,25 @@ func (s *weightedRandomSample) Sample(weight float64, traceID string) bool {
 	return false
 }
 
+// State returns copies of the random keys and trace IDs currently held in
+// the reservoir, such that they may later be restored with Restore.
+func (s *weightedRandomSample) State() (keys []float64, traceIDs []string) {
+	keys = make([]float64, len(s.keys))
+	copy(keys, s.keys)
+	return keys, s.Values()
+}
+
+// Restore adds the random keys and trace IDs previously returned by State
+// to the reservoir. If there are more items than the reservoir can hold,
+// those with the lowest keys are discarded.
+func (s *weightedRandomSample) Restore(keys []float64, traceIDs []string) {
+	for i, k := range keys {
+		if i < len(traceIDs) {
+			s.add(k, traceIDs[i])
+		}
+	}
+}
+
 // Reset clears the current values, retaining the underlying storage space.
 func (s *weightedRandomSample) Reset() {
 	s.keys = s.keys[:0]
@@ -30,3 +30,20 @@ func TestResetReservoir(t *testing.T) {
 	res.Reset()
 	assert.Len(t, res.Values(), 0)
 }
+
+func TestRestoreReservoir(t *testing.T) {
+	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
+	res := newWeightedRandomSample(rng, 3)
+	res.Sample(1, "a")
+	res.Sample(2, "b")
+	res.Sample(3, "c")
+	keys, traceIDs := res.State()
+	assert.Len(t, keys, 3)
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, traceIDs)
+
+	// Restoring into a smaller reservoir retains the items with the greatest keys.
+	restored := newWeightedRandomSample(rng, 2)
+	restored.Restore(keys, traceIDs)
+	assert.Len(t, restored.Values(), 2)
+	assert.NotContains(t, restored.Values(), traceIDs[0]) // lowest key is at the root of the heap
+}
@@ -0,0 +1,151 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sampling
+
+import (
+	"time"
+
+	"github.com/elastic/apm-server/x-pack/apm-server/sampling/eventstorage"
+)
+
+// state returns the current state of each trace group, for persisting
+// across restarts.
+func (g *traceGroups) state() []eventstorage.TraceGroupState {
+	var states []eventstorage.TraceGroupState
+	add := func(serviceName string, byService serviceGroups, dynamic bool) {
+		for _, group := range byService {
+			state := group.g.state()
+			state.ServiceName = serviceName
+			state.Policy = group.policy
+			state.Dynamic = dynamic
+			states = append(states, state)
+		}
+	}
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	for serviceName, byService := range g.staticGroups {
+		add(serviceName, byService, false)
+	}
+	for serviceName, byService := range g.dynamicGroups {
+		add(serviceName, byService, true)
+	}
+	return states
+}
+
+// restore restores the state of trace groups previously returned by state.
+//
+// State is restored only into groups created from the same policy, with the
+// same sampling configuration; the state of other groups is discarded, as
+// the policies may have changed since the state was recorded. Dynamic groups
+// are recreated, up to the maximum number of dynamic services.
+func (g *traceGroups) restore(states []eventstorage.TraceGroupState) (restored int) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	for _, state := range states {
+		if state.Policy < 0 || state.Policy >= len(g.policies) {
+			continue
+		}
+		byService, ok := g.staticGroups[state.ServiceName]
+		if state.Dynamic {
+			if ok || g.policies[state.Policy].ServiceName != "" {
+				continue
+			}
+			if byService, ok = g.dynamicGroups[state.ServiceName]; !ok {
+				if len(g.dynamicGroups) == g.maxDynamicServices {
+					continue
+				}
+				byService = make(serviceGroups, 0, len(g.catchallServicePolicies))
+				for _, policyIndex := range g.catchallServicePolicies {
+					byService = g.updateServiceNameGroups(policyIndex, byService)
+				}
+				g.dynamicGroups[state.ServiceName] = byService
+			}
+		} else if !ok {
+			continue
+		}
+		for _, group := range byService {
+			if group.policy == state.Policy && group.g.restore(state) {
+				restored++
+				break
+			}
+		}
+	}
+	return restored
+}
+
+func (g *traceGroup) state() eventstorage.TraceGroupState {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	state := eventstorage.TraceGroupState{
+		SampleRate:    g.samplingFraction,
+		MaxTraces:     g.maxTraces,
+		IngestRate:    g.ingestRate,
+		ReservoirSize: g.reservoir.Size(),
+		Total:         g.total,
+		LastTotal:     g.lastTotal,
+		LastSampled:   g.lastSampled,
+	}
+	state.ReservoirKeys, state.ReservoirTraceIDs = g.reservoir.State()
+	if len(g.sampled) > 0 {
+		state.Sampled = make([]string, len(g.sampled))
+		copy(state.Sampled, g.sampled)
+	}
+	return state
+}
+
+// restore restores the group's state, returning false if the state was
+// recorded for a group with a different sampling configuration.
+func (g *traceGroup) restore(state eventstorage.TraceGroupState) bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if state.SampleRate != g.samplingFraction || state.MaxTraces != g.maxTraces {
+		return false
+	}
+	g.ingestRate = state.IngestRate
+	g.total += state.Total
+	g.lastTotal = state.LastTotal
+	g.lastSampled = state.LastSampled
+	if g.maxTraces == 0 && state.ReservoirSize > g.reservoir.Size() {
+		// Rate-limited reservoirs have a fixed size;
+		// others are sized according to the ingest rate.
+		g.reservoir.Resize(state.ReservoirSize)
+	}
+	g.reservoir.Restore(state.ReservoirKeys, state.ReservoirTraceIDs)
+	g.sampled = append(g.sampled, state.Sampled...)
+	return true
+}
+
+// state returns the current state of each pending trace, for persisting
+// across restarts.
+func (p *pendingTraces) state() []eventstorage.PendingTraceState {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	states := make([]eventstorage.PendingTraceState, 0, len(p.traces))
+	for traceID, trace := range p.traces {
+		states = append(states, eventstorage.PendingTraceState{
+			TraceID:   traceID,
+			LastSeen:  trace.lastSeen,
+			RootID:    trace.rootID,
+			HasError:  trace.hasError,
+			SpanCount: trace.spanCount,
+		})
+	}
+	return states
+}
+
+// restore restores a pending trace previously returned by state.
+func (p *pendingTraces) restore(state eventstorage.PendingTraceState) {
+	lastSeen := state.LastSeen
+	if lastSeen.IsZero() {
+		lastSeen = time.Now()
+	}
+	p.update(state.TraceID, lastSeen, func(trace *pendingTrace) {
+		if state.RootID != "" {
+			trace.rootID = state.RootID
+		}
+		trace.hasError = trace.hasError || state.HasError
+		trace.spanCount += state.SpanCount
+	})
+}
@@ -0,0 +1,68 @@
+// Copyright Elasticsearch B.V. and/or licensed to Elasticsearch B.V. under one
+// or more contributor license agreements. Licensed under the Elastic License;
+// you may not use this file except in compliance with the Elastic License.
+
+package sampling
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/apm-server/model"
+)
+
+func TestTraceGroupsRestoreState(t *testing.T) {
+	policies := []Policy{
+		{PolicyCriteria: PolicyCriteria{ServiceName: "static"}, SampleRate: 0.5},
+		{SampleRate: 0.5},
+	}
+	groups := newTraceGroups(policies, 1000, 1.0, time.Minute)
+	for _, serviceName := range []string{"static", "dynamic"} {
+		for i := 0; i < 10; i++ {
+			_, err := groups.sampleTrace(&model.Transaction{
+				Metadata: model.Metadata{Service: model.Service{Name: serviceName}},
+				TraceID:  uuid.Must(uuid.NewV4()).String(),
+				Duration: 1,
+			}, traceStats{})
+			require.NoError(t, err)
+		}
+	}
+	state := groups.state()
+	assert.Len(t, state, 2)
+
+	restored := newTraceGroups(policies, 1000, 1.0, time.Minute)
+	assert.Equal(t, 2, restored.restore(state))
+	assert.ElementsMatch(t, groups.finalizeSampledTraces(nil), restored.finalizeSampledTraces(nil))
+
+	// State is not restored into groups whose policies have changed.
+	changed := newTraceGroups([]Policy{
+		{PolicyCriteria: PolicyCriteria{ServiceName: "static"}, SampleRate: 0.1},
+		{PolicyCriteria: PolicyCriteria{ServiceName: "dynamic"}, SampleRate: 0.5},
+	}, 1000, 1.0, time.Minute)
+	assert.Equal(t, 0, changed.restore(state))
+	assert.Empty(t, changed.finalizeSampledTraces(nil))
+}
+
+func TestPendingTracesRestoreState(t *testing.T) {
+	now := time.Now()
+	pending := newPendingTraces()
+	pending.update("trace_id", now, func(trace *pendingTrace) {
+		trace.rootID = "root_id"
+		trace.spanCount = 2
+		trace.hasError = true
+	})
+	state := pending.state()
+	assert.Len(t, state, 1)
+	assert.Equal(t, "root_id", state[0].RootID)
+
+	restored := newPendingTraces()
+	restored.restore(state[0])
+	idle := restored.takeIdle(now, nil)
+	require.Len(t, idle, 1)
+	assert.Equal(t, "root_id", idle[0].rootID)
+	assert.Equal(t, traceStats{hasError: true, spanCount: 2}, idle[0].traceStats)
+}